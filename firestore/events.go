@@ -213,9 +213,12 @@ func (f *Firestore) deleteCollection(ctx context.Context, path string, batchSize
 	}
 }
 
+// Increment document name at path n amount.
+// Returns the new value and the start of the index.
+// A negative n decrements, for example to release a reserved amount.
 func (f *Firestore) Increment(ctx context.Context, path string, name string, n int64) (int64, int64, error) {
-	if n < 1 {
-		return 0, 0, errors.Errorf("increment by at least 1")
+	if n == 0 {
+		return 0, 0, errors.Errorf("increment by non-zero amount")
 	}
 	path = normalizePath(path)
 	index := int64(0)
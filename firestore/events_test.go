@@ -314,4 +314,11 @@ func TestIncrement(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(6), n)
 	require.Equal(t, int64(2), i)
+
+	n, _, err = eds.Increment(ctx, path, "count", -2)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), n)
+
+	_, _, err = eds.Increment(ctx, path, "count", 0)
+	require.EqualError(t, err, "increment by non-zero amount")
 }
@@ -13,6 +13,8 @@ type Response struct {
 type Error struct {
 	Message string `json:"message,omitempty"`
 	Status  int    `json:"status,omitempty"`
+	// Usage if vault quota was exceeded.
+	Usage *VaultUsage `json:"usage,omitempty"`
 }
 
 // Metadata ...
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/http"
+	"github.com/keys-pub/keys/user"
+	"github.com/keys-pub/keys/user/services"
+	"github.com/keys-pub/keys/users"
+)
+
+// TXTResolver looks up DNS TXT records, see net.Resolver.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+type domain struct {
+	resolver TXTResolver
+}
+
+// DomainService verifies domain (https) users from the signed message at
+// https://<domain>/.well-known/keys.pub.txt (see DomainWellKnownURL), at the
+// user URL, or from a DNS TXT record on the domain (see DomainTXT).
+func DomainService(resolver TXTResolver) services.Service {
+	return &domain{resolver: resolver}
+}
+
+// DomainWellKnownURL is the location of the signed message for a domain
+// (https) user.
+//
+// The user URL for a domain is https://<domain>/.well-known/keyspub.txt (or
+// https://<domain>/keyspub.txt), which is what the keys library validates, so
+// the signed message may also be found there.
+func DomainWellKnownURL(domain string) string {
+	return "https://" + domain + "/.well-known/keys.pub.txt"
+}
+
+func (s *domain) Request(ctx context.Context, client http.Client, usr *user.User) (user.Status, []byte, error) {
+	var status user.Status
+	var b []byte
+	var err error
+	for _, urs := range []string{DomainWellKnownURL(usr.Name), usr.URL} {
+		status, b, err = services.Request(ctx, client, urs, nil)
+		if status == user.StatusOK {
+			if msg, _ := encoding.FindSaltpack(string(b), false); msg != "" {
+				return status, b, nil
+			}
+		}
+	}
+	if txtErr := VerifyDomainTXT(ctx, s.resolver, usr.Name, usr.KID); txtErr != nil {
+		// Return the (URL) request error.
+		return status, b, err
+	}
+	return user.StatusOK, []byte(DomainTXT(usr.KID)), nil
+}
+
+func (s *domain) Verify(ctx context.Context, b []byte, usr *user.User) (user.Status, *services.Verified, error) {
+	// TXT record (checked in Request)
+	if txt := DomainTXT(usr.KID); string(b) == txt {
+		return user.StatusOK, &services.Verified{Statement: txt}, nil
+	}
+	status, statement, err := user.FindVerify(usr, b, false)
+	if err != nil {
+		return status, nil, err
+	}
+	return status, &services.Verified{Statement: statement}, nil
+}
+
+// DomainTXT is the DNS TXT record linking a domain to a key, for domain (https)
+// users and dns statements:
+//
+//	keys.pub=kex1...
+func DomainTXT(kid keys.ID) string {
+	return "keys.pub=" + kid.String()
+}
+
+// VerifyDomainTXT checks the domain has the TXT record (see DomainTXT) for kid.
+func VerifyDomainTXT(ctx context.Context, resolver TXTResolver, domain string, kid keys.ID) error {
+	txts, err := resolver.LookupTXT(ctx, domain)
+	if err != nil {
+		return fmt.Errorf("failed to lookup TXT records for %s: %v", domain, err)
+	}
+	txt := DomainTXT(kid)
+	for _, t := range txts {
+		if strings.TrimSpace(t) == txt {
+			return nil
+		}
+	}
+	return fmt.Errorf("no TXT record %s for %s", txt, domain)
+}
+
+// TXTRecords is a TXTResolver for static records, for example in tests.
+type TXTRecords map[string][]string
+
+// LookupTXT returns the records for name.
+func (r TXTRecords) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	txts, ok := r[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return txts, nil
+}
+
+// DomainLookup returns a service lookup (for users.UseService), verifying
+// domain (https) users with DomainService.
+func DomainLookup(resolver TXTResolver) users.ServiceLookupFn {
+	service := DomainService(resolver)
+	return func(usr *user.User) services.Service {
+		if usr.Service == "https" {
+			return service
+		}
+		return nil
+	}
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/http"
+	"github.com/keys-pub/keys/user"
+	"github.com/keys-pub/keys/user/services"
+)
+
+// ForgeStatementType is the sigchain statement type for a forge user.
+const ForgeStatementType = "forge"
+
+// ForgeService is the (user) service name for forge users.
+const ForgeService = "forge"
+
+// Forge is a (self-hosted) Git forge, like Gitea or GitLab, registered by an
+// admin.
+type Forge struct {
+	// URL (base) of the forge, for example https://git.example.com.
+	URL string `json:"url"`
+}
+
+// ForgesResponse ...
+type ForgesResponse struct {
+	Forges []*Forge `json:"forges"`
+}
+
+// NormalizeForgeURL returns the forge (base) URL, with no trailing slash.
+func NormalizeForgeURL(urs string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(urs))
+	if err != nil {
+		return "", fmt.Errorf("invalid forge url %q", urs)
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid forge url %q", urs)
+	}
+	return u.Scheme + "://" + strings.ToLower(u.Host) + strings.TrimRight(u.Path, "/"), nil
+}
+
+// ForgeUser links a key to a user on a forge, the data for a forge sigchain
+// statement.
+//
+// The signed message (see ForgeUser.Sign) is in a file or snippet at URL.
+// A file has to be in the user's namespace on the forge, for example
+// https://git.example.com/alice/keys.pub/raw/branch/main/keyspub.txt (Gitea)
+// or https://git.example.com/alice/keys.pub/-/raw/main/keyspub.txt (GitLab).
+// A (GitLab) snippet, for example
+// https://git.example.com/-/snippets/1/raw/main/keyspub.txt, has to be owned by
+// the user, which is checked with the forge API.
+type ForgeUser struct {
+	Forge string `json:"forge"`
+	Name  string `json:"name"`
+	URL   string `json:"url,omitempty"`
+}
+
+// ID is name@host for the forge user.
+func (f *ForgeUser) ID() string {
+	u, err := url.Parse(f.Forge)
+	if err != nil {
+		return f.Name + "@" + f.Forge
+	}
+	return f.Name + "@" + u.Host
+}
+
+// Validate checks the forge, name and URL.
+func (f *ForgeUser) Validate() error {
+	forge, err := NormalizeForgeURL(f.Forge)
+	if err != nil {
+		return err
+	}
+	if forge != f.Forge {
+		return fmt.Errorf("forge url not normalized %q", f.Forge)
+	}
+	if f.Name == "" {
+		return errors.New("no forge username")
+	}
+	if f.Name != strings.ToLower(f.Name) || strings.ContainsAny(f.Name, "/?#@ ") {
+		return fmt.Errorf("invalid forge username %q", f.Name)
+	}
+	if f.URL == "" {
+		return nil
+	}
+	u, err := url.Parse(f.URL)
+	if err != nil || u.Fragment != "" || path.Clean(u.Path) != u.Path {
+		return fmt.Errorf("invalid forge user url %q", f.URL)
+	}
+	if strings.HasPrefix(f.URL, f.Forge+"/-/snippets/") {
+		if f.snippetID() == "" {
+			return fmt.Errorf("invalid forge snippet url %q", f.URL)
+		}
+		return nil
+	}
+	prefix := f.Forge + "/" + f.Name + "/"
+	if !strings.HasPrefix(f.URL, prefix) || len(f.URL) == len(prefix) {
+		return fmt.Errorf("forge user url %q is not in %s", f.URL, prefix)
+	}
+	return nil
+}
+
+// forgeSnippetRegex matches a (GitLab) snippet path on the forge.
+var forgeSnippetRegex = regexp.MustCompile(`^/-/snippets/([0-9]+)(/.*)?$`)
+
+// snippetID returns the snippet ID if the URL is a (GitLab) snippet on the
+// forge.
+func (f *ForgeUser) snippetID() string {
+	m := forgeSnippetRegex.FindStringSubmatch(strings.TrimPrefix(f.URL, f.Forge))
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// forgeSnippet is the (GitLab) snippet API response.
+type forgeSnippet struct {
+	Author struct {
+		Username string `json:"username"`
+	} `json:"author"`
+}
+
+// verifySnippetOwner checks the (GitLab) snippet is owned by the forge user.
+func verifySnippetOwner(ctx context.Context, client http.Client, f *ForgeUser, id string) (user.Status, error) {
+	status, b, err := services.Request(ctx, client, f.Forge+"/api/v4/snippets/"+id, nil)
+	if err != nil {
+		return status, err
+	}
+	var snippet forgeSnippet
+	if err := json.Unmarshal(b, &snippet); err != nil {
+		return user.StatusFailure, fmt.Errorf("invalid forge snippet response: %v", err)
+	}
+	if strings.ToLower(snippet.Author.Username) != f.Name {
+		return user.StatusStatementInvalid, fmt.Errorf("forge snippet %s is not owned by %s", id, f.ID())
+	}
+	return user.StatusOK, nil
+}
+
+// forgeMessage is the signed message for a forge user.
+type forgeMessage struct {
+	KID   keys.ID `json:"kid"`
+	Forge string  `json:"forge"`
+	Name  string  `json:"name"`
+}
+
+// Sign returns the (armored) signed message for the forge user, to publish
+// at the forge user URL.
+func (f *ForgeUser) Sign(key *keys.EdX25519Key) (string, error) {
+	b, err := json.Marshal(&forgeMessage{KID: key.ID(), Forge: f.Forge, Name: f.Name})
+	if err != nil {
+		return "", err
+	}
+	return encoding.EncodeSaltpack(key.Sign(b), ""), nil
+}
+
+// VerifyForgeUser requests the forge user URL and checks it has the signed
+// message for kid.
+func VerifyForgeUser(ctx context.Context, client http.Client, kid keys.ID, f *ForgeUser) (user.Status, error) {
+	if err := f.Validate(); err != nil {
+		return user.StatusFailure, err
+	}
+	if f.URL == "" {
+		return user.StatusFailure, errors.New("no forge user url")
+	}
+	if id := f.snippetID(); id != "" {
+		status, err := verifySnippetOwner(ctx, client, f, id)
+		if err != nil {
+			return status, err
+		}
+	}
+	status, b, err := services.Request(ctx, client, f.URL, nil)
+	if err != nil {
+		return status, err
+	}
+	msg, _ := encoding.FindSaltpack(string(b), false)
+	if msg == "" {
+		return user.StatusContentNotFound, errors.New("forge signed message content not found")
+	}
+	sig, _, err := encoding.DecodeSaltpack(fmt.Sprintf("BEGIN MESSAGE.\n%s\nEND MESSAGE.", msg), false)
+	if err != nil {
+		return user.StatusStatementInvalid, fmt.Errorf("invalid forge signed message: %v", err)
+	}
+	spk, err := keys.StatementPublicKeyFromID(kid)
+	if err != nil {
+		return user.StatusFailure, err
+	}
+	bout, err := spk.Verify(sig)
+	if err != nil {
+		return user.StatusStatementInvalid, fmt.Errorf("invalid forge signed message: %v", err)
+	}
+	var m forgeMessage
+	if err := json.Unmarshal(bout, &m); err != nil {
+		return user.StatusStatementInvalid, fmt.Errorf("invalid forge signed message: %v", err)
+	}
+	if m.KID != kid || m.Forge != f.Forge || m.Name != f.Name {
+		return user.StatusStatementInvalid, fmt.Errorf("forge signed message mismatch for %s", f.ID())
+	}
+	return user.StatusOK, nil
+}
+
+// ForgeUserResult is the status of a forge user, from the key server checks.
+type ForgeUserResult struct {
+	User      *ForgeUser  `json:"user"`
+	KID       keys.ID     `json:"kid"`
+	Seq       int         `json:"seq"`
+	Status    user.Status `json:"status"`
+	Err       string      `json:"err,omitempty"`
+	Timestamp int64       `json:"ts"`
+}
+
+// ForgeUsersResponse ...
+type ForgeUsersResponse struct {
+	Users []*ForgeUserResult `json:"users"`
+}
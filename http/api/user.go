@@ -63,3 +63,17 @@ type UserResponse struct {
 type UserSearchResponse struct {
 	Users []*User `json:"users"`
 }
+
+// UserStatusEvent is a change in user status, from a user check.
+type UserStatusEvent struct {
+	// User ID (name@service).
+	User      string      `json:"user"`
+	Status    user.Status `json:"status"`
+	Err       string      `json:"err,omitempty"`
+	Timestamp int64       `json:"ts"`
+}
+
+// UserHistoryResponse ...
+type UserHistoryResponse struct {
+	History []*UserStatusEvent `json:"history"`
+}
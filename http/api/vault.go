@@ -0,0 +1,15 @@
+package api
+
+// VaultQuota is the max size of a vault.
+// Zero values are unlimited.
+type VaultQuota struct {
+	MaxBytes  int64 `json:"maxBytes,omitempty" msgpack:"maxBytes,omitempty"`
+	MaxEvents int64 `json:"maxEvents,omitempty" msgpack:"maxEvents,omitempty"`
+}
+
+// VaultUsage is the size of a vault and its quota.
+type VaultUsage struct {
+	Bytes  int64 `json:"bytes"`
+	Events int64 `json:"events"`
+	VaultQuota
+}
@@ -71,6 +71,8 @@ type Error struct {
 	StatusCode int      `json:"code"`
 	Message    string   `json:"message"`
 	URL        *url.URL `json:"url,omitempty"`
+	// Usage if vault quota was exceeded.
+	Usage *api.VaultUsage `json:"usage,omitempty"`
 }
 
 func (e Error) Error() string {
@@ -110,6 +112,7 @@ func checkResponse(resp *http.Response) error {
 	}
 	if respVal.Error != nil {
 		err.Message = respVal.Error.Message
+		err.Usage = respVal.Error.Usage
 	}
 
 	return err
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/pkg/errors"
+)
+
+// Forges returns the forges registered (by an admin) on the server.
+func (c *Client) Forges(ctx context.Context) ([]*api.Forge, error) {
+	resp, err := c.Request(ctx, &Request{Method: "GET", Path: "/forges"})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.Errorf("/forges not found")
+	}
+	var out api.ForgesResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return out.Forges, nil
+}
+
+// ForgeUsers returns the forge users (from the server checks) for a key.
+// Returns nil if not found.
+func (c *Client) ForgeUsers(ctx context.Context, kid keys.ID) ([]*api.ForgeUserResult, error) {
+	resp, err := c.Request(ctx, &Request{Method: "GET", Path: "/forge/users/" + kid.String()})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var out api.ForgeUsersResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return out.Users, nil
+}
+
+// AdminForgeAdd registers a forge (base URL) by an admin.
+func (c *Client) AdminForgeAdd(ctx context.Context, forge string, admin *keys.EdX25519Key) error {
+	b, err := json.Marshal(&api.Forge{URL: forge})
+	if err != nil {
+		return err
+	}
+	if _, err := c.Request(ctx, &Request{Method: "PUT", Path: "/admin/forge", Body: b, Key: admin}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AdminForgeRemove removes a forge by an admin.
+func (c *Client) AdminForgeRemove(ctx context.Context, forge string, admin *keys.EdX25519Key) error {
+	params := url.Values{}
+	params.Add("url", forge)
+	if _, err := c.Request(ctx, &Request{Method: "DELETE", Path: "/admin/forge", Params: params, Key: admin}); err != nil {
+		return err
+	}
+	return nil
+}
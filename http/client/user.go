@@ -52,3 +52,21 @@ func (c *Client) User(ctx context.Context, kid keys.ID) (*api.UserResponse, erro
 	}
 	return &out, nil
 }
+
+// UserHistory returns user status changes for a key.
+// Returns nil if not found.
+func (c *Client) UserHistory(ctx context.Context, kid keys.ID) (*api.UserHistoryResponse, error) {
+	resp, err := c.Request(ctx, &Request{Method: "GET", Path: "/user/" + kid.String() + "/history"})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var out api.UserHistoryResponse
+	if err := json.Unmarshal(resp.Data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -130,3 +130,24 @@ func TestUser(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, resp)
 }
+
+func TestUserHistory(t *testing.T) {
+	env, closeFn := newEnv(t, server.NoLevel)
+	defer closeFn()
+
+	client := newTestClient(t, env)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	saveUser(t, env, client, alice, "alice", "github")
+
+	resp, err := client.UserHistory(context.TODO(), alice.ID())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.History))
+	require.Equal(t, "alice@github", resp.History[0].User)
+	require.Equal(t, user.StatusOK, resp.History[0].Status)
+
+	key := keys.GenerateEdX25519Key()
+	resp, err = client.UserHistory(context.TODO(), key.ID())
+	require.NoError(t, err)
+	require.Nil(t, resp)
+}
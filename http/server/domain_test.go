@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	khttp "github.com/keys-pub/keys/http"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/keys-pub/keys/user"
+	"github.com/stretchr/testify/require"
+)
+
+// domainUserMock returns a user statement for a domain (https) user and the
+// signed message, the .well-known URL returns 404 unless a file is set.
+func domainUserMock(t *testing.T, key *keys.EdX25519Key, domain string, file bool, client khttp.Client, clock tsutil.Clock) (*keys.Statement, string) {
+	url := fmt.Sprintf("https://%s/.well-known/keyspub.txt", domain)
+	sc := keys.NewSigchain(key.ID())
+	usr, err := user.New(key.ID(), "https", domain, url, sc.LastSeq()+1)
+	require.NoError(t, err)
+	st, err := user.NewSigchainStatement(sc, usr, key, clock.Now())
+	require.NoError(t, err)
+	msg, err := usr.Sign(key)
+	require.NoError(t, err)
+
+	client.SetProxy(api.DomainWellKnownURL(domain), func(ctx context.Context, req *khttp.Request) khttp.ProxyResponse {
+		if file {
+			return khttp.ProxyResponse{Body: []byte(msg)}
+		}
+		return khttp.ProxyResponse{Err: khttp.Err{Code: 404}}
+	})
+	client.SetProxy(url, func(ctx context.Context, req *khttp.Request) khttp.ProxyResponse {
+		return khttp.ProxyResponse{Err: khttp.Err{Code: 404}}
+	})
+	return st, msg
+}
+
+func TestUserDomain(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServerEnv(t, env)
+	resolver := api.TXTRecords{}
+	srv.Server.SetTXTResolver(resolver)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	charlie := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	userStatus := func(kid keys.ID) string {
+		req, err := http.NewRequest("GET", "/user/"+kid.String(), nil)
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code)
+		var resp struct {
+			User struct {
+				Status string `json:"status"`
+			} `json:"user"`
+		}
+		require.NoError(t, json.Unmarshal(body, &resp))
+		return resp.User.Status
+	}
+	put := func(st *keys.Statement) {
+		b, err := st.Bytes()
+		require.NoError(t, err)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/1", st.KID), bytes.NewReader(b))
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code, string(body))
+	}
+
+	// Alice (DNS TXT)
+	st, _ := domainUserMock(t, alice, "alice.example", false, env.client, env.clock)
+	resolver["alice.example"] = []string{"v=spf1 -all", api.DomainTXT(alice.ID())}
+	put(st)
+	require.Equal(t, "ok", userStatus(alice.ID()))
+
+	// Bob (.well-known)
+	st, _ = domainUserMock(t, bob, "bob.example", true, env.client, env.clock)
+	put(st)
+	require.Equal(t, "ok", userStatus(bob.ID()))
+
+	// Charlie (not found)
+	st, _ = domainUserMock(t, charlie, "charlie.example", false, env.client, env.clock)
+	put(st)
+	require.Equal(t, "resource-not-found", userStatus(charlie.ID()))
+
+	// Alice TXT record removed
+	delete(resolver, "alice.example")
+	req, err := http.NewRequest("POST", "/task/check/"+alice.ID().String(), nil)
+	require.NoError(t, err)
+	srv.Server.SetInternalAuth("testtoken")
+	req.Header.Set("Authorization", "testtoken")
+	code, _, _ := srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "resource-not-found", userStatus(alice.ID()))
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 )
@@ -13,7 +14,9 @@ import (
 type ErrHTTP struct {
 	Code    int    `json:"code,omitempty"`
 	Message string `json:"message,omitempty"`
-	Err     error  `json:"-"`
+	// Usage if vault quota was exceeded.
+	Usage *api.VaultUsage `json:"usage,omitempty"`
+	Err   error           `json:"-"`
 }
 
 func (e ErrHTTP) Error() string {
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/user"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// Forge users link a key to a user on a (self-hosted) Git forge registered by
+// an admin, see api.ForgeUser.
+
+func forgePath(forge string) string {
+	return dstore.Path("forges", url.QueryEscape(forge))
+}
+
+func forgeUserPath(kid keys.ID, seq int) string {
+	return dstore.Path("forge-users", keys.StatementID(kid, seq))
+}
+
+func (s *Server) forges(ctx context.Context) ([]*api.Forge, error) {
+	iter, err := s.fi.DocumentIterator(ctx, "forges")
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	forges := []*api.Forge{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			break
+		}
+		var forge api.Forge
+		if err := doc.To(&forge); err != nil {
+			return nil, err
+		}
+		forges = append(forges, &forge)
+	}
+	return forges, nil
+}
+
+func (s *Server) isForgeRegistered(ctx context.Context, forge string) (bool, error) {
+	return s.fi.Exists(ctx, forgePath(forge))
+}
+
+// forgeUserFromStatement returns the forge user for a forge statement.
+func forgeUserFromStatement(st *keys.Statement) (*api.ForgeUser, error) {
+	var fu api.ForgeUser
+	if err := json.Unmarshal(st.Data, &fu); err != nil {
+		return nil, errors.Wrapf(err, "invalid forge statement")
+	}
+	if err := fu.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid forge statement")
+	}
+	if fu.URL == "" {
+		return nil, errors.Errorf("invalid forge statement: no url")
+	}
+	return &fu, nil
+}
+
+// checkForgeStatement checks a forge statement is valid, for a registered
+// forge.
+func (s *Server) checkForgeStatement(ctx context.Context, st *keys.Statement) error {
+	fu, err := forgeUserFromStatement(st)
+	if err != nil {
+		return err
+	}
+	ok, err := s.isForgeRegistered(ctx, fu.Forge)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("forge not registered %s", fu.Forge)
+	}
+	return nil
+}
+
+// checkForgeUsers verifies the forge users in the sigchain and saves the
+// results.
+func (s *Server) checkForgeUsers(ctx context.Context, kid keys.ID) error {
+	sc, err := s.sigchains.Sigchain(kid)
+	if err != nil {
+		return err
+	}
+	for _, st := range sc.FindAll(api.ForgeStatementType) {
+		res := &api.ForgeUserResult{KID: kid, Seq: st.Seq, Timestamp: s.clock.NowMillis()}
+		fu, err := forgeUserFromStatement(st)
+		if err != nil {
+			res.Status, res.Err = user.StatusFailure, err.Error()
+		} else {
+			res.User = fu
+			res.Status, err = s.verifyForgeUser(ctx, kid, fu)
+			if err != nil {
+				res.Err = err.Error()
+			}
+		}
+		s.logger.Infof("Forge user %s %d: %s", kid, st.Seq, res.Status)
+		if err := s.fi.Set(ctx, forgeUserPath(kid, st.Seq), dstore.From(res)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) verifyForgeUser(ctx context.Context, kid keys.ID, fu *api.ForgeUser) (user.Status, error) {
+	ok, err := s.isForgeRegistered(ctx, fu.Forge)
+	if err != nil {
+		return user.StatusFailure, err
+	}
+	if !ok {
+		return user.StatusFailure, errors.Errorf("forge not registered %s", fu.Forge)
+	}
+	return api.VerifyForgeUser(ctx, s.client, kid, fu)
+}
+
+func (s *Server) getForges(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	forges, err := s.forges(ctx)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	resp := api.ForgesResponse{
+		Forges: forges,
+	}
+	return JSON(c, http.StatusOK, resp)
+}
+
+// getForgeUsers returns the (last checked) forge users for a key.
+func (s *Server) getForgeUsers(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	kid, err := keys.ParseID(c.Param("kid"))
+	if err != nil {
+		return s.ErrNotFound(c, errors.Errorf("user not found"))
+	}
+	sc, err := s.sigchains.Sigchain(kid)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	results := []*api.ForgeUserResult{}
+	for _, st := range sc.FindAll(api.ForgeStatementType) {
+		doc, err := s.fi.Get(ctx, forgeUserPath(kid, st.Seq))
+		if err != nil {
+			return s.ErrResponse(c, err)
+		}
+		if doc == nil {
+			continue
+		}
+		var res api.ForgeUserResult
+		if err := doc.To(&res); err != nil {
+			return s.ErrResponse(c, err)
+		}
+		results = append(results, &res)
+	}
+	if len(results) == 0 {
+		return s.ErrNotFound(c, errors.Errorf("user not found"))
+	}
+	resp := api.ForgeUsersResponse{
+		Users: results,
+	}
+	return JSON(c, http.StatusOK, resp)
+}
+
+// putForge (admin) registers a forge.
+func (s *Server) putForge(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	if c.Request().Body == nil {
+		return s.ErrBadRequest(c, errors.Errorf("no body data"))
+	}
+	b, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+
+	auth, err := s.auth(c, newAuthRequest("Authorization", "", b))
+	if err != nil {
+		return s.ErrForbidden(c, err)
+	}
+	if !s.isAdmin(auth.KID) {
+		return s.ErrForbidden(c, errors.Errorf("not authorized"))
+	}
+
+	var forge api.Forge
+	if err := json.Unmarshal(b, &forge); err != nil {
+		return s.ErrBadRequest(c, err)
+	}
+	urs, err := api.NormalizeForgeURL(forge.URL)
+	if err != nil {
+		return s.ErrBadRequest(c, err)
+	}
+	forge.URL = urs
+
+	s.logger.Infof("Forge %s", forge.URL)
+	if err := s.fi.Set(ctx, forgePath(forge.URL), dstore.From(forge)); err != nil {
+		return s.ErrResponse(c, err)
+	}
+
+	var resp struct{}
+	return JSON(c, http.StatusOK, resp)
+}
+
+// deleteForge (admin) removes a forge.
+// Forge users for the forge fail on their next check.
+func (s *Server) deleteForge(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuthRequest("Authorization", "", nil))
+	if err != nil {
+		return s.ErrForbidden(c, err)
+	}
+	if !s.isAdmin(auth.KID) {
+		return s.ErrForbidden(c, errors.Errorf("not authorized"))
+	}
+	urs, err := api.NormalizeForgeURL(c.QueryParam("url"))
+	if err != nil {
+		return s.ErrBadRequest(c, err)
+	}
+
+	ok, err := s.fi.Delete(ctx, forgePath(urs))
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if !ok {
+		return s.ErrNotFound(c, errors.Errorf("forge not found"))
+	}
+
+	var resp struct{}
+	return JSON(c, http.StatusOK, resp)
+}
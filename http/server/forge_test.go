@@ -0,0 +1,198 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/http"
+	"github.com/keys-pub/keys/user"
+	"github.com/stretchr/testify/require"
+)
+
+// testForge is a forge (Gitea/GitLab) stand-in serving raw files.
+type testForge struct {
+	*httptest.Server
+	files map[string]string
+}
+
+func newTestForge(t *testing.T) *testForge {
+	forge := &testForge{files: map[string]string{}}
+	forge.Server = httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, ok := forge.files[r.URL.Path]
+		if !ok {
+			nethttp.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(forge.Close)
+	return forge
+}
+
+func TestForgeUser(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServerEnv(t, env)
+	clock := env.clock
+	srv.Server.SetInternalAuth("testtoken")
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	admin := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	srv.Server.SetAdmins([]keys.ID{admin.ID()})
+
+	forge := newTestForge(t)
+	fu := &api.ForgeUser{Forge: forge.URL, Name: "alice", URL: forge.URL + "/alice/keys.pub/-/raw/main/keyspub.txt"}
+	msg, err := fu.Sign(alice)
+	require.NoError(t, err)
+
+	sc := keys.NewSigchain(alice.ID())
+	data, err := json.Marshal(fu)
+	require.NoError(t, err)
+	st, err := keys.NewSigchainStatement(sc, data, alice, api.ForgeStatementType, clock.Now())
+	require.NoError(t, err)
+	b, err := st.Bytes()
+	require.NoError(t, err)
+	putStatement := func() (int, []byte) {
+		req, err := http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/1", alice.ID()), bytes.NewReader(b))
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		return code, body
+	}
+	forgeUsers := func() []*api.ForgeUserResult {
+		req, err := http.NewRequest("GET", "/forge/users/"+alice.ID().String(), nil)
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code, string(body))
+		var resp api.ForgeUsersResponse
+		require.NoError(t, json.Unmarshal(body, &resp))
+		return resp.Users
+	}
+
+	// PUT /sigchain/:kid/:seq (forge not registered)
+	code, body := putStatement()
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"forge not registered `+forge.URL+`"}}`, string(body))
+
+	// PUT /admin/forge (not admin)
+	register := []byte(`{"url":"` + forge.URL + `/"}`)
+	req, err := http.NewAuthRequest("PUT", "/admin/forge", bytes.NewReader(register), http.ContentHash(register), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"not authorized"}}`, string(body))
+
+	// PUT /admin/forge
+	req, err = http.NewAuthRequest("PUT", "/admin/forge", bytes.NewReader(register), http.ContentHash(register), clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code, string(body))
+
+	// GET /forges
+	req, err = http.NewRequest("GET", "/forges", nil)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code, string(body))
+	require.Equal(t, `{"forges":[{"url":"`+forge.URL+`"}]}`, string(body))
+
+	// PUT /sigchain/:kid/:seq (no signed message on forge)
+	code, body = putStatement()
+	require.Equal(t, http.StatusOK, code, string(body))
+	users := forgeUsers()
+	require.Equal(t, 1, len(users))
+	require.Equal(t, user.StatusResourceNotFound, users[0].Status)
+	require.Equal(t, "alice@"+forge.Listener.Addr().String(), users[0].User.ID())
+
+	// Check (signed message on forge)
+	forge.files["/alice/keys.pub/-/raw/main/keyspub.txt"] = msg
+	check := func() {
+		req, err := http.NewRequest("POST", "/task/check/"+alice.ID().String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "testtoken")
+		code, _, _ := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code)
+	}
+	check()
+	users = forgeUsers()
+	require.Equal(t, user.StatusOK, users[0].Status)
+	require.Equal(t, "", users[0].Err)
+
+	// Signed message for a different user
+	other, err := (&api.ForgeUser{Forge: forge.URL, Name: "bob"}).Sign(alice)
+	require.NoError(t, err)
+	forge.files["/alice/keys.pub/-/raw/main/keyspub.txt"] = other
+	check()
+	users = forgeUsers()
+	require.Equal(t, user.StatusStatementInvalid, users[0].Status)
+	require.Equal(t, "forge signed message mismatch for alice@"+forge.Listener.Addr().String(), users[0].Err)
+	forge.files["/alice/keys.pub/-/raw/main/keyspub.txt"] = msg
+
+	// DELETE /admin/forge
+	req, err = http.NewAuthRequest("DELETE", "/admin/forge?url="+url.QueryEscape(forge.URL), nil, "", clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code, string(body))
+	check()
+	users = forgeUsers()
+	require.Equal(t, user.StatusFailure, users[0].Status)
+	require.Equal(t, "forge not registered "+forge.URL, users[0].Err)
+}
+
+func TestForgeUserValidate(t *testing.T) {
+	fu := &api.ForgeUser{Forge: "https://git.example.com", Name: "alice", URL: "https://git.example.com/alice/keys.pub/raw/branch/main/keyspub.txt"}
+	require.NoError(t, fu.Validate())
+
+	fu.URL = "https://git.example.com/bob/keys.pub/raw/branch/main/keyspub.txt"
+	require.EqualError(t, fu.Validate(), `forge user url "https://git.example.com/bob/keys.pub/raw/branch/main/keyspub.txt" is not in https://git.example.com/alice/`)
+
+	fu.URL = "https://git.example.com/alice/../bob/keyspub.txt"
+	require.EqualError(t, fu.Validate(), `invalid forge user url "https://git.example.com/alice/../bob/keyspub.txt"`)
+
+	fu.URL = "https://git.example.com/-/snippets/12/raw/main/keyspub.txt"
+	require.NoError(t, fu.Validate())
+
+	fu.URL = "https://git.example.com/-/snippets/alice/keyspub.txt"
+	require.EqualError(t, fu.Validate(), `invalid forge snippet url "https://git.example.com/-/snippets/alice/keyspub.txt"`)
+
+	fu.URL = ""
+	fu.Forge = "https://git.example.com/"
+	require.EqualError(t, fu.Validate(), `forge url not normalized "https://git.example.com/"`)
+
+	_, err := api.NormalizeForgeURL("ftp://git.example.com")
+	require.EqualError(t, err, `invalid forge url "ftp://git.example.com"`)
+}
+
+func TestForgeUserSnippet(t *testing.T) {
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	client := http.NewClient()
+	ctx := context.TODO()
+
+	forge := newTestForge(t)
+	fu := &api.ForgeUser{Forge: forge.URL, Name: "alice", URL: forge.URL + "/-/snippets/12/raw/main/keyspub.txt"}
+	msg, err := fu.Sign(alice)
+	require.NoError(t, err)
+	forge.files["/-/snippets/12/raw/main/keyspub.txt"] = msg
+
+	// Snippet not found (API)
+	status, err := api.VerifyForgeUser(ctx, client, alice.ID(), fu)
+	require.Error(t, err)
+	require.Equal(t, user.StatusResourceNotFound, status)
+
+	// Snippet owned by another user
+	forge.files["/api/v4/snippets/12"] = `{"id":12,"author":{"username":"mallory"}}`
+	status, err = api.VerifyForgeUser(ctx, client, alice.ID(), fu)
+	require.EqualError(t, err, "forge snippet 12 is not owned by alice@"+forge.Listener.Addr().String())
+	require.Equal(t, user.StatusStatementInvalid, status)
+
+	// Snippet owned by alice
+	forge.files["/api/v4/snippets/12"] = `{"id":12,"author":{"username":"Alice"}}`
+	status, err = api.VerifyForgeUser(ctx, client, alice.ID(), fu)
+	require.NoError(t, err)
+	require.Equal(t, user.StatusOK, status)
+}
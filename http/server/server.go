@@ -3,8 +3,10 @@ package server
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys/dstore"
 	"github.com/keys-pub/keys/dstore/events"
 	"github.com/keys-pub/keys/encoding"
@@ -37,6 +39,9 @@ type Server struct {
 	sigchains *keys.Sigchains
 	tasks     Tasks
 
+	// resolver for DNS TXT (domain user) proofs.
+	resolver api.TXTResolver
+
 	// internalAuth token for authorizing internal services.
 	internalAuth string
 
@@ -49,6 +54,9 @@ type Server struct {
 	// tokenKey for JWT vault tokens
 	tokenKey []byte
 
+	// defaultVaultQuota is the vault quota if not overridden by an admin.
+	defaultVaultQuota api.VaultQuota
+
 	emailer Emailer
 }
 
@@ -64,14 +72,16 @@ func New(fi Fire, rds Redis, client http.Client, clock tsutil.Clock, logger Logg
 
 	usrs := users.New(fi, sigchains, users.Client(client), users.Clock(clock))
 	return &Server{
-		fi:        fi,
-		rds:       rds,
-		client:    client,
-		clock:     clock,
-		tasks:     newUnsetTasks(),
-		sigchains: sigchains,
-		users:     usrs,
-		logger:    logger,
+		fi:                fi,
+		rds:               rds,
+		client:            client,
+		clock:             clock,
+		tasks:             newUnsetTasks(),
+		sigchains:         sigchains,
+		users:             usrs,
+		resolver:          net.DefaultResolver,
+		defaultVaultQuota: DefaultVaultQuota,
+		logger:            logger,
 	}
 }
 
@@ -126,6 +136,17 @@ func (s *Server) SetTasks(tasks Tasks) {
 	s.tasks = tasks
 }
 
+// SetTXTResolver sets the resolver for DNS TXT (domain user) proofs.
+func (s *Server) SetTXTResolver(resolver api.TXTResolver) {
+	s.resolver = resolver
+}
+
+// SetVaultQuota sets the default vault quota.
+// Admins can override the quota for a vault.
+func (s *Server) SetVaultQuota(quota api.VaultQuota) {
+	s.defaultVaultQuota = quota
+}
+
 // NewHandler returns http.Handler for Server.
 func NewHandler(s *Server) http.Handler {
 	return newHandler(s)
@@ -148,6 +169,11 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 
 	e.GET("/user/search", s.getUserSearch)
 	e.GET("/user/:user", s.getUser)
+	e.GET("/user/:user/history", s.getUserHistory)
+
+	// Forge
+	e.GET("/forges", s.getForges)
+	e.GET("/forge/users/:kid", s.getForgeUsers)
 
 	// Tasks
 	e.POST("/task/check/:kid", s.taskCheck)
@@ -160,6 +186,7 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 	e.GET("/vault/:vid", s.listVault)
 	e.DELETE("/vault/:vid", s.deleteVault)
 	e.HEAD("/vault/:vid", s.headVault)
+	e.GET("/vault/:vid/usage", s.getVaultUsage)
 
 	// Disco
 	e.PUT("/disco/:kid/:rid/:type", s.putDisco)
@@ -182,6 +209,10 @@ func (s *Server) AddRoutes(e *echo.Echo) {
 
 	// Admin
 	e.POST("/admin/check/:kid", s.adminCheck)
+	e.PUT("/admin/vault/:vid/quota", s.putVaultQuota)
+	e.DELETE("/admin/vault/:vid/quota", s.deleteVaultQuota)
+	e.PUT("/admin/forge", s.putForge)
+	e.DELETE("/admin/forge", s.deleteForge)
 
 	// Sigchain (aliases)
 	e.GET("/:kid", s.getSigchainAliased)
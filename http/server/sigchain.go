@@ -141,6 +141,11 @@ func (s *Server) putSigchainStatement(c echo.Context) error {
 	if st.Seq <= 0 {
 		return s.ErrBadRequest(c, errors.Errorf("invalid seq"))
 	}
+	if st.Type == api.ForgeStatementType {
+		if err := s.checkForgeStatement(ctx, st); err != nil {
+			return s.ErrBadRequest(c, err)
+		}
+	}
 
 	path := dstore.Path("sigchain", keys.StatementID(st.KID, st.Seq))
 
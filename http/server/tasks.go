@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys/user"
+	"github.com/keys-pub/keys/users"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 )
@@ -77,11 +79,17 @@ func (s *Server) taskCheck(c echo.Context) error {
 		return s.ErrBadRequest(c, err)
 	}
 
-	res, err := s.users.Update(ctx, kid)
+	res, err := s.users.Update(ctx, kid, users.UseService(api.DomainLookup(s.resolver)))
 	if err != nil {
 		return s.ErrResponse(c, err)
 	}
 	s.logger.Debugf("User result: %v", res)
+	if err := s.recordUserStatus(ctx, res); err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if err := s.checkForgeUsers(ctx, kid); err != nil {
+		return s.ErrResponse(c, err)
+	}
 	return c.String(http.StatusOK, "")
 }
 
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/user"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// userHistory returns user status events for a key, oldest first.
+func (s *Server) userHistory(ctx context.Context, kid keys.ID) ([]*api.UserStatusEvent, error) {
+	iter, err := s.fi.DocumentIterator(ctx, "user-history", dstore.Prefix(kid.String()+"-"))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+
+	history := []*api.UserStatusEvent{}
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			break
+		}
+		var event api.UserStatusEvent
+		if err := doc.To(&event); err != nil {
+			return nil, err
+		}
+		history = append(history, &event)
+	}
+	return history, nil
+}
+
+// maxUserHistory is the number of user status events kept for a key.
+const maxUserHistory = 100
+
+// userStatus is the last user status event for a key, so we don't read the
+// history on every check.
+type userStatus struct {
+	User   string      `json:"user"`
+	Status user.Status `json:"status"`
+	// Index of the event in the history.
+	Index int64 `json:"index"`
+}
+
+func userHistoryPath(kid keys.ID, index int64) string {
+	return dstore.Path("user-history", fmt.Sprintf("%s-%015d", kid, index))
+}
+
+// recordUserStatus saves a user status event if the status (or user) changed
+// since the last check.
+// Events are keyed by index (not timestamp) and only the last maxUserHistory
+// events are kept.
+func (s *Server) recordUserStatus(ctx context.Context, res *user.Result) error {
+	if res == nil || res.User == nil {
+		return nil
+	}
+	kid := res.User.KID
+	event := api.UserStatusEvent{
+		User:      res.User.ID(),
+		Status:    res.Status,
+		Err:       res.Err,
+		Timestamp: res.Timestamp,
+	}
+
+	var last userStatus
+	lastPath := dstore.Path("user-status", kid)
+	ok, err := s.fi.Load(ctx, lastPath, &last)
+	if err != nil {
+		return err
+	}
+	if ok && last.User == event.User && last.Status == event.Status {
+		return nil
+	}
+	next := userStatus{User: event.User, Status: event.Status}
+	if ok {
+		next.Index = last.Index + 1
+	}
+
+	s.logger.Infof("User status %s %s: %s", kid, event.User, event.Status)
+	// Create fails if a concurrent check already recorded this index.
+	if err := s.fi.Create(ctx, userHistoryPath(kid, next.Index), dstore.From(event)); err != nil {
+		return err
+	}
+	if err := s.fi.Set(ctx, lastPath, dstore.From(next)); err != nil {
+		return err
+	}
+	if next.Index >= maxUserHistory {
+		if _, err := s.fi.Delete(ctx, userHistoryPath(kid, next.Index-maxUserHistory)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) getUserHistory(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	param := strings.TrimSpace(c.Param("user"))
+
+	var kid keys.ID
+	userID := ""
+	if strings.Contains(param, "@") {
+		ur, err := s.users.User(ctx, param)
+		if err != nil {
+			return s.ErrResponse(c, err)
+		}
+		if ur == nil {
+			return s.ErrNotFound(c, errors.Errorf("user not found"))
+		}
+		kid = ur.User.KID
+		userID = ur.User.ID()
+	} else {
+		k, err := keys.ParseID(param)
+		if err != nil {
+			return s.ErrNotFound(c, errors.Errorf("user not found"))
+		}
+		kid = k
+	}
+
+	history, err := s.userHistory(ctx, kid)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if userID != "" {
+		filtered := []*api.UserStatusEvent{}
+		for _, event := range history {
+			if event.User == userID {
+				filtered = append(filtered, event)
+			}
+		}
+		history = filtered
+	}
+	if len(history) == 0 {
+		return s.ErrNotFound(c, errors.Errorf("user not found"))
+	}
+
+	resp := api.UserHistoryResponse{
+		History: history,
+	}
+	return JSON(c, http.StatusOK, resp)
+}
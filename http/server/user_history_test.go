@@ -0,0 +1,98 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHistory(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServerEnv(t, env)
+	resolver := api.TXTRecords{}
+	srv.Server.SetTXTResolver(resolver)
+	srv.Server.SetInternalAuth("testtoken")
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	check := func() {
+		req, err := http.NewRequest("POST", "/task/check/"+alice.ID().String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "testtoken")
+		code, _, _ := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code)
+	}
+	history := func(param string) []*api.UserStatusEvent {
+		req, err := http.NewRequest("GET", "/user/"+param+"/history", nil)
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		require.Equal(t, http.StatusOK, code, string(body))
+		var resp api.UserHistoryResponse
+		require.NoError(t, json.Unmarshal(body, &resp))
+		return resp.History
+	}
+
+	// Not found
+	req, err := http.NewRequest("GET", "/user/"+alice.ID().String()+"/history", nil)
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"user not found"}}`, string(body))
+
+	st, _ := domainUserMock(t, alice, "alice.example", false, env.client, env.clock)
+	txt := api.DomainTXT(alice.ID())
+	resolver["alice.example"] = []string{txt}
+	b, err := st.Bytes()
+	require.NoError(t, err)
+	req, err = http.NewRequest("PUT", fmt.Sprintf("/sigchain/%s/1", alice.ID()), bytes.NewReader(b))
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code, string(body))
+
+	// Same status (no change)
+	check()
+
+	// Taken down, then back
+	delete(resolver, "alice.example")
+	check()
+	check()
+	resolver["alice.example"] = []string{txt}
+	check()
+
+	events := history(alice.ID().String())
+	require.Equal(t, 3, len(events))
+	require.Equal(t, "alice.example@https", events[0].User)
+	require.Equal(t, user.StatusOK, events[0].Status)
+	require.Equal(t, user.StatusResourceNotFound, events[1].Status)
+	require.NotEmpty(t, events[1].Err)
+	require.Equal(t, user.StatusOK, events[2].Status)
+	require.True(t, events[0].Timestamp < events[1].Timestamp)
+	require.True(t, events[1].Timestamp < events[2].Timestamp)
+
+	require.Equal(t, events, history("alice.example@https"))
+
+	// Only the last 100 events are kept
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			delete(resolver, "alice.example")
+		} else {
+			resolver["alice.example"] = []string{txt}
+		}
+		check()
+	}
+	events = history(alice.ID().String())
+	require.Equal(t, 100, len(events))
+	require.Equal(t, user.StatusResourceNotFound, events[0].Status)
+	require.Equal(t, user.StatusOK, events[1].Status)
+	require.Equal(t, user.StatusOK, events[99].Status)
+	for i := 1; i < len(events); i++ {
+		require.True(t, events[i-1].Timestamp < events[i].Timestamp)
+	}
+}
@@ -60,8 +60,6 @@ func (s *Server) postVault(c echo.Context) error {
 	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
 	ctx := c.Request().Context()
 
-	// TODO: max vault size
-
 	if c.Request().Body == nil {
 		return s.ErrBadRequest(c, errors.Errorf("no body data"))
 	}
@@ -92,20 +90,23 @@ func (s *Server) postVault(c echo.Context) error {
 	docs := make([]events.Document, 0, len(req))
 	for _, d := range req {
 		docs = append(docs, dstore.Data(d.Data))
+		total += int64(len(d.Data))
+	}
+
+	if err := s.reserveVaultQuota(ctx, auth.KID, int64(len(req)), total); err != nil {
+		return s.ErrResponse(c, err)
 	}
 
 	path := dstore.Path("vaults", auth.KID)
 
 	if _, err := s.fi.EventsAdd(ctx, path, docs); err != nil {
+		if rerr := s.releaseVaultQuota(ctx, auth.KID, int64(len(req)), total); rerr != nil {
+			s.logger.Errorf("Failed to release vault quota: %v", rerr)
+		}
 		return err
 	}
-
-	// Increment usage
-	for _, d := range req {
-		total += int64(len(d.Data))
-	}
-	if _, _, err := s.fi.Increment(ctx, path, "usage", total); err != nil {
-		return s.ErrResponse(c, err)
+	if err := s.addedVaultQuota(ctx, auth.KID, int64(len(req))); err != nil {
+		s.logger.Errorf("Failed to update vault quota: %v", err)
 	}
 
 	// If we have a vault token, notify.
@@ -144,6 +145,7 @@ func (s *Server) deleteVault(c echo.Context) error {
 		return s.ErrResponse(c, err)
 	}
 
+	// Deleting the vault document also frees its usage (see Vault).
 	cpath := dstore.Path("vaults", auth.KID)
 	exists, err := s.fi.EventsDelete(ctx, cpath)
 	if err != nil {
@@ -206,6 +208,13 @@ type Vault struct {
 
 	Index     int64 `json:"idx,omitempty" msgpack:"idx,omitempty"`
 	Timestamp int64 `json:"ts,omitempty" msgpack:"ts,omitempty"`
+	// Usage is the total size of vault data (in bytes).
+	Usage int64 `json:"usage,omitempty" msgpack:"usage,omitempty"`
+	// Pending is the number of events reserved by posts in progress, for the
+	// vault quota, see reserveVaultQuota.
+	// Vault events are only removed with the vault, so Index, the last event
+	// index, is also the number of events.
+	Pending int64 `json:"pending,omitempty" msgpack:"pending,omitempty"`
 }
 
 // VaultResponse ...
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// DefaultVaultQuota is the default max vault size.
+var DefaultVaultQuota = api.VaultQuota{
+	MaxBytes:  100 * 1024 * 1024,
+	MaxEvents: 100000,
+}
+
+// vaultQuota returns the quota for a vault, the admin override or the
+// default.
+func (s *Server) vaultQuota(ctx context.Context, kid keys.ID) (api.VaultQuota, error) {
+	doc, err := s.fi.Get(ctx, dstore.Path("vault-quotas", kid))
+	if err != nil {
+		return api.VaultQuota{}, err
+	}
+	if doc == nil {
+		return s.defaultVaultQuota, nil
+	}
+	var quota api.VaultQuota
+	if err := doc.To(&quota); err != nil {
+		return api.VaultQuota{}, err
+	}
+	return quota, nil
+}
+
+// vaultUsage returns the vault usage, or nil if vault not found.
+func (s *Server) vaultUsage(ctx context.Context, kid keys.ID) (*api.VaultUsage, error) {
+	quota, err := s.vaultQuota(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := s.fi.Get(ctx, dstore.Path("vaults", kid))
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	var vault Vault
+	if err := doc.To(&vault); err != nil {
+		return nil, err
+	}
+	return &api.VaultUsage{
+		Bytes:      vault.Usage,
+		Events:     vault.Index,
+		VaultQuota: quota,
+	}, nil
+}
+
+// reserveVaultQuota reserves n events of size bytes in the vault usage, or
+// returns an error (413) if that would exceed the vault quota.
+//
+// The usage is reserved with (atomic) increments, so concurrent posts can't
+// both fit in the remaining quota. Bytes are added to the vault usage and
+// events to the vault pending count, until they are added (see
+// addedVaultQuota). If over quota, the reservation is released.
+func (s *Server) reserveVaultQuota(ctx context.Context, kid keys.ID, n int64, size int64) error {
+	quota, err := s.vaultQuota(ctx, kid)
+	if err != nil {
+		return err
+	}
+	path := dstore.Path("vaults", kid)
+
+	var msg string
+	if size > 0 {
+		bytes, _, err := s.fi.Increment(ctx, path, "usage", size)
+		if err != nil {
+			return err
+		}
+		if quota.MaxBytes > 0 && bytes > quota.MaxBytes {
+			msg = "vault quota exceeded (bytes)"
+			n = 0
+		}
+	}
+	if msg == "" && n > 0 {
+		pending, _, err := s.fi.Increment(ctx, path, "pending", n)
+		if err != nil {
+			if rerr := s.releaseVaultQuota(ctx, kid, 0, size); rerr != nil {
+				s.logger.Errorf("Failed to release vault quota: %v", rerr)
+			}
+			return err
+		}
+		// Read the index after reserving, so events added by a concurrent post
+		// are either pending or in the index (or both), never missed.
+		index, err := s.vaultIndex(ctx, kid)
+		if err != nil {
+			if rerr := s.releaseVaultQuota(ctx, kid, n, size); rerr != nil {
+				s.logger.Errorf("Failed to release vault quota: %v", rerr)
+			}
+			return err
+		}
+		if quota.MaxEvents > 0 && index+pending > quota.MaxEvents {
+			msg = "vault quota exceeded (events)"
+		}
+	}
+	if msg == "" {
+		return nil
+	}
+
+	if err := s.releaseVaultQuota(ctx, kid, n, size); err != nil {
+		return err
+	}
+	usage, err := s.vaultUsage(ctx, kid)
+	if err != nil {
+		return err
+	}
+	if usage == nil {
+		usage = &api.VaultUsage{VaultQuota: quota}
+	}
+	return &ErrHTTP{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: msg,
+		Usage:   usage,
+	}
+}
+
+// addedVaultQuota removes n (reserved) events from the vault pending count,
+// after they were added to the vault.
+func (s *Server) addedVaultQuota(ctx context.Context, kid keys.ID, n int64) error {
+	if n == 0 {
+		return nil
+	}
+	_, _, err := s.fi.Increment(ctx, dstore.Path("vaults", kid), "pending", -n)
+	return err
+}
+
+// releaseVaultQuota releases n events of size bytes reserved with
+// reserveVaultQuota.
+func (s *Server) releaseVaultQuota(ctx context.Context, kid keys.ID, n int64, size int64) error {
+	if size > 0 {
+		if _, _, err := s.fi.Increment(ctx, dstore.Path("vaults", kid), "usage", -size); err != nil {
+			return err
+		}
+	}
+	return s.addedVaultQuota(ctx, kid, n)
+}
+
+func (s *Server) vaultIndex(ctx context.Context, kid keys.ID) (int64, error) {
+	doc, err := s.fi.Get(ctx, dstore.Path("vaults", kid))
+	if err != nil {
+		return 0, err
+	}
+	if doc == nil {
+		return 0, nil
+	}
+	var vault Vault
+	if err := doc.To(&vault); err != nil {
+		return 0, err
+	}
+	return vault.Index, nil
+}
+
+func (s *Server) getVaultUsage(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuthRequest("Authorization", "vid", nil))
+	if err != nil {
+		return s.ErrForbidden(c, err)
+	}
+
+	deleted, err := s.isVaultDeleted(c, auth.KID)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if deleted {
+		return s.ErrNotFound(c, errVaultDeleted)
+	}
+
+	usage, err := s.vaultUsage(ctx, auth.KID)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if usage == nil {
+		return s.ErrNotFound(c, errVaultNotFound)
+	}
+	return JSON(c, http.StatusOK, usage)
+}
+
+// putVaultQuota (admin) overrides the quota for a vault.
+func (s *Server) putVaultQuota(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	if c.Request().Body == nil {
+		return s.ErrBadRequest(c, errors.Errorf("no body data"))
+	}
+	b, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+
+	auth, err := s.auth(c, newAuthRequest("Authorization", "", b))
+	if err != nil {
+		return s.ErrForbidden(c, err)
+	}
+	if !s.isAdmin(auth.KID) {
+		return s.ErrForbidden(c, errors.Errorf("not authorized"))
+	}
+	vid, err := keys.ParseID(c.Param("vid"))
+	if err != nil {
+		return s.ErrBadRequest(c, errors.Errorf("invalid vid"))
+	}
+
+	var quota api.VaultQuota
+	if err := json.Unmarshal(b, &quota); err != nil {
+		return s.ErrBadRequest(c, err)
+	}
+	if quota.MaxBytes < 0 || quota.MaxEvents < 0 {
+		return s.ErrBadRequest(c, errors.Errorf("invalid quota"))
+	}
+
+	s.logger.Infof("Vault quota %s: %+v", vid, quota)
+	if err := s.fi.Set(ctx, dstore.Path("vault-quotas", vid), dstore.From(quota)); err != nil {
+		return s.ErrResponse(c, err)
+	}
+
+	var resp struct{}
+	return JSON(c, http.StatusOK, resp)
+}
+
+// deleteVaultQuota (admin) removes the quota override for a vault.
+func (s *Server) deleteVaultQuota(c echo.Context) error {
+	s.logger.Infof("Server %s %s", c.Request().Method, c.Request().URL.String())
+	ctx := c.Request().Context()
+
+	auth, err := s.auth(c, newAuthRequest("Authorization", "", nil))
+	if err != nil {
+		return s.ErrForbidden(c, err)
+	}
+	if !s.isAdmin(auth.KID) {
+		return s.ErrForbidden(c, errors.Errorf("not authorized"))
+	}
+	vid, err := keys.ParseID(c.Param("vid"))
+	if err != nil {
+		return s.ErrBadRequest(c, errors.Errorf("invalid vid"))
+	}
+
+	ok, err := s.fi.Delete(ctx, dstore.Path("vault-quotas", vid))
+	if err != nil {
+		return s.ErrResponse(c, err)
+	}
+	if !ok {
+		return s.ErrNotFound(c, errors.Errorf("vault quota not found"))
+	}
+
+	var resp struct{}
+	return JSON(c, http.StatusOK, resp)
+}
@@ -0,0 +1,158 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultQuota(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServerEnv(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	admin := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	srv.Server.SetAdmins([]keys.ID{admin.ID()})
+	srv.Server.SetVaultQuota(api.VaultQuota{MaxBytes: 10, MaxEvents: 3})
+
+	postVault := func(data ...string) (int, []byte) {
+		vault := []*api.Data{}
+		for _, d := range data {
+			vault = append(vault, &api.Data{Data: []byte(d)})
+		}
+		b, err := json.Marshal(vault)
+		require.NoError(t, err)
+		req, err := http.NewAuthRequest("POST", dstore.Path("vault", alice.ID()), bytes.NewReader(b), http.ContentHash(b), clock.Now(), alice)
+		require.NoError(t, err)
+		code, _, body := srv.Serve(req)
+		return code, body
+	}
+
+	// GET /vault/:kid/usage (not found)
+	req, err := http.NewAuthRequest("GET", dstore.Path("vault", alice.ID(), "usage"), nil, "", clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"vault not found"}}`, string(body))
+
+	// POST /vault/:kid (too large)
+	code, body = postVault("12345678901")
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"vault quota exceeded (bytes)","usage":{"bytes":0,"events":0,"maxBytes":10,"maxEvents":3}}}`, string(body))
+
+	// GET /vault/:kid/usage (after rejected post)
+	req, err = http.NewAuthRequest("GET", dstore.Path("vault", alice.ID(), "usage"), nil, "", clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{"bytes":0,"events":0,"maxBytes":10,"maxEvents":3}`, string(body))
+
+	// POST /vault/:kid
+	code, body = postVault("test1", "test2")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, string(body))
+
+	// GET /vault/:kid/usage
+	req, err = http.NewAuthRequest("GET", dstore.Path("vault", alice.ID(), "usage"), nil, "", clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{"bytes":10,"events":2,"maxBytes":10,"maxEvents":3}`, string(body))
+
+	// POST /vault/:kid (bytes exceeded)
+	code, body = postVault("a")
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"vault quota exceeded (bytes)","usage":{"bytes":10,"events":2,"maxBytes":10,"maxEvents":3}}}`, string(body))
+
+	// PUT /admin/vault/:vid/quota (not admin)
+	quota := []byte(`{"maxBytes":100,"maxEvents":3}`)
+	req, err = http.NewAuthRequest("PUT", dstore.Path("admin", "vault", alice.ID(), "quota"), bytes.NewReader(quota), http.ContentHash(quota), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, `{"error":{"code":403,"message":"not authorized"}}`, string(body))
+
+	// PUT /admin/vault/:vid/quota
+	req, err = http.NewAuthRequest("PUT", dstore.Path("admin", "vault", alice.ID(), "quota"), bytes.NewReader(quota), http.ContentHash(quota), clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, string(body))
+
+	// POST /vault/:kid
+	code, body = postVault("a")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, string(body))
+
+	// POST /vault/:kid (events exceeded)
+	code, body = postVault("b")
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"vault quota exceeded (events)","usage":{"bytes":11,"events":3,"maxBytes":100,"maxEvents":3}}}`, string(body))
+
+	// PUT /admin/vault/:vid/quota (invalid)
+	invalid := []byte(`{"maxBytes":-1}`)
+	req, err = http.NewAuthRequest("PUT", dstore.Path("admin", "vault", alice.ID(), "quota"), bytes.NewReader(invalid), http.ContentHash(invalid), clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, `{"error":{"code":400,"message":"invalid quota"}}`, string(body))
+
+	// DELETE /admin/vault/:vid/quota
+	req, err = http.NewAuthRequest("DELETE", dstore.Path("admin", "vault", alice.ID(), "quota"), nil, "", clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{}`, string(body))
+
+	// GET /vault/:kid/usage (default quota)
+	req, err = http.NewAuthRequest("GET", dstore.Path("vault", alice.ID(), "usage"), nil, "", clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, `{"bytes":11,"events":3,"maxBytes":10,"maxEvents":3}`, string(body))
+
+	// DELETE /admin/vault/:vid/quota (not found)
+	req, err = http.NewAuthRequest("DELETE", dstore.Path("admin", "vault", alice.ID(), "quota"), nil, "", clock.Now(), admin)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusNotFound, code)
+	require.Equal(t, `{"error":{"code":404,"message":"vault quota not found"}}`, string(body))
+
+	// DELETE /vault/:kid
+	req, err = http.NewAuthRequest("DELETE", dstore.Path("vault", alice.ID()), nil, "", clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body = srv.Serve(req)
+	require.Equal(t, http.StatusOK, code, string(body))
+	doc, err := env.fi.Get(context.TODO(), dstore.Path("vaults", alice.ID()))
+	require.NoError(t, err)
+	require.Nil(t, doc)
+}
+
+func TestVaultQuotaExisting(t *testing.T) {
+	env := newEnv(t)
+	srv := newTestServerEnv(t, env)
+	clock := env.clock
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	srv.Server.SetVaultQuota(api.VaultQuota{MaxBytes: 100, MaxEvents: 3})
+
+	// Vault from before quotas (no pending count)
+	err := env.fi.Set(context.TODO(), dstore.Path("vaults", alice.ID()), map[string]interface{}{"idx": int64(3), "usage": int64(3)})
+	require.NoError(t, err)
+
+	// POST /vault/:kid (events exceeded)
+	b := []byte(`[{"data":"YQ=="}]`)
+	req, err := http.NewAuthRequest("POST", dstore.Path("vault", alice.ID()), bytes.NewReader(b), http.ContentHash(b), clock.Now(), alice)
+	require.NoError(t, err)
+	code, _, body := srv.Serve(req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.Equal(t, `{"error":{"code":413,"message":"vault quota exceeded (events)","usage":{"bytes":3,"events":3,"maxBytes":100,"maxEvents":3}}}`, string(body))
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"archive/tar"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// writeArchive writes a tar archive of the directory.
+// Only directories and regular files are included, other files (symlinks,
+// devices) are skipped.
+func writeArchive(w io.Writer, dir string) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+
+		if !fi.IsDir() && !fi.Mode().IsRegular() {
+			logger.Warningf("Skipping %s (not a regular file)", name)
+			return nil
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = name
+		if fi.IsDir() {
+			hdr.Name += "/"
+		}
+		// Don't include user/group info.
+		hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(p) // #nosec
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		if _, err := io.Copy(tw, f); err != nil {
+			return err
+		}
+		return f.Close()
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extractArchive extracts a tar archive to a (new) directory.
+// The archive is extracted to a temporary directory which is renamed when
+// complete.
+func extractArchive(r io.Reader, dir string) error {
+	exists, err := pathExists(dir)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.Errorf("%s already exists", dir)
+	}
+	dirTmp := dir + ".tmp"
+	if err := os.Mkdir(dirTmp, 0700); err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(dirTmp)
+	}()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name, err := archivePath(hdr.Name)
+		if err != nil {
+			return err
+		}
+		p := filepath.Join(dirTmp, name)
+		perm := os.FileMode(hdr.Mode).Perm() & 0755
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(p, perm|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+				return err
+			}
+			if err := extractArchiveFile(tr, p, perm|0600); err != nil {
+				return err
+			}
+		default:
+			return errors.Errorf("unsupported archive entry %s", hdr.Name)
+		}
+	}
+	// Read to the end, so an encrypted (stream) reader can check the message is
+	// complete before we keep the files.
+	if _, err := io.Copy(ioutil.Discard, r); err != nil {
+		return err
+	}
+
+	return os.Rename(dirTmp, dir)
+}
+
+func extractArchiveFile(r io.Reader, p string, perm os.FileMode) error {
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm) // #nosec
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// archivePath returns the (relative) path for an archive entry, or an error
+// if the entry would be outside the directory.
+func archivePath(name string) (string, error) {
+	if name == "" || strings.Contains(name, "\\") || path.IsAbs(name) {
+		return "", errors.Errorf("invalid archive path %q", name)
+	}
+	clean := path.Clean(name)
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", errors.Errorf("invalid archive path %q", name)
+	}
+	return filepath.FromSlash(clean), nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecryptArchive(t *testing.T) {
+	env := newTestEnv(t)
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testImportID(t, bobService, alice.ID())
+
+	tmpDir := keys.RandTempPath()
+	defer os.RemoveAll(tmpDir)
+	inDir := filepath.Join(tmpDir, "config")
+	require.NoError(t, os.MkdirAll(filepath.Join(inDir, "sub", "empty"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(inDir, "a.txt"), []byte("a"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(inDir, "sub", "b.txt"), []byte("b"), 0600))
+
+	aliceClient, aliceClientCloseFn := newTestRPCClient(t, aliceService, env, "", nil)
+	defer aliceClientCloseFn()
+
+	err := encryptFile(aliceClient, inDir, "", []string{bob.ID().String()}, alice.ID().String(), &EncryptOptions{})
+	require.NoError(t, err)
+	encPath := inDir + ".tar.enc"
+	exists, err := pathExists(encPath)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	bobClient, bobClientCloseFn := newTestRPCClient(t, bobService, env, "", nil)
+	defer bobClientCloseFn()
+
+	// Decrypt (config exists, so output is config-2)
+	dec, err := decryptFile(bobClient, encPath, "", true)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), dec.Sender.ID)
+	require.Equal(t, inDir+"-2", dec.Out)
+
+	b, err := ioutil.ReadFile(filepath.Join(dec.Out, "a.txt"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), b)
+	b, err = ioutil.ReadFile(filepath.Join(dec.Out, "sub", "b.txt"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), b)
+	dir, err := isDir(filepath.Join(dec.Out, "sub", "empty"))
+	require.NoError(t, err)
+	require.True(t, dir)
+
+}
+
+func TestExtractArchiveUnsafe(t *testing.T) {
+	for _, name := range []string{"../evil", "/etc/evil", "a/../../evil", "..", "a\\..\\evil"} {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: 1, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("x"))
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+
+		dir := keys.RandTempPath()
+		err = extractArchive(&buf, dir)
+		require.EqualError(t, err, fmt.Sprintf("invalid archive path %q", name))
+		exists, err := pathExists(dir)
+		require.NoError(t, err)
+		require.False(t, exists)
+		exists, err = pathExists(dir + ".tmp")
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+
+	// Symlinks are not supported
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}))
+	require.NoError(t, tw.Close())
+	err := extractArchive(&buf, keys.RandTempPath())
+	require.EqualError(t, err, "unsupported archive entry link")
+}
+
+func TestExtractArchiveIncomplete(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "a.txt", Mode: 0600, Size: 1, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("a"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	// Error after the archive, for example an encrypted stream that was
+	// truncated.
+	r := io.MultiReader(&buf, errReader{errors.Errorf("unexpected EOF")})
+	dir := keys.RandTempPath()
+	err = extractArchive(r, dir)
+	require.EqualError(t, err, "unexpected EOF")
+	exists, err := pathExists(dir)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/pkg/errors"
+)
+
+// signatureBundle is a set of (armored) detached saltpack signatures for the
+// same data.
+type signatureBundle struct {
+	Signatures []*bundleSignature `json:"signatures"`
+}
+
+type bundleSignature struct {
+	KID keys.ID `json:"kid"`
+	Sig string  `json:"sig"`
+}
+
+func decodeSignatureBundle(b []byte) (*signatureBundle, error) {
+	var bundle signatureBundle
+	if err := json.Unmarshal(b, &bundle); err != nil {
+		return nil, errors.Wrapf(err, "invalid signature bundle")
+	}
+	return &bundle, nil
+}
+
+func (b *signatureBundle) encode() ([]byte, error) {
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// add signature, replacing any existing signature for the same key.
+func (b *signatureBundle) add(kid keys.ID, sig string) {
+	for _, s := range b.Signatures {
+		if s.KID == kid {
+			s.Sig = sig
+			return
+		}
+	}
+	b.Signatures = append(b.Signatures, &bundleSignature{KID: kid, Sig: sig})
+}
+
+// SignBundle (RPC) adds signatures to a signature bundle.
+func (s *service) SignBundle(ctx context.Context, req *SignBundleRequest) (*SignBundleResponse, error) {
+	if err := s.ensureUnlocked(); err != nil {
+		return nil, err
+	}
+	if len(req.Signers) == 0 {
+		return nil, errors.Errorf("no signers specified")
+	}
+
+	bundle := &signatureBundle{}
+	if len(req.Bundle) > 0 {
+		b, err := decodeSignatureBundle(req.Bundle)
+		if err != nil {
+			return nil, err
+		}
+		// Don't countersign a bundle that doesn't verify.
+		if _, err := verifySignatureBundle(b, req.Data); err != nil {
+			return nil, err
+		}
+		bundle = b
+	}
+
+	for _, signer := range req.Signers {
+		kid, err := s.lookup(ctx, signer, nil)
+		if err != nil {
+			return nil, err
+		}
+		key, err := s.edx25519Key(kid)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := saltpack.SignDetached(req.Data, true, key)
+		if err != nil {
+			return nil, err
+		}
+		bundle.add(key.ID(), string(sig))
+	}
+
+	out, err := bundle.encode()
+	if err != nil {
+		return nil, err
+	}
+	return &SignBundleResponse{Bundle: out}, nil
+}
+
+// VerifyBundle (RPC) verifies all signatures in a bundle, and the (optional)
+// signature policy.
+func (s *service) VerifyBundle(ctx context.Context, req *VerifyBundleRequest) (*VerifyBundleResponse, error) {
+	bundle, err := decodeSignatureBundle(req.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	kids, err := verifySignatureBundle(bundle, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]*Key, 0, len(kids))
+	for _, kid := range kids {
+		signer, err := s.verifyKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+
+	if req.Policy != nil {
+		if err := s.checkSignaturePolicy(ctx, req.Policy, kids); err != nil {
+			return nil, err
+		}
+	}
+
+	return &VerifyBundleResponse{Signers: signers}, nil
+}
+
+// verifySignatureBundle verifies all signatures, returning the signers.
+func verifySignatureBundle(bundle *signatureBundle, data []byte) ([]keys.ID, error) {
+	if len(bundle.Signatures) == 0 {
+		return nil, errors.Errorf("no signatures in bundle")
+	}
+	kids := []keys.ID{}
+	seen := map[keys.ID]bool{}
+	for _, sig := range bundle.Signatures {
+		kid, err := saltpack.VerifyDetached([]byte(sig.Sig), data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to verify signature (%s)", sig.KID)
+		}
+		if kid != sig.KID {
+			return nil, errors.Errorf("signature kid mismatch %s != %s", kid, sig.KID)
+		}
+		if seen[kid] {
+			continue
+		}
+		seen[kid] = true
+		kids = append(kids, kid)
+	}
+	return kids, nil
+}
+
+func (s *service) checkSignaturePolicy(ctx context.Context, policy *SignaturePolicy, kids []keys.ID) error {
+	threshold := int(policy.Threshold)
+	if threshold < 0 {
+		return errors.Errorf("invalid signature policy threshold %d", threshold)
+	}
+	// No threshold requires all the signers.
+	if threshold == 0 {
+		threshold = len(policy.Signers)
+	}
+	if threshold == 0 {
+		return nil
+	}
+	count := len(kids)
+	if len(policy.Signers) > 0 {
+		allowed, err := s.lookupAll(ctx, policy.Signers, nil)
+		if err != nil {
+			return err
+		}
+		signed := keys.NewIDSet(kids...)
+		count = 0
+		for _, kid := range keys.NewIDSet(allowed...).IDs() {
+			if signed.Contains(kid) {
+				count++
+			}
+		}
+	}
+	if count < threshold {
+		return errors.Errorf("signature policy not met, %d of %d required signatures", count, threshold)
+	}
+	return nil
+}
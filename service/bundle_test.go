@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignBundle(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	data := []byte("release v1.0.0")
+
+	// Alice signs
+	signResp, err := aliceService.SignBundle(ctx, &SignBundleRequest{
+		Data:    data,
+		Signers: []string{alice.ID().String()},
+	})
+	require.NoError(t, err)
+
+	// Bob countersigns
+	signResp, err = bobService.SignBundle(ctx, &SignBundleRequest{
+		Data:    data,
+		Signers: []string{bob.ID().String()},
+		Bundle:  signResp.Bundle,
+	})
+	require.NoError(t, err)
+	bundle := signResp.Bundle
+
+	verifyResp, err := aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: bundle,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(verifyResp.Signers))
+	require.Equal(t, alice.ID().String(), verifyResp.Signers[0].ID)
+	require.Equal(t, bob.ID().String(), verifyResp.Signers[1].ID)
+
+	// Policy (2 of 3)
+	policy := &SignaturePolicy{
+		Threshold: 2,
+		Signers:   []string{alice.ID().String(), bob.ID().String(), charlie.ID().String()},
+	}
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: bundle,
+		Policy: policy,
+	})
+	require.NoError(t, err)
+
+	// Policy (2 of alice, charlie)
+	policy = &SignaturePolicy{
+		Threshold: 2,
+		Signers:   []string{alice.ID().String(), charlie.ID().String()},
+	}
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: bundle,
+		Policy: policy,
+	})
+	require.EqualError(t, err, "signature policy not met, 1 of 2 required signatures")
+
+	// Policy (no threshold, all of alice, charlie)
+	policy = &SignaturePolicy{
+		Signers: []string{alice.ID().String(), charlie.ID().String()},
+	}
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: bundle,
+		Policy: policy,
+	})
+	require.EqualError(t, err, "signature policy not met, 1 of 2 required signatures")
+
+	// Policy (negative threshold)
+	policy = &SignaturePolicy{
+		Threshold: -1,
+		Signers:   []string{alice.ID().String()},
+	}
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: bundle,
+		Policy: policy,
+	})
+	require.EqualError(t, err, "invalid signature policy threshold -1")
+
+	// Different data
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   []byte("release v1.0.1"),
+		Bundle: bundle,
+	})
+	require.Error(t, err)
+
+	// Countersign with different data
+	_, err = aliceService.SignBundle(ctx, &SignBundleRequest{
+		Data:    []byte("release v1.0.1"),
+		Signers: []string{alice.ID().String()},
+		Bundle:  bundle,
+	})
+	require.Error(t, err)
+
+	// Re-sign (replaces existing signature)
+	signResp, err = aliceService.SignBundle(ctx, &SignBundleRequest{
+		Data:    data,
+		Signers: []string{alice.ID().String()},
+		Bundle:  bundle,
+	})
+	require.NoError(t, err)
+	b, err := decodeSignatureBundle(signResp.Bundle)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(b.Signatures))
+
+	// Mismatched kid
+	b.Signatures[0].KID = charlie.ID()
+	tampered, err := b.encode()
+	require.NoError(t, err)
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: tampered,
+	})
+	require.EqualError(t, err, "signature kid mismatch "+alice.ID().String()+" != "+charlie.ID().String())
+
+	_, err = aliceService.VerifyBundle(ctx, &VerifyBundleRequest{
+		Data:   data,
+		Bundle: []byte(`{"signatures":[]}`),
+	})
+	require.EqualError(t, err, "no signatures in bundle")
+
+	_, err = aliceService.SignBundle(ctx, &SignBundleRequest{Data: data})
+	require.EqualError(t, err, "no signers specified")
+}
@@ -174,8 +174,12 @@ func runClient(build Build, args []string, client *Client, errorFn func(err erro
 	cmds = append(cmds, authCommands(client)...)
 	cmds = append(cmds, signCommands(client)...)
 	cmds = append(cmds, verifyCommands(client)...)
+	cmds = append(cmds, bundleCommands(client)...)
 	cmds = append(cmds, sigchainCommands(client)...)
 	cmds = append(cmds, encryptCommands(client)...)
+	cmds = append(cmds, groupCommands(client)...)
+	cmds = append(cmds, contactCommands(client)...)
+	cmds = append(cmds, trustCommands(client)...)
 	cmds = append(cmds, pullCommands(client)...)
 	cmds = append(cmds, importCommands(client)...)
 	cmds = append(cmds, exportCommands(client)...)
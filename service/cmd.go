@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"text/tabwriter"
+	"time"
 
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"google.golang.org/protobuf/proto"
@@ -62,6 +64,12 @@ func fmtKey(w io.Writer, key *Key) {
 		fmt.Fprint(w, " ")
 		fmt.Fprint(w, fmtUser(key.User))
 	}
+	if key.Label != "" {
+		fmt.Fprintf(w, " %q", key.Label)
+	}
+	if key.Expired {
+		fmt.Fprint(w, " (expired)")
+	}
 	fmt.Fprintf(w, "\n")
 }
 
@@ -90,6 +98,19 @@ func fmtVerified(w io.Writer, key *Key) {
 		fmt.Fprint(w, fmtUser(key.User))
 	}
 	fmt.Fprintf(w, "\n")
+	if key.TrustWarning != "" {
+		fmt.Fprintf(w, "warning: %s\n", key.TrustWarning)
+	}
+}
+
+func fmtEnvelope(w io.Writer, envelope *SignatureEnvelope) {
+	fmt.Fprintf(w, "signed: %s\n", tsutil.ParseMillis(envelope.CreatedAt).Format(time.RFC3339))
+	if envelope.Context != "" {
+		fmt.Fprintf(w, "context: %s\n", envelope.Context)
+	}
+	if envelope.ContentType != "" {
+		fmt.Fprintf(w, "content-type: %s\n", envelope.ContentType)
+	}
 }
 
 func encryptModeToString(m EncryptMode) string {
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func bundleCommands(client *Client) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "bundle",
+			Usage: "Signature bundles (multiple signers)",
+			Subcommands: []cli.Command{
+				{
+					Name:  "sign",
+					Usage: "Sign, adding signatures to a bundle",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "signer, s", Usage: "signer"},
+						cli.StringFlag{Name: "in, i", Usage: "file to sign"},
+						cli.StringFlag{Name: "bundle, b", Usage: "bundle to add signatures to (countersign), defaults to <in>.sigs if it exists"},
+						cli.StringFlag{Name: "out, o", Usage: "file to write, defaults to the bundle"},
+					},
+					Action: func(c *cli.Context) error {
+						in := c.String("in")
+						if in == "" {
+							return errors.Errorf("no file specified")
+						}
+						data, err := ioutil.ReadFile(in) // #nosec
+						if err != nil {
+							return err
+						}
+						bundlePath := c.String("bundle")
+						if bundlePath == "" {
+							bundlePath = in + ".sigs"
+						}
+						var bundle []byte
+						exists, err := pathExists(bundlePath)
+						if err != nil {
+							return err
+						}
+						if exists {
+							bundle, err = ioutil.ReadFile(bundlePath) // #nosec
+							if err != nil {
+								return err
+							}
+						} else if c.String("bundle") != "" {
+							return errors.Errorf("bundle not found %s", bundlePath)
+						}
+
+						resp, err := client.RPCClient().SignBundle(context.TODO(), &SignBundleRequest{
+							Data:    data,
+							Signers: c.StringSlice("signer"),
+							Bundle:  bundle,
+						})
+						if err != nil {
+							return err
+						}
+						out := c.String("out")
+						if out == "" {
+							out = bundlePath
+						}
+						if err := ioutil.WriteFile(out, resp.Bundle, filePerms); err != nil {
+							return err
+						}
+						fmt.Fprintf(client.out, "out: %s\n", out)
+						return nil
+					},
+				},
+				{
+					Name:  "verify",
+					Usage: "Verify all signatures in a bundle",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "in, i", Usage: "file to verify"},
+						cli.StringFlag{Name: "bundle, b", Usage: "bundle, defaults to <in>.sigs"},
+						cli.IntFlag{Name: "threshold, t", Usage: "required number of signatures"},
+						cli.StringSliceFlag{Name: "signer, s", Usage: "signers that count towards threshold"},
+					},
+					Action: func(c *cli.Context) error {
+						in := c.String("in")
+						if in == "" {
+							return errors.Errorf("no file specified")
+						}
+						data, err := ioutil.ReadFile(in) // #nosec
+						if err != nil {
+							return err
+						}
+						bundlePath := c.String("bundle")
+						if bundlePath == "" {
+							bundlePath = in + ".sigs"
+						}
+						bundle, err := ioutil.ReadFile(bundlePath) // #nosec
+						if err != nil {
+							return err
+						}
+						req := &VerifyBundleRequest{
+							Data:   data,
+							Bundle: bundle,
+						}
+						if c.Int("threshold") > 0 || len(c.StringSlice("signer")) > 0 {
+							req.Policy = &SignaturePolicy{
+								Threshold: int32(c.Int("threshold")),
+								Signers:   c.StringSlice("signer"),
+							}
+						}
+						resp, err := client.RPCClient().VerifyBundle(context.TODO(), req)
+						if err != nil {
+							return err
+						}
+						fmtKeys(resp.Signers)
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
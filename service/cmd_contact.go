@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func contactCommands(client *Client) []cli.Command {
+	list := func(c *cli.Context) error {
+		resp, err := client.RPCClient().Contacts(context.TODO(), &ContactsRequest{Query: c.String("query")})
+		if err != nil {
+			return err
+		}
+		for _, contact := range resp.Contacts {
+			fmt.Fprintf(client.out, "%s\t%s\n", contact.Name, strings.Join(append(contact.KIDs, contact.Users...), ", "))
+		}
+		return nil
+	}
+	listFlags := []cli.Flag{
+		cli.StringFlag{Name: "query, q", Usage: "name, kid or user"},
+	}
+	return []cli.Command{
+		{
+			Name:   "contact",
+			Usage:  "Contacts (encrypt to name)",
+			Flags:  listFlags,
+			Action: list,
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List contacts",
+					Flags:  listFlags,
+					Action: list,
+				},
+				{
+					Name:      "show",
+					Usage:     "Show contact",
+					ArgsUsage: "name",
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify contact name")
+						}
+						resp, err := client.RPCClient().Contact(context.TODO(), &ContactRequest{Name: name})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Contact)
+						return nil
+					},
+				},
+				{
+					Name:      "set",
+					Usage:     "Create or update a contact",
+					ArgsUsage: "name",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "kid, k", Usage: "kid"},
+						cli.StringSliceFlag{Name: "user, u", Usage: "user@service"},
+						cli.StringFlag{Name: "notes", Usage: "notes"},
+						cli.StringSliceFlag{Name: "group, g", Usage: "group"},
+					},
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify contact name")
+						}
+						contact := &Contact{Name: name}
+						resp, err := client.RPCClient().Contacts(context.TODO(), &ContactsRequest{Query: name})
+						if err != nil {
+							return err
+						}
+						for _, existing := range resp.Contacts {
+							if strings.EqualFold(existing.Name, name) {
+								contact = existing
+							}
+						}
+						if c.IsSet("kid") {
+							contact.KIDs = c.StringSlice("kid")
+						}
+						if c.IsSet("user") {
+							contact.Users = c.StringSlice("user")
+						}
+						if c.IsSet("notes") {
+							contact.Notes = c.String("notes")
+						}
+						if c.IsSet("group") {
+							contact.Groups = c.StringSlice("group")
+						}
+						saveResp, err := client.RPCClient().ContactSave(context.TODO(), &ContactSaveRequest{Contact: contact})
+						if err != nil {
+							return err
+						}
+						printMessage(saveResp.Contact)
+						return nil
+					},
+				},
+				{
+					Name:      "remove",
+					Usage:     "Remove a contact",
+					ArgsUsage: "name",
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify contact name")
+						}
+						if _, err := client.RPCClient().ContactRemove(context.TODO(), &ContactRemoveRequest{Name: name}); err != nil {
+							return err
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
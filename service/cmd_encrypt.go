@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sync"
@@ -22,8 +23,8 @@ func encryptCommands(client *Client) []cli.Command {
 				cli.StringSliceFlag{Name: "recipient, r", Usage: "recipients"},
 				cli.StringFlag{Name: "sender, signer, s", Usage: "signer (or anonymous)"},
 				cli.BoolFlag{Name: "armor, a", Usage: "armored"},
-				cli.StringFlag{Name: "in, i", Usage: "file to read"},
-				cli.StringFlag{Name: "out, o", Usage: "file to write, defaults to <in>.enc"},
+				cli.StringFlag{Name: "in, i", Usage: "file (or directory) to read"},
+				cli.StringFlag{Name: "out, o", Usage: "file to write, defaults to <in>.enc (or <in>.tar.enc for a directory)"},
 				cli.StringFlag{Name: "mode, m", Usage: "encryption mode: signcrypt, encrypt or default (signcrypt if signing, encrypt otherwise)"},
 				cli.BoolFlag{Hidden: true, Name: "no-signer-recipient", Usage: "don't add signer to recipients"},
 			},
@@ -104,8 +105,13 @@ func encryptCommands(client *Client) []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write"},
+				cli.BoolFlag{Name: "archive, x", Usage: "extract (tar) archive to a directory"},
+				cli.BoolFlag{Name: "inspect", Usage: "show recipients and sender, without decrypting"},
 			},
 			Action: func(c *cli.Context) error {
+				if c.Bool("inspect") {
+					return decryptInspectForCLI(c, client)
+				}
 				if c.String("in") != "" {
 					dec, err := decryptFileForCLI(c, client)
 					if err != nil {
@@ -181,6 +187,29 @@ func encryptCommands(client *Client) []cli.Command {
 	}
 }
 
+func decryptInspectForCLI(c *cli.Context, client *Client) error {
+	var reader io.Reader = os.Stdin
+	if c.String("in") != "" {
+		file, err := os.Open(c.String("in")) // #nosec
+		if err != nil {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		reader = file
+	}
+	// The header is at the start of the message.
+	b, err := ioutil.ReadAll(io.LimitReader(reader, 1024*1024))
+	if err != nil {
+		return err
+	}
+	resp, err := client.RPCClient().DecryptInspect(context.TODO(), &DecryptInspectRequest{Data: b})
+	if err != nil {
+		return err
+	}
+	printMessage(resp)
+	return nil
+}
+
 func encryptFileForCLI(c *cli.Context, client *Client, options *EncryptOptions) error {
 	return encryptFile(client, c.String("in"), c.String("out"), c.StringSlice("recipient"), c.String("sender"), options)
 }
@@ -196,6 +225,10 @@ func encryptFile(client *Client, in string, out string, recipients []string, sen
 			return err
 		}
 	}
+	archive, err := isDir(in)
+	if err != nil {
+		return err
+	}
 
 	encryptClient, err := client.RPCClient().EncryptFile(context.TODO())
 	if err != nil {
@@ -207,6 +240,7 @@ func encryptFile(client *Client, in string, out string, recipients []string, sen
 		Out:        out,
 		Recipients: recipients,
 		Sender:     sender,
+		Archive:    archive,
 		Options:    options,
 	}); err != nil {
 		return err
@@ -227,10 +261,10 @@ func encryptFile(client *Client, in string, out string, recipients []string, sen
 }
 
 func decryptFileForCLI(c *cli.Context, client *Client) (*DecryptFileOutput, error) {
-	return decryptFile(client, c.String("in"), c.String("out"))
+	return decryptFile(client, c.String("in"), c.String("out"), c.Bool("archive"))
 }
 
-func decryptFile(client *Client, in string, out string) (*DecryptFileOutput, error) {
+func decryptFile(client *Client, in string, out string, archive bool) (*DecryptFileOutput, error) {
 	if in == "" {
 		return nil, errors.Errorf("in not specified")
 	}
@@ -251,8 +285,9 @@ func decryptFile(client *Client, in string, out string) (*DecryptFileOutput, err
 	}
 
 	if err := decryptClient.Send(&DecryptFileInput{
-		In:  in,
-		Out: out,
+		In:      in,
+		Out:     out,
+		Archive: archive,
 	}); err != nil {
 		return nil, err
 	}
@@ -15,7 +15,7 @@ func exportCommands(client *Client) []cli.Command {
 			Usage: "Export a key",
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: "kid, k", Usage: "kid"},
-				cli.StringFlag{Name: "type, t", Value: "default", Usage: "default, ssh"},
+				cli.StringFlag{Name: "type, t", Value: "default", Usage: "default, ssh, paper, jwk, pem, age, openpgp"},
 				cli.BoolFlag{Name: "public", Usage: "export public part only"},
 				cli.StringFlag{Name: "password, p", Usage: "password"},
 				cli.BoolFlag{Name: "no-password", Usage: "export without password"},
@@ -35,7 +35,7 @@ func exportCommands(client *Client) []cli.Command {
 				public := c.Bool("public")
 				noPassword := c.Bool("no-password")
 
-				if !public && !noPassword {
+				if !public && !noPassword && exportTypeSupportsPassword(typ) {
 					if len(password) == 0 {
 						p, err := readVerifyPassword("Enter the password:")
 						if err != nil {
@@ -69,6 +69,16 @@ func exportTypeFromString(s string) (ExportType, error) {
 		return DefaultExport, nil
 	case "ssh":
 		return SSHExport, nil
+	case "paper":
+		return PaperExport, nil
+	case "jwk":
+		return JWKExport, nil
+	case "pem":
+		return PEMExport, nil
+	case "age":
+		return AgeExport, nil
+	case "openpgp":
+		return OpenPGPExport, nil
 	default:
 		return DefaultExport, errors.Errorf("invalid type: %s", s)
 	}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func groupCommands(client *Client) []cli.Command {
+	list := func(c *cli.Context) error {
+		resp, err := client.RPCClient().Groups(context.TODO(), &GroupsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, group := range resp.Groups {
+			fmt.Fprintf(client.out, "@%s\t%s\n", group.Name, strings.Join(group.Members, ", "))
+		}
+		return nil
+	}
+	return []cli.Command{
+		{
+			Name:   "group",
+			Usage:  "Recipient groups (encrypt to @name)",
+			Action: list,
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List groups",
+					Action: list,
+				},
+				{
+					Name:      "show",
+					Usage:     "Show group",
+					ArgsUsage: "name",
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify group name")
+						}
+						resp, err := client.RPCClient().Group(context.TODO(), &GroupRequest{Name: name})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Group)
+						return nil
+					},
+				},
+				{
+					Name:      "set",
+					Usage:     "Create or update a group",
+					ArgsUsage: "name",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{Name: "member, m", Usage: "member (kid, user@service or @group)"},
+					},
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify group name")
+						}
+						resp, err := client.RPCClient().GroupSave(context.TODO(), &GroupSaveRequest{
+							Name:    name,
+							Members: c.StringSlice("member"),
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Group)
+						return nil
+					},
+				},
+				{
+					Name:      "remove",
+					Usage:     "Remove a group",
+					ArgsUsage: "name",
+					Action: func(c *cli.Context) error {
+						name := c.Args().First()
+						if name == "" {
+							return errors.Errorf("specify group name")
+						}
+						if _, err := client.RPCClient().GroupRemove(context.TODO(), &GroupRemoveRequest{Name: name}); err != nil {
+							return err
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
@@ -13,6 +16,15 @@ import (
 var genTypes = strings.Join([]string{
 	string(keys.EdX25519),
 	string(keys.X25519),
+	string(keyring.Secp256k1),
+}, ", ")
+
+var keyTypes = strings.Join([]string{
+	string(keys.EdX25519),
+	string(keys.X25519),
+	string(keys.RSA),
+	string(keyring.ECDSA),
+	string(keyring.Secp256k1),
 }, ", ")
 
 func keyCommands(client *Client) []cli.Command {
@@ -21,10 +33,14 @@ func keyCommands(client *Client) []cli.Command {
 			Name:  "list",
 			Usage: "List keys",
 			Flags: []cli.Flag{
-				cli.StringSliceFlag{Name: "type, t", Usage: "only these types (" + genTypes + ")"},
+				cli.StringSliceFlag{Name: "type, t", Usage: "only these types (" + keyTypes + ")"},
+				cli.StringFlag{Name: "query, q", Usage: "kid, user, label, notes or tag"},
 			},
 			Action: func(c *cli.Context) error {
-				resp, err := client.RPCClient().Keys(context.TODO(), &KeysRequest{Types: c.StringSlice("type")})
+				resp, err := client.RPCClient().Keys(context.TODO(), &KeysRequest{
+					Query: c.String("query"),
+					Types: c.StringSlice("type"),
+				})
 				if err != nil {
 					return err
 				}
@@ -58,11 +74,15 @@ func keyCommands(client *Client) []cli.Command {
 			Name:  "generate",
 			Usage: "Generate a key",
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: "type, t", Value: "edx25519", Usage: "type (edx25519, x25519)"},
+				cli.StringFlag{Name: "type, t", Value: "edx25519", Usage: "type (" + genTypes + ")"},
+				cli.StringFlag{Name: "root", Usage: "derive from root (edx25519) key"},
+				cli.StringFlag{Name: "path", Usage: "derivation path, for example backend/signing"},
 			},
 			Action: func(c *cli.Context) error {
 				req := &KeyGenerateRequest{
 					Type: c.String("type"),
+					Root: c.String("root"),
+					Path: c.String("path"),
 				}
 				resp, err := client.RPCClient().KeyGenerate(context.TODO(), req)
 				if err != nil {
@@ -72,6 +92,121 @@ func keyCommands(client *Client) []cli.Command {
 				return nil
 			},
 		},
+		{
+			Name:  "update",
+			Usage: "Update key label, notes, tags or expiry",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "kid, k", Usage: "kid"},
+				cli.StringFlag{Name: "label", Usage: "label"},
+				cli.StringFlag{Name: "notes", Usage: "notes"},
+				cli.StringSliceFlag{Name: "tag", Usage: "tags (replaces existing tags)"},
+				cli.StringFlag{Name: "expires", Usage: "expiry date (YYYY-MM-DD)"},
+				cli.BoolFlag{Name: "no-expire", Usage: "remove expiry"},
+				cli.StringFlag{Name: "ssh-agent", Usage: "ssh agent policy (allow, confirm, deny)"},
+			},
+			Action: func(c *cli.Context) error {
+				kid, err := argString(c, "kid", false)
+				if err != nil {
+					return err
+				}
+				req := &KeyUpdateRequest{KID: kid}
+				if c.IsSet("label") {
+					req.Label = c.String("label")
+					req.Fields = append(req.Fields, "label")
+				}
+				if c.IsSet("notes") {
+					req.Notes = c.String("notes")
+					req.Fields = append(req.Fields, "notes")
+				}
+				if c.IsSet("tag") {
+					req.Tags = c.StringSlice("tag")
+					req.Fields = append(req.Fields, "tags")
+				}
+				if c.IsSet("expires") {
+					t, err := time.ParseInLocation("2006-01-02", c.String("expires"), time.Local)
+					if err != nil {
+						return errors.Wrapf(err, "invalid expiry date")
+					}
+					req.ExpiresAt = tsutil.Millis(t)
+					req.Fields = append(req.Fields, "expiresAt")
+				}
+				if c.Bool("no-expire") {
+					req.ExpiresAt = 0
+					req.Fields = append(req.Fields, "expiresAt")
+				}
+				if c.IsSet("ssh-agent") {
+					policy, err := sshAgentPolicyFromString(c.String("ssh-agent"))
+					if err != nil {
+						return err
+					}
+					req.SSHAgent = policy
+					req.Fields = append(req.Fields, "sshAgent")
+				}
+				if len(req.Fields) == 0 {
+					return errors.Errorf("nothing to update")
+				}
+				resp, err := client.RPCClient().KeyUpdate(context.TODO(), req)
+				if err != nil {
+					return err
+				}
+				printMessage(resp.Key)
+				return nil
+			},
+		},
+		{
+			Name:  "rotate",
+			Usage: "Rotate a key to a successor key (linked users follow the rotation)",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "kid, k", Usage: "kid"},
+				cli.StringFlag{Name: "successor, s", Usage: "successor kid, generates a new key if not specified"},
+				cli.BoolFlag{Name: "local", Usage: "don't save to the key server"},
+			},
+			Action: func(c *cli.Context) error {
+				kid, err := argString(c, "kid", false)
+				if err != nil {
+					return err
+				}
+				resp, err := client.RPCClient().KeyRotate(context.TODO(), &KeyRotateRequest{
+					KID:       kid,
+					Successor: c.String("successor"),
+					Local:     c.Bool("local"),
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Println(resp.Successor.ID)
+				return nil
+			},
+		},
+		{
+			Name:      "endorse",
+			Usage:     "Endorse (vouch for) another key",
+			ArgsUsage: "kid or user@service",
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: "kid, k", Usage: "kid to endorse with"},
+				cli.BoolFlag{Name: "local", Usage: "don't save to the key server"},
+			},
+			Action: func(c *cli.Context) error {
+				kid := c.String("kid")
+				if kid == "" {
+					return errors.Errorf("specify kid to endorse with")
+				}
+				key := c.Args().First()
+				if key == "" {
+					return errors.Errorf("specify kid or user@service to endorse")
+				}
+				resp, err := client.RPCClient().KeyEndorse(context.TODO(), &KeyEndorseRequest{
+					KID:   kid,
+					Key:   key,
+					Local: c.Bool("local"),
+				})
+				if err != nil {
+					return err
+				}
+				printMessage(resp.Statement)
+				return nil
+			},
+		},
 		{
 			Name:  "remove",
 			Usage: "Remove a key",
@@ -48,6 +48,9 @@ func sigchainCommands(client *Client) []cli.Command {
 								return err
 							}
 							fmt.Println(string(b))
+							if desc := describeStatement(st); desc != "" {
+								fmt.Printf("  %s\n", desc)
+							}
 							return nil
 						}
 
@@ -74,6 +77,9 @@ func sigchainCommands(client *Client) []cli.Command {
 								return err
 							}
 							fmt.Println(string(b))
+							if desc := describeStatement(st); desc != "" {
+								fmt.Printf("  %s\n", desc)
+							}
 						}
 						return nil
 					},
@@ -88,6 +94,7 @@ func sigchainCommands(client *Client) []cli.Command {
 							ArgsUsage: "stdin",
 							Flags: []cli.Flag{
 								cli.StringFlag{Name: "kid, k"},
+								cli.StringFlag{Name: "type, t", Usage: "type (device, email, pgp, dns), data is JSON for the type"},
 								cli.BoolFlag{Name: "local", Usage: "Don't save to the key server"},
 							},
 							Action: func(c *cli.Context) error {
@@ -107,6 +114,7 @@ func sigchainCommands(client *Client) []cli.Command {
 								resp, err := client.RPCClient().StatementCreate(context.TODO(), &StatementCreateRequest{
 									KID:   c.String("kid"),
 									Data:  b,
+									Type:  c.String("type"),
 									Local: c.Bool("local"),
 								})
 								if err != nil {
@@ -159,6 +167,91 @@ func sigchainCommands(client *Client) []cli.Command {
 						},
 					},
 				},
+				{
+					Name:  "export",
+					Usage: "Export sigchain (from the key server) with user proofs, for offline verification",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "kid, k", Usage: "kid"},
+						cli.StringFlag{Name: "out, o", Usage: "file to write, defaults to stdout"},
+					},
+					Action: func(c *cli.Context) error {
+						kid, err := argString(c, "kid", false)
+						if err != nil {
+							return err
+						}
+						resp, err := client.RPCClient().SigchainExport(context.TODO(), &SigchainExportRequest{
+							KID: kid,
+						})
+						if err != nil {
+							return err
+						}
+						out := c.String("out")
+						if out == "" {
+							fmt.Println(string(resp.Bundle))
+							return nil
+						}
+						if err := ioutil.WriteFile(out, resp.Bundle, filePerms); err != nil {
+							return err
+						}
+						fmt.Fprintf(client.out, "out: %s\n", out)
+						return nil
+					},
+				},
+				{
+					Name:      "verify",
+					Usage:     "Verify an exported sigchain (offline)",
+					ArgsUsage: "<bundle>",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "in, i", Usage: "bundle"},
+					},
+					Action: func(c *cli.Context) error {
+						in, err := argString(c, "in", false)
+						if err != nil {
+							return err
+						}
+						bundle, err := ioutil.ReadFile(in) // #nosec
+						if err != nil {
+							return err
+						}
+						resp, err := client.RPCClient().SigchainVerify(context.TODO(), &SigchainVerifyRequest{
+							Bundle: bundle,
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
+				{
+					Name:  "publish",
+					Usage: "Save pending (local) statements to the key server",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "kid, k", Usage: "kid, defaults to all keys with pending statements"},
+						cli.BoolFlag{Name: "rebase", Usage: "if the key server diverged, re-sign pending statements after the key server statements"},
+					},
+					Action: func(c *cli.Context) error {
+						resp, err := client.RPCClient().SigchainPublish(context.TODO(), &SigchainPublishRequest{
+							KID:    c.String("kid"),
+							Rebase: c.Bool("rebase"),
+						})
+						if err != nil {
+							return err
+						}
+						for _, rst := range resp.Statements {
+							st, err := statementFromRPC(rst)
+							if err != nil {
+								return err
+							}
+							b, err := st.Bytes()
+							if err != nil {
+								return err
+							}
+							fmt.Printf("%s\n", string(b))
+						}
+						return nil
+					},
+				},
 			},
 		},
 	}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -26,12 +27,33 @@ func signCommands(client *Client) []cli.Command {
 				cli.BoolFlag{Name: "detached, d", Usage: "output detached signature (.sig)"},
 				cli.BoolFlag{Name: "armor, a", Usage: "armored"},
 				cli.BoolFlag{Name: "binary, b", Usage: "binary"},
+				cli.StringFlag{Name: "format, f", Value: "saltpack", Usage: "signature format (saltpack, openpgp, sshsig)"},
+				cli.StringFlag{Name: "namespace, n", Usage: "signature namespace (sshsig), defaults to file"},
+				cli.BoolFlag{Name: "envelope, e", Usage: "sign envelope with time, context and content type (saltpack)"},
+				cli.StringFlag{Name: "context", Usage: "envelope context, what the signature is for"},
+				cli.StringFlag{Name: "content-type", Usage: "envelope content type"},
 			},
 			Action: func(c *cli.Context) error {
 				mode, err := parseMode(c)
 				if err != nil {
 					return err
 				}
+				format, err := signatureFormatFromString(c.String("format"))
+				if err != nil {
+					return err
+				}
+				mode.format = format
+				mode.namespace = c.String("namespace")
+				if format == SSHSignature {
+					if mode.detached == falseOption {
+						return errors.Errorf("sshsig signatures are detached")
+					}
+					mode.detached = trueOption
+				}
+
+				if c.Bool("envelope") || c.String("context") != "" || c.String("content-type") != "" {
+					return signEnvelopeForCLI(c, client, mode)
+				}
 
 				if c.String("in") != "" {
 					return signFileForCLI(c, client, mode)
@@ -53,9 +75,11 @@ func signStdin(c *cli.Context, client *Client, mode signMode) error {
 		return streamErr
 	}
 	if err := signClient.Send(&SignInput{
-		Signer:   c.String("signer"),
-		Armored:  mode.isArmored(stdIn, detached),
-		Detached: detached,
+		Signer:    c.String("signer"),
+		Armored:   mode.isArmored(stdIn, detached),
+		Detached:  detached,
+		Format:    mode.format,
+		Namespace: mode.namespace,
 	}); err != nil {
 		return err
 	}
@@ -99,10 +123,10 @@ func signStdin(c *cli.Context, client *Client, mode signMode) error {
 
 func signFileForCLI(c *cli.Context, client *Client, mode signMode) error {
 	detached := mode.isDetached(fileIn)
-	return signFile(client, c.String("signer"), mode.isArmored(fileIn, detached), detached, c.String("in"), c.String("out"))
+	return signFile(client, c.String("signer"), mode.isArmored(fileIn, detached), detached, mode.format, mode.namespace, c.String("in"), c.String("out"))
 }
 
-func signFile(client *Client, signer string, armored bool, detached bool, in string, out string) error {
+func signFile(client *Client, signer string, armored bool, detached bool, format SignatureFormat, namespace string, in string, out string) error {
 	in, err := filepath.Abs(in)
 	if err != nil {
 		return err
@@ -120,11 +144,13 @@ func signFile(client *Client, signer string, armored bool, detached bool, in str
 	}
 
 	if err := signClient.Send(&SignFileInput{
-		Signer:   signer,
-		Armored:  armored,
-		Detached: detached,
-		In:       in,
-		Out:      out,
+		Signer:    signer,
+		Armored:   armored,
+		Detached:  detached,
+		Format:    format,
+		Namespace: namespace,
+		In:        in,
+		Out:       out,
 	}); err != nil {
 		return err
 	}
@@ -147,6 +173,58 @@ func signFile(client *Client, signer string, armored bool, detached bool, in str
 	return nil
 }
 
+func signEnvelopeForCLI(c *cli.Context, client *Client, mode signMode) error {
+	in := c.String("in")
+	it := stdIn
+	if in != "" {
+		it = fileIn
+	}
+	detached := mode.isDetached(it)
+
+	var data []byte
+	var err error
+	if in != "" {
+		data, err = ioutil.ReadFile(in) // #nosec
+	} else {
+		data, err = ioutil.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RPCClient().Sign(context.TODO(), &SignRequest{
+		Data:        data,
+		Signer:      c.String("signer"),
+		Armored:     mode.isArmored(it, detached),
+		Detached:    detached,
+		Format:      mode.format,
+		Envelope:    true,
+		Context:     c.String("context"),
+		ContentType: c.String("content-type"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if in == "" {
+		_, err := os.Stdout.Write(resp.Data)
+		return err
+	}
+	out := c.String("out")
+	if out == "" {
+		if detached {
+			out = in + ".sig"
+		} else {
+			out = in + ".signed"
+		}
+	}
+	if err := ioutil.WriteFile(out, resp.Data, filePerms); err != nil {
+		return err
+	}
+	fmt.Fprintf(client.out, "out: %s\n", out)
+	return nil
+}
+
 type option string
 
 const (
@@ -156,8 +234,10 @@ const (
 )
 
 type signMode struct {
-	armored  option
-	detached option
+	armored   option
+	detached  option
+	format    SignatureFormat
+	namespace string
 }
 
 type inputType string
@@ -218,3 +298,16 @@ func parseMode(c *cli.Context) (signMode, error) {
 
 	return mode, nil
 }
+
+func signatureFormatFromString(s string) (SignatureFormat, error) {
+	switch s {
+	case "", "saltpack":
+		return SaltpackSignature, nil
+	case "openpgp", "pgp":
+		return OpenPGPSignature, nil
+	case "sshsig", "ssh":
+		return SSHSignature, nil
+	default:
+		return SaltpackSignature, errors.Errorf("invalid signature format: %s", s)
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func trustCommands(client *Client) []cli.Command {
+	list := func(c *cli.Context) error {
+		resp, err := client.RPCClient().Pins(context.TODO(), &PinsRequest{})
+		if err != nil {
+			return err
+		}
+		for _, pin := range resp.Pins {
+			fmt.Fprintf(client.out, "%s\t%s\t%s\t%s\n", pin.KID, pin.User, pinMethodToString(pin.Method), trustLevelToString(pin.Level))
+		}
+		return nil
+	}
+	return []cli.Command{
+		{
+			Name:   "trust",
+			Usage:  "Pin (trust) keys",
+			Action: list,
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List pinned keys",
+					Action: list,
+				},
+				{
+					Name:      "pin",
+					Usage:     "Pin a key",
+					ArgsUsage: "kid or user@service",
+					Flags: []cli.Flag{
+						cli.StringFlag{Name: "method, m", Usage: "how the key was verified (in-person, wormhole, proof, tofu)"},
+						cli.StringFlag{Name: "level, l", Usage: "trust level (full, marginal, never)"},
+					},
+					Action: func(c *cli.Context) error {
+						key := c.Args().First()
+						if key == "" {
+							return errors.Errorf("specify kid or user@service")
+						}
+						method, err := pinMethodFromString(c.String("method"))
+						if err != nil {
+							return err
+						}
+						level, err := trustLevelFromString(c.String("level"))
+						if err != nil {
+							return err
+						}
+						resp, err := client.RPCClient().TrustPin(context.TODO(), &TrustPinRequest{
+							Key:    key,
+							Method: method,
+							Level:  level,
+						})
+						if err != nil {
+							return err
+						}
+						printMessage(resp.Pin)
+						return nil
+					},
+				},
+				{
+					Name:      "unpin",
+					Usage:     "Remove a pinned key",
+					ArgsUsage: "kid or user@service",
+					Action: func(c *cli.Context) error {
+						key := c.Args().First()
+						if key == "" {
+							return errors.Errorf("specify kid or user@service")
+						}
+						if _, err := client.RPCClient().TrustUnpin(context.TODO(), &TrustUnpinRequest{Key: key}); err != nil {
+							return err
+						}
+						return nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func pinMethodFromString(s string) (PinMethod, error) {
+	switch s {
+	case "in-person":
+		return PinInPerson, nil
+	case "wormhole":
+		return PinWormhole, nil
+	case "proof":
+		return PinProof, nil
+	case "tofu":
+		return PinTOFU, nil
+	case "":
+		return PinNone, errors.Errorf("specify method (in-person, wormhole, proof, tofu)")
+	default:
+		return PinNone, errors.Errorf("invalid method %q", s)
+	}
+}
+
+func pinMethodToString(m PinMethod) string {
+	switch m {
+	case PinInPerson:
+		return "in-person"
+	case PinWormhole:
+		return "wormhole"
+	case PinProof:
+		return "proof"
+	case PinTOFU:
+		return "tofu"
+	default:
+		return ""
+	}
+}
+
+func trustLevelFromString(s string) (TrustLevel, error) {
+	switch s {
+	case "", "default":
+		return TrustUnknown, nil
+	case "full":
+		return TrustFull, nil
+	case "marginal":
+		return TrustMarginal, nil
+	case "never":
+		return TrustNever, nil
+	default:
+		return TrustUnknown, errors.Errorf("invalid level %q", s)
+	}
+}
+
+func trustLevelToString(l TrustLevel) string {
+	switch l {
+	case TrustFull:
+		return "full"
+	case TrustMarginal:
+		return "marginal"
+	case TrustNever:
+		return "never"
+	default:
+		return ""
+	}
+}
@@ -8,7 +8,11 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/tsutil"
 	"github.com/urfave/cli"
 )
 
@@ -24,6 +28,7 @@ func userCommands(client *Client) []cli.Command {
 					Flags: []cli.Flag{
 						cli.StringFlag{Name: "kid, k", Usage: "key"},
 						cli.BoolFlag{Name: "local", Usage: "search local index only"},
+						cli.BoolFlag{Name: "history", Usage: "show status history"},
 					},
 					Action: func(c *cli.Context) error {
 						kid, err := argString(c, "kid", false)
@@ -31,8 +36,9 @@ func userCommands(client *Client) []cli.Command {
 							return err
 						}
 						resp, err := client.RPCClient().User(context.TODO(), &UserRequest{
-							KID:   kid,
-							Local: c.Bool("local"),
+							KID:     kid,
+							Local:   c.Bool("local"),
+							History: c.Bool("history"),
 						})
 						if err != nil {
 							return err
@@ -40,6 +46,15 @@ func userCommands(client *Client) []cli.Command {
 						if resp.User != nil {
 							fmt.Println(fmtUser(resp.User))
 						}
+						if len(resp.History) > 0 {
+							w := new(tabwriter.Writer)
+							w.Init(os.Stdout, 0, 8, 1, ' ', 0)
+							for _, e := range resp.History {
+								ts := tsutil.ParseMillis(e.Timestamp).Format(time.RFC3339)
+								fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ts, e.User, e.Status, e.Err)
+							}
+							w.Flush()
+						}
 						return nil
 					},
 				},
@@ -80,7 +95,7 @@ func userCommands(client *Client) []cli.Command {
 				},
 				{
 					Name:  "setup",
-					Usage: "Link a key to an account (Twitter, Github, Reddit)",
+					Usage: "Link a key to an account (Twitter, Github, Reddit, Domain)",
 					Flags: []cli.Flag{
 						cli.StringFlag{Name: "kid, k", Usage: "key"},
 					},
@@ -95,6 +110,7 @@ func userCommands(client *Client) []cli.Command {
 						fmt.Println("(g) Github")
 						fmt.Println("(t) Twitter")
 						fmt.Println("(r) Reddit")
+						fmt.Println("(d) Domain (DNS TXT or .well-known)")
 						input, err := reader.ReadString('\n')
 						if err != nil {
 							return err
@@ -112,6 +128,9 @@ func userCommands(client *Client) []cli.Command {
 						case "r", "reddit":
 							service = "reddit"
 							question = "What's your Reddit username?"
+						case "d", "domain", "https":
+							service = "https"
+							question = "What's your domain?"
 						}
 
 						fmt.Println("")
@@ -146,6 +165,9 @@ func userCommands(client *Client) []cli.Command {
 							instructions = "Save the following signed message as a post on your user subreddit."
 							link = "https://www.reddit.com/user/" + name + "/submit"
 							urlq = "What's the location (URL) where the signed message was posted?"
+						case "https":
+							instructions = "Add the DNS TXT record " + api.DomainTXT(keys.ID(kid)) + " on " + name + ", or save the following signed message at the location below."
+							link = api.DomainWellKnownURL(name)
 						}
 						fmt.Println("")
 						fmt.Println(instructions)
@@ -165,12 +187,15 @@ func userCommands(client *Client) []cli.Command {
 							return nil
 						}
 
-						fmt.Print(urlq + " ")
-						surl, err := reader.ReadString('\n')
-						if err != nil {
-							return err
+						url := link
+						if urlq != "" {
+							fmt.Print(urlq + " ")
+							surl, err := reader.ReadString('\n')
+							if err != nil {
+								return err
+							}
+							url = strings.TrimSpace(strings.ToLower(surl))
 						}
-						url := strings.TrimSpace(strings.ToLower(surl))
 
 						_, err = client.RPCClient().UserAdd(context.TODO(), &UserAddRequest{
 							KID:     kid,
@@ -194,12 +219,14 @@ func userCommands(client *Client) []cli.Command {
 						cli.StringFlag{Name: "kid, k", Usage: "key"},
 						cli.StringFlag{Name: "service"},
 						cli.StringFlag{Name: "name"},
+						cli.StringFlag{Name: "forge", Usage: "forge URL, for service forge"},
 					},
 					Action: func(c *cli.Context) error {
 						resp, err := client.RPCClient().UserSign(context.TODO(), &UserSignRequest{
 							KID:     c.String("kid"),
 							Service: c.String("service"),
 							Name:    c.String("name"),
+							Forge:   c.String("forge"),
 						})
 						if err != nil {
 							return err
@@ -218,6 +245,7 @@ func userCommands(client *Client) []cli.Command {
 						cli.StringFlag{Name: "name"},
 						cli.StringFlag{Name: "url", Usage: "URL to signed statement created by `keys user sign`"},
 						cli.BoolFlag{Name: "local", Usage: "Don't save to the key server"},
+						cli.StringFlag{Name: "forge", Usage: "forge URL, for service forge"},
 					},
 					Action: func(c *cli.Context) error {
 						resp, err := client.RPCClient().UserAdd(context.TODO(), &UserAddRequest{
@@ -225,6 +253,7 @@ func userCommands(client *Client) []cli.Command {
 							Service: c.String("service"),
 							Name:    c.String("name"),
 							URL:     c.String("url"),
+							Forge:   c.String("forge"),
 							Local:   c.Bool("local"),
 						})
 						if err != nil {
@@ -24,6 +24,18 @@ func vaultCommands(client *Client) []cli.Command {
 						return nil
 					},
 				},
+				{
+					Name:  "status",
+					Usage: "Vault status",
+					Action: func(c *cli.Context) error {
+						resp, err := client.RPCClient().VaultStatus(context.TODO(), &VaultStatusRequest{})
+						if err != nil {
+							return err
+						}
+						printMessage(resp)
+						return nil
+					},
+				},
 				{
 					Name:  "auth",
 					Usage: "Vault auth (single use, expiring)",
@@ -26,6 +26,8 @@ func verifyCommands(client *Client) []cli.Command {
 				cli.StringFlag{Name: "sig, x", Usage: "signature file (if detached)"},
 				cli.StringFlag{Name: "in, i", Usage: "file to read"},
 				cli.StringFlag{Name: "out, o", Usage: "file to write (if attached), defaults to {in} without .signed"},
+				cli.BoolFlag{Name: "envelope, e", Usage: "verify signature envelope (time, context and content type)"},
+				cli.StringFlag{Name: "context", Usage: "required envelope context"},
 			},
 			Action: func(c *cli.Context) error {
 				logger.Debugf("Verify (cmd)")
@@ -34,6 +36,10 @@ func verifyCommands(client *Client) []cli.Command {
 
 				signer := c.String("signer")
 
+				if c.Bool("envelope") || c.String("context") != "" {
+					return verifyEnvelopeForCLI(c, client, signer)
+				}
+
 				if c.String("in") != "" {
 					if _, err := verifyFileForCLI(c, client, signer); err != nil {
 						return err
@@ -278,6 +284,71 @@ func verifyDetachedStream(client *Client, reader io.Reader, sigFile string, sign
 	return nil
 }
 
+func verifyEnvelopeForCLI(c *cli.Context, client *Client, signer string) error {
+	in := c.String("in")
+	var data []byte
+	var err error
+	if in != "" {
+		data, err = ioutil.ReadFile(in) // #nosec
+	} else {
+		data, err = ioutil.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return err
+	}
+
+	var key *Key
+	var envelope *SignatureEnvelope
+	var verified []byte
+	if sigFile := c.String("sig"); sigFile != "" {
+		sig, err := ioutil.ReadFile(sigFile) // #nosec
+		if err != nil {
+			return err
+		}
+		resp, err := client.RPCClient().VerifyDetached(context.TODO(), &VerifyDetachedRequest{
+			Data:    data,
+			Sig:     sig,
+			Context: c.String("context"),
+		})
+		if err != nil {
+			return err
+		}
+		key, envelope = resp.Signer, resp.Envelope
+	} else {
+		resp, err := client.RPCClient().Verify(context.TODO(), &VerifyRequest{
+			Data:    data,
+			Context: c.String("context"),
+		})
+		if err != nil {
+			return err
+		}
+		key, envelope, verified = resp.Signer, resp.Envelope, resp.Data
+	}
+
+	if err := checkSigner(os.Stderr, key, signer); err != nil {
+		return err
+	}
+	if envelope != nil {
+		fmtEnvelope(os.Stderr, envelope)
+	}
+
+	if verified == nil {
+		return nil
+	}
+	if in == "" {
+		_, err := os.Stdout.Write(verified)
+		return err
+	}
+	out := c.String("out")
+	if out == "" {
+		out = strings.TrimSuffix(in, ".signed")
+		if out == in {
+			return errors.Errorf("no out specified")
+		}
+	}
+	return ioutil.WriteFile(out, verified, filePerms)
+}
+
 func checkSigner(out io.Writer, signer *Key, expected string) error {
 	if signer == nil {
 		return errors.Errorf("no signer")
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/contacts"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// Contact (RPC) returns a contact by name.
+func (s *service) Contact(ctx context.Context, req *ContactRequest) (*ContactResponse, error) {
+	contact, err := contacts.New(s.vault).Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if contact == nil {
+		return nil, keys.NewErrNotFound(req.Name)
+	}
+	return &ContactResponse{
+		Contact: contactToRPC(contact),
+	}, nil
+}
+
+// ContactSave (RPC) creates or updates a contact.
+func (s *service) ContactSave(ctx context.Context, req *ContactSaveRequest) (*ContactSaveResponse, error) {
+	if req.Contact == nil {
+		return nil, errors.Errorf("no contact specified")
+	}
+	kids := make([]keys.ID, 0, len(req.Contact.KIDs))
+	for _, k := range req.Contact.KIDs {
+		kid, err := keys.ParseID(strings.TrimSpace(k))
+		if err != nil {
+			return nil, err
+		}
+		kids = append(kids, kid)
+	}
+	contact, err := contacts.New(s.vault).Save(&contacts.Contact{
+		ID:     req.Contact.ID,
+		Name:   req.Contact.Name,
+		KIDs:   kids,
+		Users:  req.Contact.Users,
+		Notes:  req.Contact.Notes,
+		Groups: req.Contact.Groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ContactSaveResponse{
+		Contact: contactToRPC(contact),
+	}, nil
+}
+
+// ContactRemove (RPC) removes a contact.
+func (s *service) ContactRemove(ctx context.Context, req *ContactRemoveRequest) (*ContactRemoveResponse, error) {
+	if req.Name == "" {
+		return nil, errors.Errorf("no contact specified")
+	}
+	ok, err := contacts.New(s.vault).Delete(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, keys.NewErrNotFound(req.Name)
+	}
+	return &ContactRemoveResponse{}, nil
+}
+
+// Contacts (RPC) lists contacts.
+func (s *service) Contacts(ctx context.Context, req *ContactsRequest) (*ContactsResponse, error) {
+	cs, err := contacts.New(s.vault).List()
+	if err != nil {
+		return nil, err
+	}
+	query := strings.ToLower(strings.TrimSpace(req.Query))
+	out := make([]*Contact, 0, len(cs))
+	for _, c := range cs {
+		if query != "" && !contactMatches(c, query) {
+			continue
+		}
+		out = append(out, contactToRPC(c))
+	}
+	return &ContactsResponse{
+		Contacts: out,
+	}, nil
+}
+
+func contactMatches(c *contacts.Contact, query string) bool {
+	if strings.Contains(strings.ToLower(c.Name), query) {
+		return true
+	}
+	for _, r := range c.Recipients() {
+		if strings.HasPrefix(r, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// isContactName returns true if s could be a contact name, and not a kid or
+// user@service.
+func isContactName(s string) bool {
+	return contacts.ValidateName(s) == nil
+}
+
+// expandContact returns the contact name and recipients (kids and users) for
+// a contact, or nil recipients if s isn't a contact.
+func expandContact(cv *contacts.Contacts, s string) (string, []string, error) {
+	if !isContactName(s) {
+		return "", nil, nil
+	}
+	contact, err := cv.Get(s)
+	if err != nil {
+		return "", nil, err
+	}
+	if contact == nil {
+		return "", nil, nil
+	}
+	return contact.Name, contact.Recipients(), nil
+}
+
+func contactToRPC(c *contacts.Contact) *Contact {
+	kids := make([]string, 0, len(c.KIDs))
+	for _, kid := range c.KIDs {
+		kids = append(kids, kid.String())
+	}
+	return &Contact{
+		ID:        c.ID,
+		Name:      c.Name,
+		KIDs:      kids,
+		Users:     c.Users,
+		Notes:     c.Notes,
+		Groups:    c.Groups,
+		CreatedAt: tsutil.Millis(c.CreatedAt),
+		UpdatedAt: tsutil.Millis(c.UpdatedAt),
+	}
+}
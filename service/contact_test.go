@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContacts(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testImportID(t, aliceService, bob.ID())
+	testImportID(t, aliceService, charlie.ID())
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	saveResp, err := aliceService.ContactSave(ctx, &ContactSaveRequest{
+		Contact: &Contact{
+			Name:   "Bob",
+			KIDs:   []string{bob.ID().String()},
+			Notes:  "Backend",
+			Groups: []string{"backend-team"},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, saveResp.Contact.ID)
+	require.Equal(t, "Bob", saveResp.Contact.Name)
+
+	_, err = aliceService.ContactSave(ctx, &ContactSaveRequest{
+		Contact: &Contact{
+			Name:   "charlie",
+			KIDs:   []string{charlie.ID().String()},
+			Groups: []string{"@backend-team"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = aliceService.ContactSave(ctx, &ContactSaveRequest{
+		Contact: &Contact{Name: "invalid", KIDs: []string{"kex1invalid"}},
+	})
+	require.Error(t, err)
+
+	contactsResp, err := aliceService.Contacts(ctx, &ContactsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(contactsResp.Contacts))
+	contactsResp, err = aliceService.Contacts(ctx, &ContactsRequest{Query: "bo"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(contactsResp.Contacts))
+	require.Equal(t, "Bob", contactsResp.Contacts[0].Name)
+
+	contactResp, err := aliceService.Contact(ctx, &ContactRequest{Name: "bob"})
+	require.NoError(t, err)
+	require.Equal(t, "Backend", contactResp.Contact.Notes)
+
+	// Lookup
+	kid, err := aliceService.lookup(ctx, "bob", nil)
+	require.NoError(t, err)
+	require.Equal(t, bob.ID(), kid)
+
+	// Encrypt to contact
+	encryptResp, err := aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi bob"),
+		Recipients: []string{"bob"},
+		Sender:     alice.ID().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Bob: "+bob.ID().String(), encryptResp.Info)
+	decryptResp, err := bobService.Decrypt(ctx, &DecryptRequest{Data: encryptResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi bob"), decryptResp.Data)
+
+	// Encrypt to group (of contacts)
+	encryptResp, err = aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi team"),
+		Recipients: []string{"@backend-team"},
+		Sender:     alice.ID().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "@backend-team: "+bob.ID().String()+", "+charlie.ID().String(), encryptResp.Info)
+
+	// Group with contact member
+	_, err = aliceService.GroupSave(ctx, &GroupSaveRequest{
+		Name:    "eng",
+		Members: []string{"bob", "@backend-team"},
+	})
+	require.NoError(t, err)
+
+	// Contact with more than one key
+	_, err = aliceService.ContactSave(ctx, &ContactSaveRequest{
+		Contact: &Contact{
+			Name: "team",
+			KIDs: []string{bob.ID().String(), charlie.ID().String()},
+		},
+	})
+	require.NoError(t, err)
+	kids, err := aliceService.lookupAll(ctx, []string{"team"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []keys.ID{bob.ID(), charlie.ID()}, kids)
+	// Single key (for example a wormhole recipient)
+	_, err = aliceService.lookup(ctx, "team", &lookupOpts{VerifyUser: true, CheckPin: true})
+	require.EqualError(t, err, "contact team has more than one key, specify a kid or user")
+
+	// Remove
+	_, err = aliceService.ContactRemove(ctx, &ContactRemoveRequest{Name: "bob"})
+	require.NoError(t, err)
+	_, err = aliceService.ContactRemove(ctx, &ContactRemoveRequest{Name: "bob"})
+	require.EqualError(t, err, "bob not found")
+	_, err = aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi"),
+		Recipients: []string{"bob"},
+	})
+	require.EqualError(t, err, "failed to parse bob")
+}
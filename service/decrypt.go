@@ -67,12 +67,16 @@ func (s *service) DecryptFile(srv RPC_DecryptFileServer) error {
 		return errors.Errorf("in not specified")
 	}
 
-	out, err := resolveOutPath(req.Out, req.In, ".enc")
+	inSuffix := ".enc"
+	if req.Archive {
+		inSuffix = ".tar.enc"
+	}
+	out, err := resolveOutPath(req.Out, req.In, inSuffix)
 	if err != nil {
 		return err
 	}
 
-	sender, mode, err := s.decryptWriteInOut(srv.Context(), req.In, out)
+	sender, mode, err := s.decryptWriteInOut(srv.Context(), req.In, out, req.Archive)
 	if err != nil {
 		return errors.Wrapf(err, "failed to decrypt")
 	}
@@ -166,7 +170,7 @@ func modeFromEncoding(enc saltpack.Encoding) (EncryptMode, error) {
 	}
 }
 
-func (s *service) decryptWriteInOut(ctx context.Context, in string, out string) (*Key, EncryptMode, error) {
+func (s *service) decryptWriteInOut(ctx context.Context, in string, out string, archive bool) (*Key, EncryptMode, error) {
 	inFile, err := os.Open(in) // #nosec
 	if err != nil {
 		return nil, DefaultEncrypt, errors.Wrapf(err, "failed to open %s", in)
@@ -186,8 +190,14 @@ func (s *service) decryptWriteInOut(ctx context.Context, in string, out string)
 		return nil, DefaultEncrypt, err
 	}
 
-	if err := writeFile(out, decReader); err != nil {
-		return nil, DefaultEncrypt, err
+	if archive {
+		if err := extractArchive(decReader, out); err != nil {
+			return nil, DefaultEncrypt, err
+		}
+	} else {
+		if err := writeFile(out, decReader); err != nil {
+			return nil, DefaultEncrypt, err
+		}
 	}
 	if err := inFile.Close(); err != nil {
 		return nil, DefaultEncrypt, err
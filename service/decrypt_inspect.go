@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/keybase/go-codec/codec"
+	ksaltpack "github.com/keybase/saltpack"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/pkg/errors"
+)
+
+// DecryptInspect (RPC) reads the header of an encrypted message, without
+// decrypting the payload.
+func (s *service) DecryptInspect(ctx context.Context, req *DecryptInspectRequest) (*DecryptInspectResponse, error) {
+	header, armored, err := readSaltpackHeader(bytes.NewReader(req.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	var mode EncryptMode
+	switch header.Type {
+	case ksaltpack.MessageTypeEncryption:
+		mode = SaltpackEncrypt
+	case ksaltpack.MessageTypeSigncryption:
+		mode = SaltpackSigncrypt
+	default:
+		return nil, errors.Errorf("not an encrypted message (%s)", header.Type)
+	}
+
+	recipients := []*Key{}
+	anon := 0
+	for _, r := range header.Receivers {
+		// Signcrypt receiver identifiers are derived from the ephemeral key, so
+		// they are always hidden.
+		if mode == SaltpackSigncrypt || len(r.ReceiverKID) == 0 {
+			anon++
+			continue
+		}
+		if len(r.ReceiverKID) != 32 {
+			return nil, errors.Errorf("invalid recipient key")
+		}
+		kid := keys.NewX25519PublicKey(keys.Bytes32(r.ReceiverKID)).ID()
+		convert, err := s.convertIfX25519ID(kid)
+		if err != nil {
+			return nil, err
+		}
+		key, err := s.key(ctx, convert)
+		if err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, key)
+	}
+
+	// Find which of our keys can open the message. Opening only processes the
+	// header, the payload isn't read.
+	kr := keyring.New(s.vault)
+	bks, err := kr.X25519Keys()
+	if err != nil {
+		return nil, err
+	}
+	var recipient *Key
+	var sender *Key
+	for _, bk := range bks {
+		_, key, _, err := saltpack.NewReader(bytes.NewReader(req.Data), saltpack.NewKeyring(bk))
+		if err == ksaltpack.ErrNoDecryptionKey {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		recipient, err = s.findSender(ctx, bk.ID())
+		if err != nil {
+			return nil, err
+		}
+		if key != nil {
+			sender, err = s.findSender(ctx, key.ID())
+			if err != nil {
+				return nil, err
+			}
+		}
+		break
+	}
+
+	return &DecryptInspectResponse{
+		Mode:                mode,
+		Armored:             armored,
+		Recipients:          recipients,
+		AnonymousRecipients: int32(anon),
+		CanOpen:             recipient != nil,
+		Sender:              sender,
+		Recipient:           recipient,
+	}, nil
+}
+
+// readSaltpackHeader reads the (double encoded) header from the start of a
+// saltpack message, armored or binary.
+func readSaltpackHeader(r io.Reader) (*ksaltpack.EncryptionHeader, bool, error) {
+	buf := bufio.NewReaderSize(r, 8192)
+	armored, _, _, _, err := ksaltpack.ClassifyStream(buf)
+	if err != nil {
+		return nil, false, errors.Errorf("invalid data")
+	}
+	var in io.Reader = buf
+	if armored {
+		dearmored, _, err := ksaltpack.NewArmor62DecoderStream(buf, nil, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		in = dearmored
+	}
+
+	var mh codec.MsgpackHandle
+	var b []byte
+	if err := codec.NewDecoder(in, &mh).Decode(&b); err != nil {
+		return nil, false, errors.Wrapf(err, "failed to read header")
+	}
+	var header ksaltpack.EncryptionHeader
+	if err := codec.NewDecoderBytes(b, &mh).Decode(&header); err != nil {
+		return nil, false, errors.Wrapf(err, "failed to read header")
+	}
+	return &header, armored, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptInspect(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testImportID(t, aliceService, bob.ID())
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testImportID(t, bobService, alice.ID())
+
+	for _, armored := range []bool{false, true} {
+		// Encrypt
+		encryptResp, err := aliceService.Encrypt(ctx, &EncryptRequest{
+			Data:       []byte("hi bob"),
+			Recipients: []string{bob.ID().String()},
+			Sender:     alice.ID().String(),
+			Options: &EncryptOptions{
+				Mode:              SaltpackEncrypt,
+				Armored:           armored,
+				NoSenderRecipient: true,
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err := bobService.DecryptInspect(ctx, &DecryptInspectRequest{Data: encryptResp.Data})
+		require.NoError(t, err)
+		require.Equal(t, SaltpackEncrypt, resp.Mode)
+		require.Equal(t, armored, resp.Armored)
+		require.True(t, resp.CanOpen)
+		require.Equal(t, bob.ID().String(), resp.Recipient.ID)
+		require.Equal(t, 0, len(resp.Recipients))
+		require.Equal(t, int32(1), resp.AnonymousRecipients)
+		require.Equal(t, alice.ID().String(), resp.Sender.ID)
+
+		// Alice can't open her own message
+		resp, err = aliceService.DecryptInspect(ctx, &DecryptInspectRequest{Data: encryptResp.Data})
+		require.NoError(t, err)
+		require.False(t, resp.CanOpen)
+		require.Nil(t, resp.Recipient)
+		require.Nil(t, resp.Sender)
+		require.Equal(t, int32(1), resp.AnonymousRecipients)
+
+		// Signcrypt
+		encryptResp, err = aliceService.Encrypt(ctx, &EncryptRequest{
+			Data:       []byte("hi bob"),
+			Recipients: []string{bob.ID().String()},
+			Sender:     alice.ID().String(),
+			Options: &EncryptOptions{
+				Mode:    SaltpackSigncrypt,
+				Armored: armored,
+			},
+		})
+		require.NoError(t, err)
+
+		resp, err = bobService.DecryptInspect(ctx, &DecryptInspectRequest{Data: encryptResp.Data})
+		require.NoError(t, err)
+		require.Equal(t, SaltpackSigncrypt, resp.Mode)
+		require.True(t, resp.CanOpen)
+		require.Equal(t, bob.ID().String(), resp.Recipient.ID)
+		require.Equal(t, 0, len(resp.Recipients))
+		require.Equal(t, int32(2), resp.AnonymousRecipients)
+		require.Equal(t, alice.ID().String(), resp.Sender.ID)
+	}
+
+	// Signed (not encrypted)
+	signResp, err := aliceService.Sign(ctx, &SignRequest{
+		Data:   []byte("hi"),
+		Signer: alice.ID().String(),
+	})
+	require.NoError(t, err)
+	_, err = bobService.DecryptInspect(ctx, &DecryptInspectRequest{Data: signResp.Data})
+	require.EqualError(t, err, "not an encrypted message (an attached signature)")
+
+	_, err = bobService.DecryptInspect(ctx, &DecryptInspectRequest{Data: []byte("???")})
+	require.EqualError(t, err, "invalid data")
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys/saltpack"
@@ -17,6 +18,8 @@ type encrypt struct {
 	sender     keys.ID
 	mode       EncryptMode
 	armored    bool
+	// info describes which recipients groups resolved to.
+	info string
 }
 
 func (s *service) newEncrypt(ctx context.Context, recipients []string, sender string, options *EncryptOptions) (*encrypt, error) {
@@ -33,7 +36,16 @@ func (s *service) newEncrypt(ctx context.Context, recipients []string, sender st
 		skid = s
 	}
 
-	recs, err := s.lookupAll(ctx, recipients, &lookupOpts{VerifyUser: true})
+	recipients, expansions, err := s.expandRecipients(recipients)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.groupsInfo(ctx, expansions)
+	if err != nil {
+		return nil, err
+	}
+
+	recs, err := s.lookupAll(ctx, recipients, &lookupOpts{VerifyUser: true, CheckPin: true})
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +83,7 @@ func (s *service) newEncrypt(ctx context.Context, recipients []string, sender st
 		sender:     skid,
 		mode:       mode,
 		armored:    options.Armored,
+		info:       info,
 	}, nil
 }
 
@@ -107,10 +120,11 @@ func (s *service) Encrypt(ctx context.Context, req *EncryptRequest) (*EncryptRes
 
 	return &EncryptResponse{
 		Data: out,
+		Info: enc.info,
 	}, nil
 }
 
-func (s *service) encryptWriteInOut(ctx context.Context, in string, out string, enc *encrypt) error {
+func (s *service) encryptWriteInOut(ctx context.Context, in string, out string, enc *encrypt, archive bool) error {
 	outTmp := out + ".tmp"
 	outFile, err := os.Create(outTmp)
 	if err != nil {
@@ -128,16 +142,14 @@ func (s *service) encryptWriteInOut(ctx context.Context, in string, out string,
 		return err
 	}
 
-	inFile, err := os.Open(in) // #nosec
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = inFile.Close()
-	}()
-	reader := bufio.NewReader(inFile)
-	if _, err := reader.WriteTo(stream); err != nil {
-		return err
+	if archive {
+		if err := writeArchive(stream, in); err != nil {
+			return err
+		}
+	} else {
+		if err := encryptCopyFile(stream, in); err != nil {
+			return err
+		}
 	}
 	if err := stream.Close(); err != nil {
 		return err
@@ -145,10 +157,6 @@ func (s *service) encryptWriteInOut(ctx context.Context, in string, out string,
 	if err := writer.Flush(); err != nil {
 		return err
 	}
-
-	if err := inFile.Close(); err != nil {
-		return err
-	}
 	if err := outFile.Close(); err != nil {
 		return err
 	}
@@ -160,6 +168,21 @@ func (s *service) encryptWriteInOut(ctx context.Context, in string, out string,
 	return nil
 }
 
+func encryptCopyFile(w io.Writer, in string) error {
+	inFile, err := os.Open(in) // #nosec
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = inFile.Close()
+	}()
+	reader := bufio.NewReader(inFile)
+	if _, err := reader.WriteTo(w); err != nil {
+		return err
+	}
+	return inFile.Close()
+}
+
 func (s *service) encryptWriter(ctx context.Context, w io.Writer, enc *encrypt) (io.WriteCloser, error) {
 	var stream io.WriteCloser
 	switch enc.mode {
@@ -205,9 +228,23 @@ func (s *service) EncryptFile(srv RPC_EncryptFileServer) error {
 	if in == "" {
 		return errors.Errorf("in not specified")
 	}
+	dir, err := isDir(in)
+	if err != nil {
+		return err
+	}
+	if dir && !req.Archive {
+		return errors.Errorf("%s is a directory, specify archive to encrypt directories", in)
+	}
+	if !dir && req.Archive {
+		return errors.Errorf("%s is not a directory", in)
+	}
 	out := req.Out
 	if out == "" {
-		out = in + ".enc"
+		if req.Archive {
+			out = strings.TrimSuffix(in, string(os.PathSeparator)) + ".tar.enc"
+		} else {
+			out = in + ".enc"
+		}
 	}
 
 	enc, err := s.newEncrypt(srv.Context(), req.Recipients, req.Sender, req.Options)
@@ -215,7 +252,7 @@ func (s *service) EncryptFile(srv RPC_EncryptFileServer) error {
 		return err
 	}
 
-	if err := s.encryptWriteInOut(srv.Context(), in, out, enc); err != nil {
+	if err := s.encryptWriteInOut(srv.Context(), in, out, enc, req.Archive); err != nil {
 		return err
 	}
 
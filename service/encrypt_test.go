@@ -439,7 +439,7 @@ func TestEncryptDecryptFile(t *testing.T) {
 	bobClient, bobClientCloseFn := newTestRPCClient(t, bobService, env, "", nil)
 	defer bobClientCloseFn()
 
-	dec, err := decryptFile(bobClient, encPath, decPath)
+	dec, err := decryptFile(bobClient, encPath, decPath, false)
 	require.NoError(t, err)
 	require.NotNil(t, dec.Sender)
 	require.Equal(t, alice.ID().String(), dec.Sender.ID)
@@ -455,7 +455,7 @@ func TestEncryptDecryptFile(t *testing.T) {
 	err = os.Rename(encPath, datPath)
 	require.NoError(t, err)
 
-	dec, err = decryptFile(bobClient, datPath, "")
+	dec, err = decryptFile(bobClient, datPath, "", false)
 	require.NoError(t, err)
 	require.NotNil(t, dec.Sender)
 	require.Equal(t, alice.ID().String(), dec.Sender.ID)
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/pins"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// endorseStatementType is the sigchain statement type for an endorsement.
+const endorseStatementType = "endorse"
+
+// endorsement is the statement data for vouching for another key, posted by
+// the endorsing key to its sigchain. User is the user (name@service) the key
+// was endorsed as, if any.
+type endorsement struct {
+	KID  keys.ID `json:"kid"`
+	User string  `json:"user,omitempty"`
+}
+
+func (e *endorsement) verify(kid keys.ID) error {
+	if _, err := keys.ParseID(e.KID.String()); err != nil {
+		return errors.Wrapf(err, "invalid endorsed kid")
+	}
+	if e.KID == kid {
+		return errors.Errorf("can't endorse the sigchain key")
+	}
+	if e.User != "" && !strings.Contains(e.User, "@") {
+		return errors.Errorf("invalid endorsed user %q", e.User)
+	}
+	return nil
+}
+
+func (e *endorsement) describe() string {
+	if e.User != "" {
+		return fmt.Sprintf("%s (%s)", e.KID, e.User)
+	}
+	return e.KID.String()
+}
+
+// KeyEndorse (RPC) endorses (vouches for) another key.
+func (s *service) KeyEndorse(ctx context.Context, req *KeyEndorseRequest) (*KeyEndorseResponse, error) {
+	kid, err := s.parseKID(req.KID)
+	if err != nil {
+		return nil, err
+	}
+	endorsed, err := s.lookup(ctx, req.Key, &lookupOpts{VerifyUser: true})
+	if err != nil {
+		return nil, err
+	}
+	usr, err := s.pinUser(ctx, req.Key, endorsed)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(&endorsement{KID: endorsed, User: usr})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.StatementCreate(ctx, &StatementCreateRequest{
+		KID:   kid.String(),
+		Data:  b,
+		Type:  endorseStatementType,
+		Local: req.Local,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &KeyEndorseResponse{
+		Statement: resp.Statement,
+	}, nil
+}
+
+// endorsements returns (non-revoked) endorsements of a key from the sigchains
+// of pinned keys (that aren't distrusted).
+func (s *service) endorsements(kid keys.ID) ([]*Endorsement, error) {
+	ps, err := pins.New(s.vault).List()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Endorsement{}
+	for _, pin := range ps {
+		if pin.Level == pins.Never || pin.KID == kid || !pin.KID.IsEdX25519() {
+			continue
+		}
+		sc, err := s.scs.Sigchain(pin.KID)
+		if err != nil {
+			return nil, err
+		}
+		if sc == nil {
+			continue
+		}
+		for _, st := range sc.FindAll(endorseStatementType) {
+			var e endorsement
+			if err := json.Unmarshal(st.Data, &e); err != nil {
+				logger.Warningf("Invalid endorsement %s: %v", st.URL(), err)
+				continue
+			}
+			if e.KID != kid {
+				continue
+			}
+			out = append(out, &Endorsement{
+				KID:       pin.KID.String(),
+				User:      pin.User,
+				Trust:     trustLevelToRPC(pin.Level),
+				CreatedAt: tsutil.Millis(st.Timestamp),
+			})
+			break
+		}
+	}
+	return out, nil
+}
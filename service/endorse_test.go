@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyEndorse(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testUserSetupGithub(t, env, bobService, bob, "bob")
+
+	charlieService, charlieCloseFn := newTestService(t, env)
+	defer charlieCloseFn()
+	testAuthSetup(t, charlieService)
+	testImportKey(t, charlieService, charlie)
+	testUserSetupGithub(t, env, charlieService, charlie, "charlie")
+
+	// Bob endorses charlie@github
+	testPull(t, bobService, charlie.ID())
+	endorseResp, err := bobService.KeyEndorse(ctx, &KeyEndorseRequest{
+		KID: bob.ID().String(),
+		Key: "charlie@github",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "endorse", endorseResp.Statement.Type)
+	st, err := statementFromRPC(endorseResp.Statement)
+	require.NoError(t, err)
+	require.Equal(t, "endorse: "+charlie.ID().String()+" (charlie@github)", describeStatement(st))
+
+	_, err = bobService.KeyEndorse(ctx, &KeyEndorseRequest{
+		KID: bob.ID().String(),
+		Key: bob.ID().String(),
+	})
+	require.EqualError(t, err, "invalid endorse statement: can't endorse the sigchain key")
+
+	testPull(t, aliceService, bob.ID())
+	testPull(t, aliceService, charlie.ID())
+
+	// Bob isn't pinned
+	keyResp, err := aliceService.Key(ctx, &KeyRequest{Key: "charlie@github"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keyResp.Key.Endorsements))
+
+	_, err = aliceService.TrustPin(ctx, &TrustPinRequest{Key: "bob@github", Method: PinInPerson})
+	require.NoError(t, err)
+	keyResp, err = aliceService.Key(ctx, &KeyRequest{Key: "charlie@github"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keyResp.Key.Endorsements))
+	require.Equal(t, bob.ID().String(), keyResp.Key.Endorsements[0].KID)
+	require.Equal(t, "bob@github", keyResp.Key.Endorsements[0].User)
+	require.Equal(t, TrustFull, keyResp.Key.Endorsements[0].Trust)
+
+	// Revoke
+	_, err = bobService.StatementRevoke(ctx, &StatementRevokeRequest{
+		KID: bob.ID().String(),
+		Seq: endorseResp.Statement.Seq,
+	})
+	require.NoError(t, err)
+	testPull(t, aliceService, bob.ID())
+	keyResp, err = aliceService.Key(ctx, &KeyRequest{Key: "charlie@github"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keyResp.Key.Endorsements))
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/keys-pub/keys/env"
 	"github.com/pkg/errors"
@@ -47,8 +48,21 @@ func (c Env) linkPath() string {
 // Env key names
 const serverCfgKey = "server"
 const portCfgKey = "port"
-
-var configKeys = []string{serverCfgKey, portCfgKey}
+const keyExpiryCfgKey = "key-expiry-warning"
+
+// SSH agent (ssh-agent.*) env keys
+const sshAgentEnabledCfgKey = "ssh-agent.enabled"
+const sshAgentSocketCfgKey = "ssh-agent.socket"
+const sshAgentAskPassCfgKey = "ssh-agent.askpass"
+
+var configKeys = []string{
+	serverCfgKey,
+	portCfgKey,
+	keyExpiryCfgKey,
+	sshAgentEnabledCfgKey,
+	sshAgentSocketCfgKey,
+	sshAgentAskPassCfgKey,
+}
 
 // IsKey returns true if config key is recognized.
 func (c Env) IsKey(s string) bool {
@@ -65,6 +79,34 @@ func (c Env) Port() int {
 	return c.GetInt(portCfgKey, c.build.DefaultPort)
 }
 
+// KeyExpiryWarning is how long before a key expires to warn about it
+// (defaults to 30 days).
+func (c Env) KeyExpiryWarning() time.Duration {
+	days := c.GetInt(keyExpiryCfgKey, 30)
+	return time.Duration(days) * time.Hour * 24
+}
+
+// SSHAgentEnabled is true if the service should listen for ssh-agent
+// connections.
+func (c Env) SSHAgentEnabled() bool {
+	return c.GetBool(sshAgentEnabledCfgKey)
+}
+
+// SSHAgentSocket is the path for the ssh-agent (unix) socket, defaults to
+// ssh-agent.sock in the app directory.
+func (c Env) SSHAgentSocket() (string, error) {
+	if path := c.Get(sshAgentSocketCfgKey, ""); path != "" {
+		return path, nil
+	}
+	return c.AppPath("ssh-agent.sock", true)
+}
+
+// SSHAgentAskPass is the program used to confirm ssh-agent signatures,
+// defaults to SSH_ASKPASS.
+func (c Env) SSHAgentAskPass() string {
+	return c.Get(sshAgentAskPassCfgKey, os.Getenv("SSH_ASKPASS"))
+}
+
 // Server to connect to.
 func (c Env) Server() string {
 	return c.Get(serverCfgKey, "https://keys.pub")
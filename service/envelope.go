@@ -0,0 +1,180 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// A signature envelope is signed (saltpack) metadata around the data, so the
+// signature includes when and what it was made for:
+//
+//	keys.pub/envelope/v1
+//	{"ts":1234,"ctx":"release","type":"application/zip","digest":"..."}
+//	<data>
+//
+// A detached envelope doesn't include the data, the digest is checked against
+// the data when verified.
+const envelopeMagic = "keys.pub/envelope/v1\n"
+
+type envelopeHeader struct {
+	CreatedAt   int64  `json:"ts"`
+	Context     string `json:"ctx,omitempty"`
+	ContentType string `json:"type,omitempty"`
+	// Digest is SHA256 of the data.
+	Digest   []byte `json:"digest"`
+	Detached bool   `json:"detached,omitempty"`
+}
+
+func encodeEnvelope(h *envelopeHeader, data []byte) ([]byte, error) {
+	hb, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(envelopeMagic)
+	buf.Write(hb)
+	buf.WriteString("\n")
+	if !h.Detached {
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeEnvelope returns the envelope header and data, or nil if not an
+// envelope.
+func decodeEnvelope(b []byte) (*envelopeHeader, []byte, error) {
+	if !bytes.HasPrefix(b, []byte(envelopeMagic)) {
+		return nil, nil, nil
+	}
+	b = b[len(envelopeMagic):]
+	i := bytes.IndexByte(b, '\n')
+	if i < 0 {
+		return nil, nil, errors.Errorf("invalid envelope")
+	}
+	var h envelopeHeader
+	if err := json.Unmarshal(b[:i], &h); err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid envelope")
+	}
+	data := b[i+1:]
+	if h.Detached && len(data) > 0 {
+		return nil, nil, errors.Errorf("invalid envelope, detached with data")
+	}
+	return &h, data, nil
+}
+
+func (h *envelopeHeader) verifyDigest(data []byte) error {
+	digest := sha256.Sum256(data)
+	if !bytes.Equal(digest[:], h.Digest) {
+		return errors.Errorf("envelope digest mismatch")
+	}
+	return nil
+}
+
+func (h *envelopeHeader) toRPC() *SignatureEnvelope {
+	return &SignatureEnvelope{
+		CreatedAt:   h.CreatedAt,
+		Context:     h.Context,
+		ContentType: h.ContentType,
+	}
+}
+
+// checkContext returns an error if context is required and doesn't match.
+func (h *envelopeHeader) checkContext(context string) error {
+	if context == "" {
+		return nil
+	}
+	if h == nil {
+		return errors.Errorf("signature has no envelope (context %q required)", context)
+	}
+	if h.Context != context {
+		return errors.Errorf("signature context mismatch %q != %q", h.Context, context)
+	}
+	return nil
+}
+
+// signEnvelope signs data in an envelope.
+func signEnvelope(data []byte, armored bool, detached bool, context string, contentType string, key *keys.EdX25519Key, now time.Time) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	h := &envelopeHeader{
+		CreatedAt:   tsutil.Millis(now),
+		Context:     context,
+		ContentType: contentType,
+		Digest:      digest[:],
+		Detached:    detached,
+	}
+	b, err := encodeEnvelope(h, data)
+	if err != nil {
+		return nil, err
+	}
+	return saltpack.Sign(b, armored, key)
+}
+
+// verifyEnvelope verifies a saltpack (attached) signed message, returning
+// the data and envelope (if any).
+func verifyEnvelope(b []byte) ([]byte, *envelopeHeader, keys.ID, error) {
+	verified, kid, err := saltpack.Verify(b)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	h, data, err := decodeEnvelope(verified)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if h == nil {
+		return verified, nil, kid, nil
+	}
+	if h.Detached {
+		return nil, nil, "", errors.Errorf("envelope is detached")
+	}
+	if err := h.verifyDigest(data); err != nil {
+		return nil, nil, "", err
+	}
+	return data, h, kid, nil
+}
+
+// errEnvelopeUnsupported is returned for envelopes from the file and stream
+// verify methods, which don't check the envelope digest or context.
+var errEnvelopeUnsupported = errors.New("envelope not supported for file or stream verify (use verify)")
+
+// isEnvelope returns true if b (verified data) is an envelope.
+func isEnvelope(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(envelopeMagic))
+}
+
+// isDetachedEnvelopeSig returns true if sig is a signed (detached) envelope
+// instead of a detached signature.
+func isDetachedEnvelopeSig(sig []byte) bool {
+	verified, _, err := saltpack.Verify(sig)
+	return err == nil && isEnvelope(verified)
+}
+
+// verifyDetachedEnvelope verifies a saltpack detached signature or a detached
+// envelope, returning the envelope (if any).
+func verifyDetachedEnvelope(sig []byte, data []byte) (*envelopeHeader, keys.ID, error) {
+	// Detached envelopes are signed (attached) messages.
+	if verified, kid, err := saltpack.Verify(sig); err == nil {
+		h, _, err := decodeEnvelope(verified)
+		if err != nil {
+			return nil, "", err
+		}
+		if h == nil || !h.Detached {
+			return nil, "", errors.Errorf("not a detached signature")
+		}
+		if err := h.verifyDigest(data); err != nil {
+			return nil, "", err
+		}
+		return h, kid, nil
+	}
+	kid, err := saltpack.VerifyDetached(sig, data)
+	if err != nil {
+		return nil, "", err
+	}
+	return nil, kid, nil
+}
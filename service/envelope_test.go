@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/saltpack"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignEnvelope(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	data := []byte("artifact")
+
+	// Attached
+	signResp, err := service.Sign(ctx, &SignRequest{
+		Data:        data,
+		Signer:      alice.ID().String(),
+		Armored:     true,
+		Context:     "release",
+		ContentType: "application/octet-stream",
+	})
+	require.NoError(t, err)
+
+	verifyResp, err := service.Verify(ctx, &VerifyRequest{Data: signResp.Data, Context: "release"})
+	require.NoError(t, err)
+	require.Equal(t, data, verifyResp.Data)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+	require.NotNil(t, verifyResp.Envelope)
+	require.Equal(t, "release", verifyResp.Envelope.Context)
+	require.Equal(t, "application/octet-stream", verifyResp.Envelope.ContentType)
+	require.True(t, verifyResp.Envelope.CreatedAt > 0)
+	require.True(t, verifyResp.Envelope.CreatedAt < tsutil.Millis(env.clock.Now()))
+
+	_, err = service.Verify(ctx, &VerifyRequest{Data: signResp.Data, Context: "deploy"})
+	require.EqualError(t, err, `signature context mismatch "release" != "deploy"`)
+
+	// Detached
+	signResp, err = service.Sign(ctx, &SignRequest{
+		Data:     data,
+		Signer:   alice.ID().String(),
+		Detached: true,
+		Envelope: true,
+		Context:  "release",
+	})
+	require.NoError(t, err)
+
+	detachedResp, err := service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Data:    data,
+		Sig:     signResp.Data,
+		Context: "release",
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), detachedResp.Signer.ID)
+	require.Equal(t, "release", detachedResp.Envelope.Context)
+
+	_, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Data: []byte("other artifact"),
+		Sig:  signResp.Data,
+	})
+	require.EqualError(t, err, "envelope digest mismatch")
+
+	_, err = service.Verify(ctx, &VerifyRequest{Data: signResp.Data})
+	require.EqualError(t, err, "envelope is detached")
+
+	// No envelope
+	sig, err := saltpack.SignDetached(data, true, alice)
+	require.NoError(t, err)
+	detachedResp, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Data: data,
+		Sig:  sig,
+	})
+	require.NoError(t, err)
+	require.Nil(t, detachedResp.Envelope)
+	_, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Data:    data,
+		Sig:     sig,
+		Context: "release",
+	})
+	require.EqualError(t, err, `signature has no envelope (context "release" required)`)
+
+	_, err = service.Sign(ctx, &SignRequest{
+		Data:     data,
+		Signer:   alice.ID().String(),
+		Detached: true,
+		Format:   SSHSignature,
+		Context:  "release",
+	})
+	require.EqualError(t, err, "envelope not supported for SSHSIG_SIGNATURE_FORMAT signatures")
+}
+
+func TestEnvelopeFileStream(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	var out bytes.Buffer
+	client, clientCloseFn := newTestRPCClient(t, service, env, "", &out)
+	defer clientCloseFn()
+
+	data := []byte("artifact")
+	inPath := keys.RandTempPath()
+	defer os.Remove(inPath)
+	err := ioutil.WriteFile(inPath, data, 0600)
+	require.NoError(t, err)
+
+	// Attached
+	signResp, err := service.Sign(ctx, &SignRequest{
+		Data:    data,
+		Signer:  alice.ID().String(),
+		Context: "release",
+	})
+	require.NoError(t, err)
+	signedPath := keys.RandTempPath()
+	defer os.Remove(signedPath)
+	err = ioutil.WriteFile(signedPath, signResp.Data, 0600)
+	require.NoError(t, err)
+
+	verifiedPath := keys.RandTempPath()
+	_, err = verifyFile(client, signedPath, verifiedPath, "")
+	require.EqualError(t, err, "rpc error: code = Unknown desc = envelope not supported for file or stream verify (use verify)")
+	_, err = os.Stat(verifiedPath)
+	require.True(t, os.IsNotExist(err))
+
+	verifyClient, err := NewVerifyStreamClient(ctx, client.RPCClient())
+	require.NoError(t, err)
+	err = verifyClient.Send(&VerifyInput{Data: signResp.Data})
+	require.NoError(t, err)
+	err = verifyClient.CloseSend()
+	require.NoError(t, err)
+	_, err = verifyClient.Recv()
+	require.EqualError(t, err, "rpc error: code = Unknown desc = envelope not supported for file or stream verify (use verify)")
+
+	// Detached
+	signResp, err = service.Sign(ctx, &SignRequest{
+		Data:     data,
+		Signer:   alice.ID().String(),
+		Detached: true,
+		Context:  "release",
+	})
+	require.NoError(t, err)
+	sigPath := keys.RandTempPath()
+	defer os.Remove(sigPath)
+	err = ioutil.WriteFile(sigPath, signResp.Data, 0600)
+	require.NoError(t, err)
+
+	err = verifyDetachedFile(client, inPath, sigPath, "")
+	require.EqualError(t, err, "rpc error: code = Unknown desc = envelope not supported for file or stream verify (use verify)")
+	err = verifyDetachedStream(client, bytes.NewReader(data), sigPath, "")
+	require.EqualError(t, err, "rpc error: code = Unknown desc = envelope not supported for file or stream verify (use verify)")
+
+	// No envelope
+	sig, err := saltpack.SignDetached(data, false, alice)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(sigPath, sig, 0600)
+	require.NoError(t, err)
+	err = verifyDetachedFile(client, inPath, sigPath, "")
+	require.NoError(t, err)
+}
@@ -25,23 +25,55 @@ func (s *service) KeyExport(ctx context.Context, req *KeyExportRequest) (*KeyExp
 		return nil, keys.NewErrNotFound(id.String())
 	}
 
+	switch req.Type {
+	case OpenPGPExport:
+		if req.Password != "" {
+			return nil, errors.Errorf("password not supported for openpgp export")
+		}
+		// The OpenPGP public key includes a self-signature, so we need the
+		// private key.
+		sk := key.AsEdX25519()
+		if sk == nil {
+			return nil, errors.Errorf("openpgp export requires an edx25519 private key")
+		}
+		entity, err := s.openpgpEntity(sk)
+		if err != nil {
+			return nil, err
+		}
+		out, err := encodeOpenPGPPublicKey(entity)
+		if err != nil {
+			return nil, err
+		}
+		return &KeyExportResponse{Export: []byte(out)}, nil
+	}
+
 	if req.NoPassword && req.Password != "" {
 		return nil, errors.Errorf("no password option set with password")
 	}
 
-	if !req.NoPassword && !req.Public && req.Password == "" {
+	// Paper and JWK exports can't have a password.
+	if !req.NoPassword && !req.Public && req.Password == "" && exportTypeSupportsPassword(req.Type) {
 		return nil, errors.Errorf("password required for export (or set no password option)")
 	}
 
 	switch req.Type {
+	case PaperExport, JWKExport, PEMExport, AgeExport:
+		out, err := encodeKey(key, req.Type, req.Password, req.Public)
+		if err != nil {
+			return nil, err
+		}
+		return &KeyExportResponse{Export: []byte(out)}, nil
 	case SSHExport:
 		var kk keys.Key
 		if req.Public {
-			kk = key.AsPublic()
+			kk = keyring.AsPublic(key)
 		} else {
-			kk = key.As()
+			kk = keyring.As(key)
+		}
+		if kk == nil {
+			return nil, errors.Errorf("unsupported key type %s", key.Type)
 		}
-		out, err := keys.EncodeSSHKey(kk, req.Password)
+		out, err := keyring.EncodeSSHKey(kk, req.Password)
 		if err != nil {
 			return nil, err
 		}
@@ -63,3 +95,49 @@ func (s *service) KeyExport(ctx context.Context, req *KeyExportRequest) (*KeyExp
 		return nil, errors.Errorf("unknown export type %s", req.Type)
 	}
 }
+
+func exportTypeSupportsPassword(typ ExportType) bool {
+	switch typ {
+	case DefaultExport, SSHExport, PEMExport, AgeExport:
+		return true
+	default:
+		return false
+	}
+}
+
+// encodeKey encodes key for the keyring export types. Paper and JWK exports
+// don't support password protection.
+func encodeKey(key *api.Key, typ ExportType, password string, public bool) (string, error) {
+	var name string
+	var encode func(k keys.Key, password string) (string, error)
+	switch typ {
+	case PaperExport:
+		name = "paper"
+		encode = func(k keys.Key, _ string) (string, error) { return keyring.EncodePaperKey(k) }
+	case JWKExport:
+		name = "jwk"
+		encode = func(k keys.Key, _ string) (string, error) { return keyring.EncodeJWK(k) }
+	case PEMExport:
+		name, encode = "pem", keyring.EncodePEMKey
+	case AgeExport:
+		name, encode = "age", keyring.EncodeAgeKey
+	default:
+		return "", errors.Errorf("unknown export type %s", typ)
+	}
+	if password != "" && (typ == PaperExport || typ == JWKExport) {
+		return "", errors.Errorf("password not supported for %s export", name)
+	}
+	if public && typ == PaperExport {
+		return "", errors.Errorf("public not supported for %s export", name)
+	}
+	var kk keys.Key
+	if public {
+		kk = keyring.AsPublic(key)
+	} else {
+		kk = keyring.As(key)
+	}
+	if kk == nil {
+		return "", errors.Errorf("unsupported key type %s", key.Type)
+	}
+	return encode(kk, password)
+}
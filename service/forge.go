@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/keys-pub/keys/user"
+	"github.com/pkg/errors"
+)
+
+// Forge users link a key to a user on a (self-hosted) Git forge, like Gitea
+// or GitLab, registered by an admin on the key server, see api.ForgeUser.
+// They are forge (typed) sigchain statements, created with UserAdd.
+
+// forgeStatement is a forge user link.
+type forgeStatement struct {
+	api.ForgeUser
+}
+
+func (f *forgeStatement) verify(kid keys.ID) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	if f.URL == "" {
+		return errors.Errorf("no forge user url")
+	}
+	return nil
+}
+
+func (f *forgeStatement) describe() string {
+	return f.ID() + " " + f.URL
+}
+
+// forgeVerified is the last (local) forge user check.
+type forgeVerified struct {
+	Status     user.Status `msgpack:"status"`
+	Err        string      `msgpack:"err,omitempty"`
+	VerifiedAt time.Time   `msgpack:"verifiedAt,omitempty"`
+}
+
+func forgeVerifiedPath(kid keys.ID, seq int) string {
+	return dstore.Path("forge-verified", keys.StatementID(kid, seq))
+}
+
+// forgeSign returns the signed message for a forge user.
+func forgeSign(key *keys.EdX25519Key, forge string, name string) (string, *api.ForgeUser, error) {
+	urs, err := api.NormalizeForgeURL(forge)
+	if err != nil {
+		return "", nil, err
+	}
+	fu := &api.ForgeUser{Forge: urs, Name: name}
+	if err := fu.Validate(); err != nil {
+		return "", nil, err
+	}
+	msg, err := fu.Sign(key)
+	if err != nil {
+		return "", nil, err
+	}
+	return msg, fu, nil
+}
+
+// checkForgeRegistered checks the forge is registered on the key server.
+func (s *service) checkForgeRegistered(ctx context.Context, forge string) error {
+	forges, err := s.client.Forges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, f := range forges {
+		if f.URL == forge {
+			return nil
+		}
+	}
+	return errors.Errorf("forge not registered %s", forge)
+}
+
+// forgeUserAdd verifies and adds a forge statement to the sigchain.
+func (s *service) forgeUserAdd(ctx context.Context, key *keys.EdX25519Key, forge, name, urs string, localOnly bool) (*User, *keys.Statement, error) {
+	forge, err := api.NormalizeForgeURL(forge)
+	if err != nil {
+		return nil, nil, err
+	}
+	fu := &forgeStatement{api.ForgeUser{Forge: forge, Name: name, URL: urs}}
+	if err := fu.verify(key.ID()); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkForgeRegistered(ctx, forge); err != nil {
+		return nil, nil, err
+	}
+	status, err := api.VerifyForgeUser(ctx, s.users.Client(), key.ID(), &fu.ForgeUser)
+	if err != nil {
+		return nil, nil, errors.Errorf("user check failed: %s", err)
+	}
+
+	sc, err := s.scs.Sigchain(key.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := json.Marshal(fu)
+	if err != nil {
+		return nil, nil, err
+	}
+	st, err := keys.NewSigchainStatement(sc, b, key, api.ForgeStatementType, s.clock.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sc.Add(st); err != nil {
+		return nil, nil, err
+	}
+	if !localOnly {
+		if err := s.client.SigchainSave(ctx, st); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := s.scs.Save(sc); err != nil {
+		return nil, nil, err
+	}
+	if localOnly {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	verified := &forgeVerified{Status: status, VerifiedAt: s.clock.Now()}
+	if err := s.db.Set(ctx, forgeVerifiedPath(key.ID(), st.Seq), dstore.From(verified)); err != nil {
+		return nil, nil, err
+	}
+
+	return &User{
+		ID:         fu.ID(),
+		KID:        key.ID().String(),
+		Seq:        int32(st.Seq),
+		Service:    api.ForgeService,
+		Name:       fu.Name,
+		URL:        fu.URL,
+		Status:     userStatus(status),
+		VerifiedAt: tsutil.Millis(verified.VerifiedAt),
+		Timestamp:  tsutil.Millis(verified.VerifiedAt),
+	}, st, nil
+}
+
+// hasForgeUser returns true if the sigchain has a forge user.
+func (s *service) hasForgeUser(kid keys.ID) (bool, error) {
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return false, err
+	}
+	return sc != nil && len(sc.FindAll(api.ForgeStatementType)) > 0, nil
+}
+
+// ensureForgeUsersVerified checks the forge users in the sigchain, if not
+// verified recently (see userVerifiedExpire).
+func (s *service) ensureForgeUsersVerified(ctx context.Context, kid keys.ID) error {
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return err
+	}
+	if sc == nil {
+		return nil
+	}
+	for _, st := range sc.FindAll(api.ForgeStatementType) {
+		data, err := decodeStatementData(kid, st.Type, st.Data)
+		if err != nil {
+			return err
+		}
+		fu := data.(*forgeStatement)
+
+		path := forgeVerifiedPath(kid, st.Seq)
+		var verified forgeVerified
+		ok, err := s.db.Load(ctx, path, &verified)
+		if err != nil {
+			return err
+		}
+		if ok && verified.Status == user.StatusOK && s.clock.Now().Sub(verified.VerifiedAt) < userVerifiedExpire {
+			continue
+		}
+
+		logger.Infof("Checking forge user %s", fu.ID())
+		status, err := api.VerifyForgeUser(ctx, s.users.Client(), kid, &fu.ForgeUser)
+		verified = forgeVerified{Status: status, VerifiedAt: verified.VerifiedAt}
+		if err != nil {
+			verified.Err = err.Error()
+		}
+		if status == user.StatusOK {
+			verified.VerifiedAt = s.clock.Now()
+		}
+		if err := s.db.Set(ctx, path, dstore.From(verified)); err != nil {
+			return err
+		}
+		if status != user.StatusOK {
+			return errors.Errorf("user %s has failed status %s", fu.ID(), status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+// testForge is a forge (Gitea/GitLab) stand-in serving raw files.
+type testForge struct {
+	*httptest.Server
+	files map[string]string
+}
+
+func newTestForge(t *testing.T) *testForge {
+	forge := &testForge{files: map[string]string{}}
+	forge.Server = httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, ok := forge.files[r.URL.Path]
+		if !ok {
+			nethttp.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(forge.Close)
+	return forge
+}
+
+func TestForgeUser(t *testing.T) {
+	env := newTestEnv(t)
+	env.admins = []keys.ID{charlie.ID()}
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+
+	forge := newTestForge(t)
+	host := strings.TrimPrefix(forge.URL, "http://")
+	path := "/alice/keys.pub/-/raw/main/keyspub.txt"
+
+	signResp, err := aliceService.UserSign(ctx, &UserSignRequest{
+		KID:     alice.ID().String(),
+		Service: "forge",
+		Name:    "alice",
+		Forge:   forge.URL + "/",
+	})
+	require.NoError(t, err)
+
+	add := func() (*UserAddResponse, error) {
+		return aliceService.UserAdd(ctx, &UserAddRequest{
+			KID:     alice.ID().String(),
+			Service: "forge",
+			Name:    "alice",
+			URL:     forge.URL + path,
+			Forge:   forge.URL,
+		})
+	}
+
+	// Not registered
+	_, err = add()
+	require.EqualError(t, err, "forge not registered "+forge.URL)
+
+	err = aliceService.client.AdminForgeAdd(ctx, forge.URL, charlie)
+	require.NoError(t, err)
+
+	// No signed message
+	_, err = add()
+	require.EqualError(t, err, "user check failed: resource not found")
+
+	// Not in the user namespace
+	_, err = aliceService.UserAdd(ctx, &UserAddRequest{
+		KID:     alice.ID().String(),
+		Service: "forge",
+		Name:    "alice",
+		URL:     forge.URL + "/bob/keyspub.txt",
+		Forge:   forge.URL,
+	})
+	require.EqualError(t, err, "forge user url \""+forge.URL+"/bob/keyspub.txt\" is not in "+forge.URL+"/alice/")
+
+	forge.files[path] = signResp.Message
+	addResp, err := add()
+	require.NoError(t, err)
+	require.Equal(t, "alice@"+host, addResp.User.ID)
+	require.Equal(t, UserStatusOK, addResp.User.Status)
+	require.Equal(t, "forge", addResp.Statement.Type)
+
+	// Typed statements can't create forge users (use UserAdd)
+	_, err = aliceService.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "forge",
+		Data: addResp.Statement.Data,
+	})
+	require.EqualError(t, err, "statement type forge is reserved")
+
+	// Bob verifies
+	testPull(t, bobService, alice.ID())
+	_, err = bobService.verifyKey(ctx, alice.ID())
+	require.NoError(t, err)
+
+	// Signed message removed (verified recently)
+	delete(forge.files, path)
+	_, err = bobService.verifyKey(ctx, alice.ID())
+	require.NoError(t, err)
+
+	// Verify expired
+	env.clock.Add(time.Hour * 25)
+	_, err = bobService.verifyKey(ctx, alice.ID())
+	require.EqualError(t, err, "user alice@"+host+" has failed status resource-not-found")
+
+	forge.files[path] = signResp.Message
+	_, err = bobService.verifyKey(ctx, alice.ID())
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ module github.com/keys-pub/keys-ext/service
 go 1.14
 
 require (
+	github.com/ProtonMail/go-crypto v0.0.0-20210428141323-04723f9f07d7
 	github.com/alta/protopatch v0.3.4
 	github.com/cpuguy83/go-md2man/v2 v2.0.0 // indirect
 	github.com/davecgh/go-spew v1.1.1
@@ -10,7 +11,8 @@ require (
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.5.6 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0
-	github.com/keybase/saltpack v0.0.0-20210611181147-9dd0a21addc6 // indirect
+	github.com/keybase/go-codec v0.0.0-20180928230036-164397562123
+	github.com/keybase/saltpack v0.0.0-20210611181147-9dd0a21addc6
 	github.com/keys-pub/keys v0.1.22-0.20210708223433-a34d3ce96fb2
 	github.com/keys-pub/keys-ext/auth/fido2 v0.0.0-20210708231605-d6538034681a
 	github.com/keys-pub/keys-ext/auth/mock v0.0.0-20210401184359-d3fda856e211
@@ -31,7 +33,7 @@ require (
 	github.com/sirupsen/logrus v1.8.1
 	github.com/stretchr/testify v1.7.0
 	github.com/urfave/cli v1.22.5
-	golang.org/x/crypto v0.0.0-20210616213533-5ff15b29337e // indirect
+	golang.org/x/crypto v0.0.0-20210616213533-5ff15b29337e
 	golang.org/x/net v0.0.0-20210614182718-04defd469f4e // indirect
 	golang.org/x/sys v0.0.0-20210630005230-0f9fa26af87c // indirect
 	golang.org/x/term v0.0.0-20210615171337-6886f2dfbf5b
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/contacts"
+	"github.com/keys-pub/keys-ext/vault/groups"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// Group (RPC) returns a recipient group by name.
+func (s *service) Group(ctx context.Context, req *GroupRequest) (*GroupResponse, error) {
+	gv := groups.New(s.vault)
+	group, err := gv.Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, keys.NewErrNotFound(req.Name)
+	}
+	return &GroupResponse{
+		Group: groupToRPC(group),
+	}, nil
+}
+
+// GroupSave (RPC) creates or updates a recipient group.
+func (s *service) GroupSave(ctx context.Context, req *GroupSaveRequest) (*GroupSaveResponse, error) {
+	name := groups.NormalizeName(req.Name)
+	if err := groups.ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	gv := groups.New(s.vault)
+	for _, member := range req.Members {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+		if groups.IsGroupName(member) {
+			if groups.NormalizeName(member) == name {
+				return nil, errors.Errorf("group can't include itself")
+			}
+			group, err := gv.Get(member)
+			if err != nil {
+				return nil, err
+			}
+			if group == nil {
+				inGroup, err := contacts.New(s.vault).InGroup(member)
+				if err != nil {
+					return nil, err
+				}
+				if len(inGroup) == 0 {
+					return nil, errors.Errorf("group not found %s", member)
+				}
+			}
+			continue
+		}
+		if _, err := s.lookup(ctx, member, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	group, err := gv.Save(name, req.Members)
+	if err != nil {
+		return nil, err
+	}
+	return &GroupSaveResponse{
+		Group: groupToRPC(group),
+	}, nil
+}
+
+// GroupRemove (RPC) removes a recipient group.
+func (s *service) GroupRemove(ctx context.Context, req *GroupRemoveRequest) (*GroupRemoveResponse, error) {
+	if req.Name == "" {
+		return nil, errors.Errorf("no group specified")
+	}
+	gv := groups.New(s.vault)
+	ok, err := gv.Delete(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, keys.NewErrNotFound(req.Name)
+	}
+	return &GroupRemoveResponse{}, nil
+}
+
+// Groups (RPC) lists recipient groups.
+func (s *service) Groups(ctx context.Context, req *GroupsRequest) (*GroupsResponse, error) {
+	gv := groups.New(s.vault)
+	gs, err := gv.List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Group, 0, len(gs))
+	for _, g := range gs {
+		out = append(out, groupToRPC(g))
+	}
+	return &GroupsResponse{
+		Groups: out,
+	}, nil
+}
+
+func groupToRPC(g *groups.Group) *Group {
+	return &Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		Members:   g.Members,
+		CreatedAt: tsutil.Millis(g.CreatedAt),
+		UpdatedAt: tsutil.Millis(g.UpdatedAt),
+	}
+}
+
+// groupExpansion is a group (@name) and the members it resolved to.
+type groupExpansion struct {
+	name    string
+	members []string
+}
+
+// expandRecipients replaces group (@name) recipients with their members,
+// including members of nested groups and contacts in the group, and contact
+// names with the contact kids and users.
+func (s *service) expandRecipients(recipients []string) ([]string, []*groupExpansion, error) {
+	out := []string{}
+	expansions := []*groupExpansion{}
+	gv := groups.New(s.vault)
+	cv := contacts.New(s.vault)
+	for _, recipient := range recipients {
+		var members []string
+		var name string
+		switch {
+		case groups.IsGroupName(recipient):
+			m, err := expandGroup(gv, cv, recipient, map[string]bool{})
+			if err != nil {
+				return nil, nil, err
+			}
+			members, name = m, "@"+groups.NormalizeName(recipient)
+		default:
+			cname, recs, err := expandContact(cv, recipient)
+			if err != nil {
+				return nil, nil, err
+			}
+			if recs == nil {
+				out = append(out, recipient)
+				continue
+			}
+			members, name = recs, cname
+		}
+		expansions = append(expansions, &groupExpansion{
+			name:    name,
+			members: members,
+		})
+		out = append(out, members...)
+	}
+	return out, expansions, nil
+}
+
+func expandGroup(gv *groups.Groups, cv *contacts.Contacts, name string, visited map[string]bool) ([]string, error) {
+	name = groups.NormalizeName(name)
+	if visited[name] {
+		return nil, errors.Errorf("group cycle @%s", name)
+	}
+	visited[name] = true
+	defer delete(visited, name)
+
+	group, err := gv.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	inGroup, err := cv.InGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil && len(inGroup) == 0 {
+		return nil, errors.Errorf("group not found @%s", name)
+	}
+	out := []string{}
+	if group != nil {
+		for _, member := range group.Members {
+			switch {
+			case groups.IsGroupName(member):
+				nested, err := expandGroup(gv, cv, member, visited)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, nested...)
+			default:
+				_, recs, err := expandContact(cv, member)
+				if err != nil {
+					return nil, err
+				}
+				if recs == nil {
+					out = append(out, member)
+					continue
+				}
+				out = append(out, recs...)
+			}
+		}
+	}
+	for _, contact := range inGroup {
+		out = append(out, contact.Recipients()...)
+	}
+	return out, nil
+}
+
+// groupsInfo describes which recipients groups resolved to, for example:
+//
+//	@backend-team: alice@github (kex1...), bob@github (kex1...)
+func (s *service) groupsInfo(ctx context.Context, expansions []*groupExpansion) (string, error) {
+	lines := make([]string, 0, len(expansions))
+	for _, e := range expansions {
+		members := make([]string, 0, len(e.members))
+		for _, member := range e.members {
+			kid, err := s.lookup(ctx, member, nil)
+			if err != nil {
+				return "", err
+			}
+			if member == kid.String() {
+				members = append(members, member)
+			} else {
+				members = append(members, fmt.Sprintf("%s (%s)", member, kid))
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", e.name, strings.Join(members, ", ")))
+	}
+	return strings.Join(lines, "\n"), nil
+}
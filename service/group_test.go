@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroups(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testImportID(t, aliceService, bob.ID())
+	testImportID(t, aliceService, charlie.ID())
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	saveResp, err := aliceService.GroupSave(ctx, &GroupSaveRequest{
+		Name:    "@Backend-Team",
+		Members: []string{bob.ID().String()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "backend-team", saveResp.Group.Name)
+
+	_, err = aliceService.GroupSave(ctx, &GroupSaveRequest{
+		Name:    "eng",
+		Members: []string{"@backend-team", charlie.ID().String()},
+	})
+	require.NoError(t, err)
+
+	_, err = aliceService.GroupSave(ctx, &GroupSaveRequest{
+		Name:    "ops",
+		Members: []string{"@missing"},
+	})
+	require.EqualError(t, err, "group not found @missing")
+
+	_, err = aliceService.GroupSave(ctx, &GroupSaveRequest{Name: "ops"})
+	require.EqualError(t, err, "no group members")
+
+	groupsResp, err := aliceService.Groups(ctx, &GroupsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(groupsResp.Groups))
+	require.Equal(t, "backend-team", groupsResp.Groups[0].Name)
+	require.Equal(t, "eng", groupsResp.Groups[1].Name)
+
+	// Encrypt to group
+	encryptResp, err := aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi team"),
+		Recipients: []string{"@eng"},
+		Sender:     alice.ID().String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "@eng: "+bob.ID().String()+", "+charlie.ID().String(), encryptResp.Info)
+
+	decryptResp, err := bobService.Decrypt(ctx, &DecryptRequest{Data: encryptResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi team"), decryptResp.Data)
+
+	_, err = aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi"),
+		Recipients: []string{"@missing"},
+	})
+	require.EqualError(t, err, "group not found @missing")
+
+	// Update (cycle)
+	_, err = aliceService.GroupSave(ctx, &GroupSaveRequest{
+		Name:    "backend-team",
+		Members: []string{"@eng"},
+	})
+	require.NoError(t, err)
+	_, err = aliceService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi"),
+		Recipients: []string{"@eng"},
+	})
+	require.EqualError(t, err, "group cycle @eng")
+
+	// Remove
+	_, err = aliceService.GroupRemove(ctx, &GroupRemoveRequest{Name: "backend-team"})
+	require.NoError(t, err)
+	_, err = aliceService.GroupRemove(ctx, &GroupRemoveRequest{Name: "backend-team"})
+	require.EqualError(t, err, "backend-team not found")
+	groupsResp, err = aliceService.Groups(ctx, &GroupsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groupsResp.Groups))
+}
@@ -12,7 +12,7 @@ import (
 
 // KeyImport (RPC) imports a key.
 func (s *service) KeyImport(ctx context.Context, req *KeyImportRequest) (*KeyImportResponse, error) {
-	key, err := api.ParseKey(req.In, req.Password)
+	key, format, err := keyring.DetectKey(req.In, req.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,8 @@ func (s *service) KeyImport(ctx context.Context, req *KeyImportRequest) (*KeyImp
 	}
 
 	return &KeyImportResponse{
-		KID: key.ID.String(),
+		KID:    key.ID.String(),
+		Format: string(format),
 	}, nil
 }
 
@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/vault/keyring"
 	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/bech32"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +31,7 @@ func TestKeyImport(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, key.ID().String(), importResp.KID)
+	require.Equal(t, "saltpack", importResp.Format)
 
 	keyResp, err := service.Key(ctx, &KeyRequest{Key: key.ID().String()})
 	require.NoError(t, err)
@@ -51,6 +54,7 @@ func TestKeyImport(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, bob.ID().String(), importResp.KID)
+	require.Equal(t, "id", importResp.Format)
 
 	// Import (charlie, ID with whitespace)
 	importResp, err = service.KeyImport(ctx, &KeyImportRequest{
@@ -89,3 +93,51 @@ func TestKeyImportSaltpack(t *testing.T) {
 	require.Equal(t, 1, len(keysResp.Keys))
 	require.Equal(t, "kex16v9uk4t5wykkklpkrcane3p267n8eu95y3fd55yv4h45m6ku3hyqx2a5fn", keysResp.Keys[0].ID)
 }
+
+func TestKeyImportAge(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	bk := keys.GenerateX25519Key()
+	identity, err := bech32.Encode("age-secret-key-", bk.PrivateKey()[:])
+	require.NoError(t, err)
+
+	importResp, err := service.KeyImport(ctx, &KeyImportRequest{
+		In: []byte("# created: 2021-07-01T00:00:00Z\n" + strings.ToUpper(identity) + "\n"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, bk.ID().String(), importResp.KID)
+	require.Equal(t, "age", importResp.Format)
+
+	kr := keyring.New(service.vault)
+	out, err := kr.X25519Keys()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(out))
+	require.Equal(t, bk.ID(), out[0].ID())
+}
+
+func TestKeyImportSecp256k1(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+
+	k1 := keyring.GenerateSecp256k1Key()
+	pem, err := keyring.EncodePEMKey(k1, "")
+	require.NoError(t, err)
+
+	importResp, err := service.KeyImport(ctx, &KeyImportRequest{In: []byte(pem)})
+	require.NoError(t, err)
+	require.Equal(t, k1.ID().String(), importResp.KID)
+	require.Equal(t, "pkcs8", importResp.Format)
+
+	kr := keyring.New(service.vault)
+	out, err := kr.Secp256k1Keys()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(out))
+	require.Equal(t, k1.ID(), out[0].ID())
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/keys-pub/keys"
 	"github.com/keys-pub/keys-ext/vault/keyring"
@@ -31,6 +32,11 @@ func (s *service) Key(ctx context.Context, req *KeyRequest) (*KeyResponse, error
 	if err != nil {
 		return nil, err
 	}
+	endorsements, err := s.endorsements(kid)
+	if err != nil {
+		return nil, err
+	}
+	key.Endorsements = endorsements
 
 	return &KeyResponse{
 		Key: key,
@@ -41,7 +47,16 @@ func (s *service) verifyKey(ctx context.Context, kid keys.ID) (*Key, error) {
 	if err := s.ensureUserVerified(ctx, kid); err != nil {
 		return nil, err
 	}
-	return s.key(ctx, kid)
+	key, err := s.key(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	warning, err := s.trustWarning(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	key.TrustWarning = warning
+	return key, nil
 }
 
 func (s *service) keyToRPC(ctx context.Context, key *api.Key, saved bool) (*Key, error) {
@@ -54,6 +69,20 @@ func (s *service) keyToRPC(ctx context.Context, key *api.Key, saved bool) (*Key,
 		Saved:     saved,
 		IsPrivate: len(key.Private) > 0,
 	}
+	if saved {
+		md := keyring.MetadataForKey(key)
+		out.Label = md.Label
+		out.Notes = md.Notes
+		out.Tags = md.Tags
+		if !md.ExpiresAt.IsZero() {
+			out.ExpiresAt = tsutil.Millis(md.ExpiresAt)
+			out.Expired = keyring.IsExpired(key, s.clock.Now())
+		}
+		root, path := keyring.DerivedFrom(key)
+		out.Root = root.String()
+		out.Path = path
+		out.SSHAgent = sshAgentPolicyToRPC(md.SSHAgent)
+	}
 
 	if err := s.fillKey(ctx, key.ID, out); err != nil {
 		return nil, err
@@ -81,6 +110,12 @@ func (s *service) fillKey(ctx context.Context, kid keys.ID, key *Key) error {
 	}
 	key.User = userResultToRPC(res)
 
+	trust, err := s.trustLevel(kid)
+	if err != nil {
+		return err
+	}
+	key.Trust = trust
+
 	// Sigchain info
 	sc, err := s.scs.Sigchain(kid)
 	if err != nil {
@@ -92,6 +127,11 @@ func (s *service) fillKey(ctx context.Context, kid keys.ID, key *Key) error {
 		if last != nil {
 			key.SigchainUpdatedAt = tsutil.Millis(last.Timestamp)
 		}
+		rotated, err := s.rotatedTo(kid)
+		if err != nil {
+			logger.Warningf("Invalid key rotation for %s: %v", kid, err)
+		}
+		key.RotatedTo = rotated.String()
 	}
 	return nil
 }
@@ -126,29 +166,121 @@ func (s *service) KeyRemove(ctx context.Context, req *KeyRemoveRequest) (*KeyRem
 	return &KeyRemoveResponse{}, nil
 }
 
+// KeyUpdate (RPC) updates key label, notes, tags and expiry.
+func (s *service) KeyUpdate(ctx context.Context, req *KeyUpdateRequest) (*KeyUpdateResponse, error) {
+	kid, err := s.parseKID(req.KID)
+	if err != nil {
+		return nil, err
+	}
+	kr := keyring.New(s.vault)
+	key, err := kr.Get(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+	md := keyring.MetadataForKey(key)
+	if err := keyUpdateMetadata(md, req); err != nil {
+		return nil, err
+	}
+	key, err = kr.SaveMetadata(kid, md)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.keyToRPC(ctx, key, true)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyUpdateResponse{
+		Key: out,
+	}, nil
+}
+
+// keyUpdateMetadata sets the request fields on the metadata, or the non-zero
+// fields if none are specified.
+func keyUpdateMetadata(md *keyring.Metadata, req *KeyUpdateRequest) error {
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = keyUpdateNonZeroFields(req)
+	}
+	for _, field := range fields {
+		switch field {
+		case "label":
+			md.Label = req.Label
+		case "notes":
+			md.Notes = req.Notes
+		case "tags":
+			md.Tags = req.Tags
+		case "expiresAt":
+			md.ExpiresAt = time.Time{}
+			if req.ExpiresAt != 0 {
+				md.ExpiresAt = tsutil.ParseMillis(req.ExpiresAt)
+			}
+		case "sshAgent":
+			md.SSHAgent = sshAgentPolicyFromRPC(req.SSHAgent)
+		default:
+			return errors.Errorf("invalid field %s", field)
+		}
+	}
+	return nil
+}
+
+// keyUpdateNonZeroFields returns the fields set in the request.
+func keyUpdateNonZeroFields(req *KeyUpdateRequest) []string {
+	fields := []string{}
+	if req.Label != "" {
+		fields = append(fields, "label")
+	}
+	if req.Notes != "" {
+		fields = append(fields, "notes")
+	}
+	if len(req.Tags) > 0 {
+		fields = append(fields, "tags")
+	}
+	if req.ExpiresAt != 0 {
+		fields = append(fields, "expiresAt")
+	}
+	if req.SSHAgent != SSHAgentAllow {
+		fields = append(fields, "sshAgent")
+	}
+	return fields
+}
+
 // KeyGenerate (RPC) creates a key.
 func (s *service) KeyGenerate(ctx context.Context, req *KeyGenerateRequest) (*KeyGenerateResponse, error) {
 	if req.Type == "" {
 		return nil, errors.Errorf("no key type specified")
 	}
-	var key keys.Key
-	switch req.Type {
-	case string(keys.EdX25519):
-		key = keys.GenerateEdX25519Key()
-	case string(keys.X25519):
-		key = keys.GenerateX25519Key()
-	default:
-		return nil, errors.Errorf("unknown key type %s", req.Type)
-	}
-	vk := api.NewKey(key)
-	now := s.clock.NowMillis()
-	vk.CreatedAt = now
-	vk.UpdatedAt = now
 	kr := keyring.New(s.vault)
-	if err := kr.Save(vk); err != nil {
-		return nil, err
+	var vk *api.Key
+	if req.Root != "" || req.Path != "" {
+		dk, err := s.derive(req.Type, req.Root, req.Path)
+		if err != nil {
+			return nil, err
+		}
+		// If we already have the derived key, keep the existing key (and metadata).
+		existing, err := kr.Get(dk.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return &KeyGenerateResponse{KID: existing.ID.String()}, nil
+		}
+		vk = dk
+	} else {
+		switch req.Type {
+		case string(keys.EdX25519):
+			vk = api.NewKey(keys.GenerateEdX25519Key())
+		case string(keys.X25519):
+			vk = api.NewKey(keys.GenerateX25519Key())
+		case string(keyring.Secp256k1):
+			vk = api.NewKey(keyring.GenerateSecp256k1Key())
+		default:
+			return nil, errors.Errorf("unknown key type %s", req.Type)
+		}
 	}
-	if err := s.scs.Index(vk.ID); err != nil {
+	if err := s.saveKey(vk); err != nil {
 		return nil, err
 	}
 
@@ -157,6 +289,36 @@ func (s *service) KeyGenerate(ctx context.Context, req *KeyGenerateRequest) (*Ke
 	}, nil
 }
 
+// saveKey saves a new (generated or derived) key to the keyring.
+func (s *service) saveKey(vk *api.Key) error {
+	now := s.clock.NowMillis()
+	vk.CreatedAt = now
+	vk.UpdatedAt = now
+	if err := keyring.New(s.vault).Save(vk); err != nil {
+		return err
+	}
+	return s.scs.Index(vk.ID)
+}
+
+// derive a key of type from root key and path.
+func (s *service) derive(typ string, root string, path string) (*api.Key, error) {
+	if root == "" {
+		return nil, errors.Errorf("no root key specified")
+	}
+	if path == "" {
+		return nil, errors.Errorf("no path specified")
+	}
+	rid, err := s.parseKID(root)
+	if err != nil {
+		return nil, err
+	}
+	rk, err := s.edx25519Key(rid)
+	if err != nil {
+		return nil, err
+	}
+	return keyring.DeriveKey(rk, keys.KeyType(typ), path)
+}
+
 func (s *service) parseKID(kid string) (keys.ID, error) {
 	if kid == "" {
 		return "", errors.Errorf("no kid specified")
@@ -193,11 +193,71 @@ func TestKeyGenerate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, genResp.KID, resp.Key.ID)
 
+	// Generate secp256k1
+	genResp, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: string(keyring.Secp256k1)})
+	require.NoError(t, err)
+	k1kid, err := keys.ParseID(genResp.KID)
+	require.NoError(t, err)
+	k1s, err := kr.Secp256k1Keys()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(k1s))
+	require.Equal(t, k1kid, k1s[0].ID())
+
 	// Generate invalid
 	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: "invalidkeytype"})
 	require.EqualError(t, err, "unknown key type invalidkeytype")
 }
 
+func TestKeyGenerateDerived(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	genResp, err := service.KeyGenerate(ctx, &KeyGenerateRequest{
+		Type: string(keys.EdX25519),
+		Root: alice.ID().String(),
+		Path: "backend/signing",
+	})
+	require.NoError(t, err)
+	expected, err := keyring.DeriveEdX25519Key(alice, "backend/signing")
+	require.NoError(t, err)
+	require.Equal(t, expected.ID().String(), genResp.KID)
+
+	resp, err := service.Key(ctx, &KeyRequest{Key: genResp.KID})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), resp.Key.Root)
+	require.Equal(t, "backend/signing", resp.Key.Path)
+
+	// Generate again returns the same key
+	genResp2, err := service.KeyGenerate(ctx, &KeyGenerateRequest{
+		Type: string(keys.EdX25519),
+		Root: alice.ID().String(),
+		Path: "backend/signing",
+	})
+	require.NoError(t, err)
+	require.Equal(t, genResp.KID, genResp2.KID)
+
+	// X25519
+	genResp, err = service.KeyGenerate(ctx, &KeyGenerateRequest{
+		Type: string(keys.X25519),
+		Root: alice.ID().String(),
+		Path: "backend/encrypt",
+	})
+	require.NoError(t, err)
+	require.True(t, keys.ID(genResp.KID).IsX25519())
+
+	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: string(keys.EdX25519), Path: "backend"})
+	require.EqualError(t, err, "no root key specified")
+	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: string(keys.EdX25519), Root: alice.ID().String()})
+	require.EqualError(t, err, "no path specified")
+	randKey := keys.GenerateEdX25519Key()
+	_, err = service.KeyGenerate(ctx, &KeyGenerateRequest{Type: string(keys.EdX25519), Root: randKey.ID().String(), Path: "backend"})
+	require.EqualError(t, err, fmt.Sprintf("%s not found", randKey.ID()))
+}
+
 func TestKeyRemove(t *testing.T) {
 	// SetLogger(NewLogger(DebugLevel))
 	env := newTestEnv(t)
@@ -231,3 +291,98 @@ func TestKeyRemove(t *testing.T) {
 	_, err = service.KeyRemove(ctx, &KeyRemoveRequest{KID: randKey.ID().String()})
 	require.EqualError(t, err, fmt.Sprintf("%s not found", randKey.ID()))
 }
+
+func TestKeyUpdate(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+
+	expiresAt := env.clock.NowMillis() + 1000*60*60*24
+	resp, err := service.KeyUpdate(ctx, &KeyUpdateRequest{
+		KID:       alice.ID().String(),
+		Label:     "Release signing",
+		Notes:     "Paper backup in safe",
+		Tags:      []string{"release"},
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Release signing", resp.Key.Label)
+	require.Equal(t, "Paper backup in safe", resp.Key.Notes)
+	require.Equal(t, []string{"release"}, resp.Key.Tags)
+	require.Equal(t, expiresAt, resp.Key.ExpiresAt)
+	require.False(t, resp.Key.Expired)
+
+	var buf bytes.Buffer
+	fmtKey(&buf, resp.Key)
+	require.Equal(t, "kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077 \"Release signing\"\n", buf.String())
+
+	// Query
+	keysResp, err := service.Keys(ctx, &KeysRequest{Query: "release"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keysResp.Keys))
+	require.Equal(t, alice.ID().String(), keysResp.Keys[0].ID)
+	keysResp, err = service.Keys(ctx, &KeysRequest{Query: "safe"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keysResp.Keys))
+	keysResp, err = service.Keys(ctx, &KeysRequest{Query: "deploy"})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(keysResp.Keys))
+
+	// Runtime status
+	status, err := service.RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(status.KeyExpiries))
+	require.Equal(t, alice.ID().String(), status.KeyExpiries[0].KID)
+	require.Equal(t, "Release signing", status.KeyExpiries[0].Label)
+	require.False(t, status.KeyExpiries[0].Expired)
+
+	// Update fields (others unchanged)
+	resp, err = service.KeyUpdate(ctx, &KeyUpdateRequest{
+		KID:    alice.ID().String(),
+		Notes:  "Moved to vault",
+		Fields: []string{"notes"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Release signing", resp.Key.Label)
+	require.Equal(t, "Moved to vault", resp.Key.Notes)
+	require.Equal(t, []string{"release"}, resp.Key.Tags)
+	require.Equal(t, expiresAt, resp.Key.ExpiresAt)
+
+	// Remove expiry
+	resp, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), Fields: []string{"expiresAt"}})
+	require.NoError(t, err)
+	require.Equal(t, "Release signing", resp.Key.Label)
+	require.Empty(t, resp.Key.ExpiresAt)
+	status, err = service.RuntimeStatus(ctx, &RuntimeStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(status.KeyExpiries))
+
+	// Update non-zero fields (others unchanged)
+	resp, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), Label: "Releases"})
+	require.NoError(t, err)
+	require.Equal(t, "Releases", resp.Key.Label)
+	require.Equal(t, "Moved to vault", resp.Key.Notes)
+	require.Equal(t, []string{"release"}, resp.Key.Tags)
+
+	// Clear fields
+	resp, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), Fields: []string{"label", "notes"}})
+	require.NoError(t, err)
+	require.Empty(t, resp.Key.Label)
+	require.Empty(t, resp.Key.Notes)
+	require.Equal(t, []string{"release"}, resp.Key.Tags)
+
+	// Invalid field
+	_, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), Fields: []string{"kid"}})
+	require.EqualError(t, err, "invalid field kid")
+
+	// Not found
+	randKey := keys.GenerateEdX25519Key()
+	_, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: randKey.ID().String()})
+	require.EqualError(t, err, fmt.Sprintf("%s not found", randKey.ID()))
+	_, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: randKey.ID().String(), Fields: []string{"label"}})
+	require.EqualError(t, err, fmt.Sprintf("%s not found", randKey.ID()))
+}
@@ -56,7 +56,7 @@ func (s *service) filterKeys(ctx context.Context, ks []*api.Key, saved bool, que
 		if err != nil {
 			return nil, err
 		}
-		if query == "" || (key.User != nil && strings.HasPrefix(key.User.ID, query)) || strings.HasPrefix(key.ID, query) {
+		if matchKey(k, key, query) {
 			keys = append(keys, key)
 		}
 	}
@@ -73,6 +73,22 @@ func (s *service) filterKeys(ctx context.Context, ks []*api.Key, saved bool, que
 	return keys, nil
 }
 
+func matchKey(k *api.Key, key *Key, query string) bool {
+	if query == "" {
+		return true
+	}
+	if key.User != nil && strings.HasPrefix(key.User.ID, query) {
+		return true
+	}
+	if strings.HasPrefix(key.ID, query) {
+		return true
+	}
+	if key.Saved && keyring.MetadataForKey(k).MatchQuery(query) {
+		return true
+	}
+	return false
+}
+
 func keysSort(pks []*Key, sortField string, sortDirection SortDirection, i, j int) bool {
 	switch sortField {
 	case "type":
@@ -13,4 +13,5 @@ ver="0.0.0-dev-$ts"
 
 DEBUG=1 VERSION=$ver DATE=$date "$scripts/gobuild.sh" keysd "$dir"
 DEBUG=1 VERSION=$ver DATE=$date "$scripts/gobuild.sh" keys  "$dir/../keys"
+DEBUG=1 VERSION=$ver DATE=$date "$scripts/gobuild.sh" keys-sshsig "$dir/../keys-sshsig"
 
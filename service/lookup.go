@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/contacts"
 	"github.com/keys-pub/keys/user"
 	"github.com/pkg/errors"
 )
@@ -15,6 +16,9 @@ type lookupOpts struct {
 	VerifyUser bool
 	// SearchRemote to look for the key on the server if not found locally.
 	SearchRemote bool
+	// CheckPin fails if the user has a different key pinned, and pins the
+	// key if the user has none (TOFU).
+	CheckPin bool
 }
 
 // lookup a key by kid or user@service.
@@ -26,7 +30,7 @@ func (s *service) lookup(ctx context.Context, key string, opts *lookupOpts) (key
 		opts = &lookupOpts{}
 	}
 
-	kid, err := s.lookupKID(ctx, key, opts.SearchRemote)
+	kid, userKID, err := s.lookupKID(ctx, key, opts.SearchRemote)
 	if err != nil {
 		return "", err
 	}
@@ -35,46 +39,85 @@ func (s *service) lookup(ctx context.Context, key string, opts *lookupOpts) (key
 	}
 
 	if opts.VerifyUser {
-		res, err := s.users.Get(ctx, kid)
-		if err != nil {
+		// Check the key the user resolved to (before following rotations) and
+		// the current key.
+		if err := s.checkUserStatus(ctx, userKID); err != nil {
 			return "", err
 		}
-		if res == nil {
-			return kid, nil
+		if kid != userKID {
+			if err := s.checkUserStatus(ctx, kid); err != nil {
+				return "", err
+			}
 		}
-		if res.Status != user.StatusOK {
-			return "", errors.Errorf("user %s has failed status %s", res.User.ID(), res.Status)
+	}
+
+	if opts.CheckPin {
+		if err := s.checkPin(ctx, key, kid); err != nil {
+			return "", err
 		}
 	}
 
 	return kid, nil
 }
 
+// checkUserStatus returns an error if kid has a user with a failed status.
+func (s *service) checkUserStatus(ctx context.Context, kid keys.ID) error {
+	res, err := s.users.Get(ctx, kid)
+	if err != nil {
+		return err
+	}
+	if res != nil && res.Status != user.StatusOK {
+		return errors.Errorf("user %s has failed status %s", res.User.ID(), res.Status)
+	}
+	return nil
+}
+
+// lookupAll looks up keys, expanding contact names to all the contact kids and
+// users (like groups).
 func (s *service) lookupAll(ctx context.Context, ks []string, opts *lookupOpts) ([]keys.ID, error) {
 	ids := make([]keys.ID, 0, len(ks))
+	cv := contacts.New(s.vault)
 	for _, key := range ks {
-		id, err := s.lookup(ctx, key, opts)
+		_, recipients, err := expandContact(cv, key)
 		if err != nil {
 			return nil, err
 		}
-		ids = append(ids, id)
+		if recipients == nil {
+			recipients = []string{key}
+		}
+		for _, recipient := range recipients {
+			id, err := s.lookup(ctx, recipient, opts)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
 	}
 	return ids, nil
 }
 
-func (s *service) lookupKID(ctx context.Context, key string, searchRemote bool) (keys.ID, error) {
+// lookupKID returns the key for a kid, user@service or contact, and the key
+// it resolved to before following rotations (for a user).
+func (s *service) lookupKID(ctx context.Context, key string, searchRemote bool) (keys.ID, keys.ID, error) {
 	if strings.Contains(key, "@") {
 		return s.lookupUser(ctx, key, searchRemote)
 	}
 
 	kid, err := keys.ParseID(key)
 	if err != nil {
-		return "", errors.Errorf("failed to parse %s", key)
+		contact, cerr := contacts.New(s.vault).Get(key)
+		if cerr != nil {
+			return "", "", cerr
+		}
+		if contact == nil {
+			return "", "", errors.Errorf("failed to parse %s", key)
+		}
+		return s.lookupContactKID(ctx, contact, searchRemote)
 	}
 
 	rkid, err := s.scs.Lookup(kid)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	if rkid != "" {
 		kid = rkid
@@ -83,12 +126,32 @@ func (s *service) lookupKID(ctx context.Context, key string, searchRemote bool)
 	if searchRemote {
 		res, err := s.client.User(ctx, kid)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		if res != nil {
 			// TODO: We should verify ourselves that the server isn't lying
-			return res.User.KID, nil
+			return res.User.KID, res.User.KID, nil
 		}
 	}
-	return kid, nil
+	return kid, kid, nil
+}
+
+// lookupContactKID returns the key for a contact, for where we need a single
+// key. Fails if the contact kids and users resolve to more than one key.
+func (s *service) lookupContactKID(ctx context.Context, contact *contacts.Contact, searchRemote bool) (keys.ID, keys.ID, error) {
+	var kid, userKID keys.ID
+	for _, recipient := range contact.Recipients() {
+		k, uk, err := s.lookupKID(ctx, recipient, searchRemote)
+		if err != nil {
+			return "", "", err
+		}
+		if kid == "" {
+			kid, userKID = k, uk
+			continue
+		}
+		if k != kid {
+			return "", "", errors.Errorf("contact %s has more than one key, specify a kid or user", contact.Name)
+		}
+	}
+	return kid, userKID, nil
 }
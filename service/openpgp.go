@@ -0,0 +1,175 @@
+package service
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// openpgpEntity returns an OpenPGP (EdDSA) entity for an EdX25519 key.
+// The OpenPGP key uses the same Ed25519 key, and the (vault) key creation time
+// so the OpenPGP fingerprint is stable.
+func (s *service) openpgpEntity(sk *keys.EdX25519Key) (*openpgp.Entity, error) {
+	kr := keyring.New(s.vault)
+	key, err := kr.Get(sk.ID())
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(sk.ID().String())
+	}
+	createdAt := tsutil.ParseMillis(key.CreatedAt).Truncate(time.Second)
+	return newOpenPGPEntity(sk, createdAt)
+}
+
+func newOpenPGPEntity(sk *keys.EdX25519Key, createdAt time.Time) (*openpgp.Entity, error) {
+	priv := packet.NewSignerPrivateKey(createdAt, ed25519.NewKeyFromSeed(sk.Seed()[:]))
+	uid := packet.NewUserId(sk.ID().String(), "", "")
+	if uid == nil {
+		return nil, errors.Errorf("invalid openpgp user id")
+	}
+
+	isPrimaryID := true
+	sig := &packet.Signature{
+		Version:           priv.PublicKey.Version,
+		SigType:           packet.SigTypePositiveCert,
+		PubKeyAlgo:        priv.PublicKey.PubKeyAlgo,
+		Hash:              crypto.SHA256,
+		CreationTime:      createdAt,
+		IssuerKeyId:       &priv.PublicKey.KeyId,
+		IssuerFingerprint: priv.PublicKey.Fingerprint,
+		IsPrimaryId:       &isPrimaryID,
+		FlagsValid:        true,
+		FlagSign:          true,
+		FlagCertify:       true,
+	}
+	config := openpgpConfig(createdAt)
+	if err := sig.SignUserId(uid.Id, &priv.PublicKey, priv, config); err != nil {
+		return nil, err
+	}
+
+	return &openpgp.Entity{
+		PrimaryKey: &priv.PublicKey,
+		PrivateKey: priv,
+		Identities: map[string]*openpgp.Identity{
+			uid.Id: {
+				Name:          uid.Id,
+				UserId:        uid,
+				SelfSignature: sig,
+				Signatures:    []*packet.Signature{sig},
+			},
+		},
+	}, nil
+}
+
+func openpgpConfig(now time.Time) *packet.Config {
+	return &packet.Config{
+		DefaultHash: crypto.SHA256,
+		Time:        func() time.Time { return now },
+	}
+}
+
+// encodeOpenPGPPublicKey returns the armored OpenPGP public key.
+func encodeOpenPGPPublicKey(entity *openpgp.Entity) (string, error) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := entity.Serialize(w); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// newOpenPGPSignStream returns a stream for an OpenPGP signature.
+// If detached, the output is a (binary or armored) detached signature,
+// otherwise the output is a cleartext signed message (always armored).
+func newOpenPGPSignStream(w io.Writer, armored bool, detached bool, entity *openpgp.Entity, now time.Time) (io.WriteCloser, error) {
+	config := openpgpConfig(now)
+	if !detached {
+		return clearsign.Encode(w, entity.PrivateKey, config)
+	}
+
+	return &openpgpDetachedStream{
+		w:       w,
+		armored: armored,
+		priv:    entity.PrivateKey,
+		config:  config,
+		h:       config.Hash().New(),
+	}, nil
+}
+
+// openpgpDetachedStream hashes input and writes the (detached) signature to w
+// on Close.
+type openpgpDetachedStream struct {
+	w       io.Writer
+	armored bool
+	priv    *packet.PrivateKey
+	config  *packet.Config
+	h       hash.Hash
+}
+
+func (s *openpgpDetachedStream) Write(b []byte) (int, error) {
+	return s.h.Write(b)
+}
+
+func (s *openpgpDetachedStream) Close() error {
+	sig := &packet.Signature{
+		Version:      s.priv.PublicKey.Version,
+		SigType:      packet.SigTypeBinary,
+		PubKeyAlgo:   s.priv.PubKeyAlgo,
+		Hash:         s.config.Hash(),
+		CreationTime: s.config.Now(),
+		IssuerKeyId:  &s.priv.KeyId,
+	}
+	if err := sig.Sign(s.h, s.priv, s.config); err != nil {
+		return err
+	}
+	if !s.armored {
+		return sig.Serialize(s.w)
+	}
+	aw, err := armor.Encode(s.w, openpgp.SignatureType, nil)
+	if err != nil {
+		return err
+	}
+	if err := sig.Serialize(aw); err != nil {
+		return err
+	}
+	return aw.Close()
+}
+
+// openpgpSign signs data with OpenPGP.
+func (s *service) openpgpSign(b []byte, armored bool, detached bool, key *keys.EdX25519Key) ([]byte, error) {
+	entity, err := s.openpgpEntity(key)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	stream, err := newOpenPGPSignStream(&buf, armored, detached, entity, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stream.Write(b); err != nil {
+		return nil, err
+	}
+	if err := stream.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
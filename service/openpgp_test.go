@@ -0,0 +1,83 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignOpenPGP(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	exportResp, err := service.KeyExport(ctx, &KeyExportRequest{
+		KID:  alice.ID().String(),
+		Type: OpenPGPExport,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(exportResp.Export), "-----BEGIN PGP PUBLIC KEY BLOCK-----"))
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(exportResp.Export))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keyring))
+
+	// Export is stable
+	exportResp2, err := service.KeyExport(ctx, &KeyExportRequest{
+		KID:  alice.ID().String(),
+		Type: OpenPGPExport,
+	})
+	require.NoError(t, err)
+	require.Equal(t, exportResp.Export, exportResp2.Export)
+
+	message := []byte("I'm alice")
+
+	// Detached (armored)
+	signResp, err := service.Sign(ctx, &SignRequest{
+		Data:     message,
+		Signer:   alice.ID().String(),
+		Armored:  true,
+		Detached: true,
+		Format:   OpenPGPSignature,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signResp.Data), "-----BEGIN PGP SIGNATURE-----"))
+	signer, err := openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(message), bytes.NewReader(signResp.Data), nil)
+	require.NoError(t, err)
+	require.Equal(t, keyring[0].PrimaryKey.KeyId, signer.PrimaryKey.KeyId)
+
+	_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader([]byte("I'm not alice")), bytes.NewReader(signResp.Data), nil)
+	require.Error(t, err)
+
+	// Detached (binary)
+	signResp, err = service.Sign(ctx, &SignRequest{
+		Data:     message,
+		Signer:   alice.ID().String(),
+		Detached: true,
+		Format:   OpenPGPSignature,
+	})
+	require.NoError(t, err)
+	_, err = openpgp.CheckDetachedSignature(keyring, bytes.NewReader(message), bytes.NewReader(signResp.Data), nil)
+	require.NoError(t, err)
+
+	// Cleartext
+	signResp, err = service.Sign(ctx, &SignRequest{
+		Data:   message,
+		Signer: alice.ID().String(),
+		Format: OpenPGPSignature,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signResp.Data), "-----BEGIN PGP SIGNED MESSAGE-----"))
+	block, _ := clearsign.Decode(signResp.Data)
+	require.NotNil(t, block)
+	require.Equal(t, append(message, '\n'), block.Plaintext)
+	_, err = block.VerifySignature(keyring, nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,283 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/dstore"
+	"github.com/pkg/errors"
+)
+
+// Statements created with Local are queued (pending) until published with
+// SigchainPublish.
+
+type pendingStatement struct {
+	KID keys.ID `msgpack:"kid"`
+	Seq int     `msgpack:"seq"`
+}
+
+func pendingPath(kid keys.ID, seq int) string {
+	return dstore.Path("pending", keys.StatementID(kid, seq))
+}
+
+func (s *service) addPending(ctx context.Context, st *keys.Statement) error {
+	logger.Infof("Statement pending %s", keys.StatementID(st.KID, st.Seq))
+	return s.db.Set(ctx, pendingPath(st.KID, st.Seq), dstore.From(&pendingStatement{KID: st.KID, Seq: st.Seq}))
+}
+
+// pending returns pending statements, ordered by kid and seq.
+func (s *service) pending(ctx context.Context) ([]*pendingStatement, error) {
+	docs, err := s.db.Documents(ctx, "pending")
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*pendingStatement, 0, len(docs))
+	for _, doc := range docs {
+		var p pendingStatement
+		if err := doc.To(&p); err != nil {
+			return nil, err
+		}
+		out = append(out, &p)
+	}
+	return out, nil
+}
+
+// pendingSeqs returns pending statement seqs for a key.
+func (s *service) pendingSeqs(ctx context.Context, kid keys.ID) (map[int]bool, error) {
+	pending, err := s.pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seqs := map[int]bool{}
+	for _, p := range pending {
+		if p.KID == kid {
+			seqs[p.Seq] = true
+		}
+	}
+	return seqs, nil
+}
+
+func (s *service) clearPending(ctx context.Context, kid keys.ID) error {
+	seqs, err := s.pendingSeqs(ctx, kid)
+	if err != nil {
+		return err
+	}
+	for seq := range seqs {
+		if _, err := s.db.Delete(ctx, pendingPath(kid, seq)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statementsToRPCWithStatus converts statements, setting pending status.
+func (s *service) statementsToRPCWithStatus(ctx context.Context, kid keys.ID, sts []*keys.Statement) ([]*Statement, error) {
+	seqs, err := s.pendingSeqs(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	out := statementsToRPC(sts)
+	for _, st := range out {
+		if seqs[int(st.Seq)] {
+			st.Status = StatementPending
+		}
+	}
+	return out, nil
+}
+
+// SigchainPublish (RPC) saves pending statements to the key server.
+func (s *service) SigchainPublish(ctx context.Context, req *SigchainPublishRequest) (*SigchainPublishResponse, error) {
+	var kids []keys.ID
+	if req.KID != "" {
+		kid, err := s.parseKID(req.KID)
+		if err != nil {
+			return nil, err
+		}
+		kids = []keys.ID{kid}
+	} else {
+		pending, err := s.pending(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set := keys.NewIDSet()
+		for _, p := range pending {
+			set.Add(p.KID)
+		}
+		kids = set.IDs()
+	}
+
+	out := []*Statement{}
+	for _, kid := range kids {
+		sts, err := s.publish(ctx, kid, req.Rebase)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, statementsToRPC(sts)...)
+	}
+	return &SigchainPublishResponse{
+		Statements: out,
+	}, nil
+}
+
+// publish saves local statements, missing from the key server, in order.
+// If the key server sigchain diverged from the local sigchain, and rebase is
+// true, pending statements are re-signed after the key server statements.
+func (s *service) publish(ctx context.Context, kid keys.ID, rebase bool) ([]*keys.Statement, error) {
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return nil, err
+	}
+	if sc == nil {
+		sc = keys.NewSigchain(kid)
+	}
+	pending, err := s.pendingSeqs(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Sigchain(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	remote := []*keys.Statement{}
+	if resp != nil {
+		remote = resp.Statements
+	}
+
+	local := sc.Statements()
+	diverged, err := sigchainDivergence(local, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	published := []*keys.Statement{}
+	if diverged == 0 {
+		for _, st := range local {
+			if st.Seq <= len(remote) {
+				continue
+			}
+			if err := s.client.SigchainSave(ctx, st); err != nil {
+				return nil, err
+			}
+			published = append(published, st)
+		}
+		if len(remote) > len(local) {
+			// Key server is ahead, update our sigchain.
+			rsc := keys.NewSigchain(kid)
+			if err := rsc.AddAll(remote); err != nil {
+				return nil, err
+			}
+			if err := s.scs.Save(rsc); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if !rebase {
+			return nil, errors.Errorf("sigchain diverged from key server at seq %d (publish with rebase to re-sign pending statements)", diverged)
+		}
+		rebased, err := s.rebase(ctx, kid, local, remote, diverged, pending)
+		if err != nil {
+			return nil, err
+		}
+		published = rebased
+	}
+
+	if err := s.clearPending(ctx, kid); err != nil {
+		return nil, err
+	}
+	if _, err := s.users.Update(ctx, kid); err != nil {
+		return nil, err
+	}
+	return published, nil
+}
+
+// rebase re-signs local (pending) statements, after diverged, on the remote
+// sigchain.
+func (s *service) rebase(ctx context.Context, kid keys.ID, local []*keys.Statement, remote []*keys.Statement, diverged int, pending map[int]bool) ([]*keys.Statement, error) {
+	key, err := s.edx25519Key(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+	for _, st := range local[diverged-1:] {
+		if !pending[st.Seq] {
+			return nil, errors.Errorf("sigchain diverged from key server at seq %d, but local statement %d isn't pending", diverged, st.Seq)
+		}
+	}
+
+	sc := keys.NewSigchain(kid)
+	if err := sc.AddAll(remote); err != nil {
+		return nil, err
+	}
+	rebased := []*keys.Statement{}
+	for _, st := range local[diverged-1:] {
+		var next *keys.Statement
+		if st.Revoke != 0 {
+			if st.Revoke >= diverged {
+				return nil, errors.Errorf("can't rebase revoke of diverged statement %d", st.Revoke)
+			}
+			next, err = sc.Revoke(st.Revoke, key)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			if st.Type == "user" {
+				// User statements include the seq, so we can't re-sign them.
+				return nil, errors.Errorf("can't rebase user statement %d, revoke and link the user again", st.Seq)
+			}
+			next, err = keys.NewSigchainStatement(sc, st.Data, key, st.Type, s.clock.Now())
+			if err != nil {
+				return nil, err
+			}
+			if err := sc.Add(next); err != nil {
+				return nil, err
+			}
+		}
+		logger.Infof("Rebased statement %d to %d", st.Seq, next.Seq)
+		rebased = append(rebased, next)
+	}
+
+	// Save the rebased (pending) statements before publishing, so our sigchain
+	// stays consistent with the key server if publishing fails part way.
+	if err := s.scs.Save(sc); err != nil {
+		return nil, err
+	}
+	if err := s.clearPending(ctx, kid); err != nil {
+		return nil, err
+	}
+	for _, st := range rebased {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+	for _, st := range rebased {
+		if err := s.client.SigchainSave(ctx, st); err != nil {
+			return nil, err
+		}
+		if _, err := s.db.Delete(ctx, pendingPath(kid, st.Seq)); err != nil {
+			return nil, err
+		}
+	}
+	return rebased, nil
+}
+
+// sigchainDivergence returns the first seq where local and remote statements
+// differ, or 0 if they don't.
+func sigchainDivergence(local []*keys.Statement, remote []*keys.Statement) (int, error) {
+	for i := 0; i < len(local) && i < len(remote); i++ {
+		lb, err := local[i].Bytes()
+		if err != nil {
+			return 0, err
+		}
+		rb, err := remote[i].Bytes()
+		if err != nil {
+			return 0, err
+		}
+		if !bytes.Equal(lb, rb) {
+			return i + 1, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigchainPublish(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	ctx := context.TODO()
+
+	createResp, err := service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:   alice.ID().String(),
+		Data:  []byte("test1"),
+		Local: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatementPending, createResp.Statement.Status)
+
+	createResp, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:   alice.ID().String(),
+		Data:  []byte("test2"),
+		Local: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), createResp.Statement.Seq)
+
+	resp, err := service.Sigchain(ctx, &SigchainRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Statements))
+	require.Equal(t, StatementPending, resp.Statements[0].Status)
+	require.Equal(t, StatementPending, resp.Statements[1].Status)
+
+	remote, err := service.client.Sigchain(ctx, alice.ID())
+	require.NoError(t, err)
+	require.Nil(t, remote)
+
+	publishResp, err := service.SigchainPublish(ctx, &SigchainPublishRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(publishResp.Statements))
+	require.Equal(t, []byte("test1"), publishResp.Statements[0].Data)
+	require.Equal(t, []byte("test2"), publishResp.Statements[1].Data)
+
+	resp, err = service.Sigchain(ctx, &SigchainRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Statements))
+	require.Equal(t, StatementPublished, resp.Statements[0].Status)
+	require.Equal(t, StatementPublished, resp.Statements[1].Status)
+
+	remote, err = service.client.Sigchain(ctx, alice.ID())
+	require.NoError(t, err)
+	require.NotNil(t, remote)
+	require.Equal(t, 2, len(remote.Statements))
+
+	// Nothing pending
+	publishResp, err = service.SigchainPublish(ctx, &SigchainPublishRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(publishResp.Statements))
+}
+
+func TestSigchainPublishRebase(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	service2, closeFn2 := newTestService(t, env)
+	defer closeFn2()
+	testAuthSetup(t, service2)
+	testImportKey(t, service2, alice)
+	ctx := context.TODO()
+
+	_, err := service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Data: []byte("test1"),
+	})
+	require.NoError(t, err)
+	// Publish with nothing pending updates from the key server.
+	publishResp, err := service2.SigchainPublish(ctx, &SigchainPublishRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(publishResp.Statements))
+
+	// Pending statement on service, while service2 publishes.
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:   alice.ID().String(),
+		Data:  []byte("local"),
+		Local: true,
+	})
+	require.NoError(t, err)
+	_, err = service2.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Data: []byte("remote"),
+	})
+	require.NoError(t, err)
+
+	_, err = service.SigchainPublish(ctx, &SigchainPublishRequest{KID: alice.ID().String()})
+	require.EqualError(t, err, "sigchain diverged from key server at seq 2 (publish with rebase to re-sign pending statements)")
+
+	publishResp, err = service.SigchainPublish(ctx, &SigchainPublishRequest{
+		KID:    alice.ID().String(),
+		Rebase: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(publishResp.Statements))
+	require.Equal(t, int32(3), publishResp.Statements[0].Seq)
+	require.Equal(t, []byte("local"), publishResp.Statements[0].Data)
+
+	resp, err := service.Sigchain(ctx, &SigchainRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp.Statements))
+	require.Equal(t, []byte("remote"), resp.Statements[1].Data)
+	require.Equal(t, []byte("local"), resp.Statements[2].Data)
+	require.Equal(t, StatementPublished, resp.Statements[2].Status)
+
+	remote, err := service.client.Sigchain(ctx, alice.ID())
+	require.NoError(t, err)
+	require.Equal(t, 3, len(remote.Statements))
+}
+
+func TestSigchainPublishUser(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	ctx := context.TODO()
+
+	signResp, err := service.UserSign(ctx, &UserSignRequest{
+		KID:     alice.ID().String(),
+		Service: "github",
+		Name:    "alice",
+	})
+	require.NoError(t, err)
+	env.client.SetProxy("https://api.github.com/gists/1", func(ctx context.Context, req *http.Request) http.ProxyResponse {
+		return http.ProxyResponse{Body: []byte(githubMock("alice", "1", signResp.Message))}
+	})
+
+	addResp, err := service.UserAdd(ctx, &UserAddRequest{
+		KID:     alice.ID().String(),
+		Service: "github",
+		Name:    "alice",
+		URL:     "https://gist.github.com/alice/1",
+		Local:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatementPending, addResp.Statement.Status)
+
+	resp, err := service.Sigchain(ctx, &SigchainRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Statements))
+	require.Equal(t, StatementPending, resp.Statements[0].Status)
+
+	remote, err := service.client.Sigchain(ctx, alice.ID())
+	require.NoError(t, err)
+	require.Nil(t, remote)
+
+	publishResp, err := service.SigchainPublish(ctx, &SigchainPublishRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(publishResp.Statements))
+	require.Equal(t, "user", publishResp.Statements[0].Type)
+
+	remote, err = service.client.Sigchain(ctx, alice.ID())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(remote.Statements))
+}
@@ -22,7 +22,13 @@ func (s *service) Pull(ctx context.Context, req *PullRequest) (*PullResponse, er
 			return nil, err
 		}
 		if res == nil {
-			return nil, keys.NewErrNotFound(kid.String())
+			ok, err := s.hasForgeUser(kid)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, keys.NewErrNotFound(kid.String())
+			}
 		}
 		return &PullResponse{KIDs: []string{kid.String()}}, nil
 	}
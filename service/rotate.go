@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/pkg/errors"
+)
+
+// rotateStatementType is the sigchain statement type for a key rotation.
+const rotateStatementType = "rotate"
+
+// maxRotations is the maximum number of rotations we follow.
+const maxRotations = 20
+
+// keyRotation is the statement data for a key rotation, posted by the old key
+// to its sigchain. Sig is a signature by the successor key, so the successor
+// agrees to the rotation.
+type keyRotation struct {
+	KID       keys.ID `json:"kid"`
+	Successor keys.ID `json:"successor"`
+	Sig       []byte  `json:"sig"`
+}
+
+func keyRotationMessage(kid keys.ID, successor keys.ID) []byte {
+	return []byte("keys.pub/rotate/v1\n" + kid.String() + "\n" + successor.String())
+}
+
+func newKeyRotation(key *keys.EdX25519Key, successor *keys.EdX25519Key) *keyRotation {
+	return &keyRotation{
+		KID:       key.ID(),
+		Successor: successor.ID(),
+		Sig:       successor.SignDetached(keyRotationMessage(key.ID(), successor.ID())),
+	}
+}
+
+func (r *keyRotation) verify(kid keys.ID) error {
+	if r.KID != kid {
+		return errors.Errorf("key rotation kid mismatch %s != %s", r.KID, kid)
+	}
+	if r.Successor == kid {
+		return errors.Errorf("key rotation to same key")
+	}
+	spk, err := keys.NewEdX25519PublicKeyFromID(r.Successor)
+	if err != nil {
+		return errors.Wrapf(err, "invalid key rotation successor")
+	}
+	if err := spk.VerifyDetached(r.Sig, keyRotationMessage(r.KID, r.Successor)); err != nil {
+		return errors.Errorf("invalid key rotation signature")
+	}
+	return nil
+}
+
+func (r *keyRotation) describe() string {
+	return fmt.Sprintf("rotated to %s", r.Successor)
+}
+
+// KeyRotate (RPC) rotates an EdX25519 key to a successor key.
+func (s *service) KeyRotate(ctx context.Context, req *KeyRotateRequest) (*KeyRotateResponse, error) {
+	kid, err := s.parseKID(req.KID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.edx25519Key(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+
+	rotated, err := s.rotatedTo(kid)
+	if err != nil {
+		return nil, err
+	}
+	if rotated != "" {
+		return nil, errors.Errorf("key was already rotated to %s", rotated)
+	}
+
+	// A generated successor is saved after the rotation is added (and
+	// published), so we don't keep an orphan key if the rotation fails.
+	var successor *keys.EdX25519Key
+	generated := req.Successor == ""
+	if generated {
+		successor = keys.GenerateEdX25519Key()
+	} else {
+		sid, err := keys.ParseID(req.Successor)
+		if err != nil {
+			return nil, err
+		}
+		successor, err = s.edx25519Key(sid)
+		if err != nil {
+			return nil, err
+		}
+		if successor == nil {
+			return nil, keys.NewErrNotFound(sid.String())
+		}
+	}
+	if successor.ID() == key.ID() {
+		return nil, errors.Errorf("can't rotate to the same key")
+	}
+
+	b, err := encodeStatementData(key.ID(), newKeyRotation(key, successor))
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := s.scs.Sigchain(key.ID())
+	if err != nil {
+		return nil, err
+	}
+	st, err := keys.NewSigchainStatement(sc, b, key, rotateStatementType, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := sc.Add(st); err != nil {
+		return nil, err
+	}
+
+	if !req.Local {
+		if err := s.client.SigchainSave(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+
+	if generated {
+		if err := s.saveKey(api.NewKey(successor)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.scs.Save(sc); err != nil {
+		return nil, err
+	}
+	stOut := statementToRPC(st)
+	if req.Local {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, err
+		}
+		stOut.Status = StatementPending
+	}
+
+	if _, err = s.users.Update(ctx, key.ID()); err != nil {
+		return nil, err
+	}
+
+	out, err := s.key(ctx, successor.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyRotateResponse{
+		Statement: stOut,
+		Successor: out,
+	}, nil
+}
+
+// rotatedTo returns the successor key from a (non-revoked) key rotation in
+// the sigchain, or empty if the key wasn't rotated.
+func (s *service) rotatedTo(kid keys.ID) (keys.ID, error) {
+	if !kid.IsEdX25519() {
+		return "", nil
+	}
+	sc, err := s.scs.Sigchain(kid)
+	if err != nil {
+		return "", err
+	}
+	if sc == nil {
+		return "", nil
+	}
+	st := sc.FindLast(rotateStatementType)
+	if st == nil {
+		return "", nil
+	}
+	var rotation keyRotation
+	if err := json.Unmarshal(st.Data, &rotation); err != nil {
+		return "", errors.Wrapf(err, "invalid key rotation")
+	}
+	if err := rotation.verify(kid); err != nil {
+		return "", err
+	}
+	return rotation.Successor, nil
+}
+
+// followRotations returns the current key, following key rotations.
+func (s *service) followRotations(kid keys.ID) (keys.ID, error) {
+	visited := map[keys.ID]bool{kid: true}
+	for i := 0; i < maxRotations; i++ {
+		next, err := s.rotatedTo(kid)
+		if err != nil {
+			return "", err
+		}
+		if next == "" {
+			return kid, nil
+		}
+		if visited[next] {
+			return "", errors.Errorf("key rotation cycle at %s", next)
+		}
+		visited[next] = true
+		logger.Debugf("Key %s was rotated to %s", kid, next)
+		kid = next
+	}
+	return "", errors.Errorf("too many key rotations")
+}
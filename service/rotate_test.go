@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyRotate(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	tua := testUserSetupGithub(t, env, aliceService, alice, "alice")
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+
+	// Rotate (generates successor)
+	rotateResp, err := aliceService.KeyRotate(ctx, &KeyRotateRequest{KID: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, rotateStatementType, rotateResp.Statement.Type)
+	require.True(t, rotateResp.Successor.IsPrivate)
+	successor := keys.ID(rotateResp.Successor.ID)
+
+	keyResp, err := aliceService.Key(ctx, &KeyRequest{Key: alice.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, successor.String(), keyResp.Key.RotatedTo)
+
+	kid, err := aliceService.lookup(ctx, "alice@github", nil)
+	require.NoError(t, err)
+	require.Equal(t, successor, kid)
+
+	_, err = aliceService.KeyRotate(ctx, &KeyRotateRequest{KID: alice.ID().String()})
+	require.EqualError(t, err, "key was already rotated to "+successor.String())
+
+	// Bob follows the rotation
+	testPull(t, bobService, alice.ID())
+	kid, err = bobService.lookup(ctx, "alice@github", nil)
+	require.NoError(t, err)
+	require.Equal(t, successor, kid)
+
+	encryptResp, err := bobService.Encrypt(ctx, &EncryptRequest{
+		Data:       []byte("hi alice"),
+		Recipients: []string{"alice@github"},
+		Sender:     bob.ID().String(),
+	})
+	require.NoError(t, err)
+	decryptResp, err := aliceService.Decrypt(ctx, &DecryptRequest{Data: encryptResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi alice"), decryptResp.Data)
+
+	// Rotate successor (to existing key)
+	testImportKey(t, aliceService, charlie)
+	_, err = aliceService.KeyRotate(ctx, &KeyRotateRequest{KID: successor.String(), Successor: charlie.ID().String()})
+	require.NoError(t, err)
+	kid, err = aliceService.lookup(ctx, "alice@github", nil)
+	require.NoError(t, err)
+	require.Equal(t, charlie.ID(), kid)
+
+	// Successor must be our key
+	_, err = bobService.KeyRotate(ctx, &KeyRotateRequest{KID: bob.ID().String(), Successor: charlie.ID().String()})
+	require.EqualError(t, err, charlie.ID().String()+" not found")
+
+	// User status is checked for the user key (not the successor)
+	env.client.SetProxy(tua.URL, func(ctx context.Context, req *http.Request) http.ProxyResponse {
+		return http.ProxyResponse{Err: http.Err{Code: 500}}
+	})
+	testPull(t, bobService, alice.ID())
+	_, err = bobService.lookup(ctx, "alice@github", &lookupOpts{VerifyUser: true})
+	require.EqualError(t, err, "user alice@github has failed status connection-fail")
+}
+
+func TestKeyRotationVerify(t *testing.T) {
+	rotation := newKeyRotation(alice, bob)
+	require.NoError(t, rotation.verify(alice.ID()))
+	require.EqualError(t, rotation.verify(bob.ID()), "key rotation kid mismatch "+alice.ID().String()+" != "+bob.ID().String())
+
+	// Not signed by successor
+	rotation.Successor = charlie.ID()
+	require.EqualError(t, rotation.verify(alice.ID()), "invalid key rotation signature")
+}
+
+func TestKeyRotateFailed(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+
+	// Alice (on another device) publishes a statement, so rotate fails to
+	// publish (sigchain out of date).
+	otherService, otherCloseFn := newTestService(t, env)
+	defer otherCloseFn()
+	testAuthSetup(t, otherService)
+	testImportKey(t, otherService, alice)
+	testUserSetupGithub(t, env, otherService, alice, "alice")
+
+	keysResp, err := aliceService.Keys(ctx, &KeysRequest{})
+	require.NoError(t, err)
+	count := len(keysResp.Keys)
+
+	_, err = aliceService.KeyRotate(ctx, &KeyRotateRequest{KID: alice.ID().String()})
+	require.Error(t, err)
+
+	// No (orphan) successor key
+	keysResp, err = aliceService.Keys(ctx, &KeysRequest{})
+	require.NoError(t, err)
+	require.Equal(t, count, len(keysResp.Keys))
+}
@@ -29,6 +29,107 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type SignatureFormat int32
+
+const (
+	SaltpackSignature SignatureFormat = 0
+	// OPENPGP_SIGNATURE_FORMAT is an RFC 4880 (EdDSA) signature, detached or
+	// cleartext (if not detached, always armored).
+	OpenPGPSignature SignatureFormat = 1
+	// SSHSIG_SIGNATURE_FORMAT is an OpenSSH (armored) SSHSIG signature, always
+	// detached (as used by ssh-keygen -Y sign and git gpg.format=ssh).
+	SSHSignature SignatureFormat = 2
+)
+
+// Enum value maps for SignatureFormat.
+var (
+	SignatureFormat_name = map[int32]string{
+		0: "SALTPACK_SIGNATURE_FORMAT",
+		1: "OPENPGP_SIGNATURE_FORMAT",
+		2: "SSHSIG_SIGNATURE_FORMAT",
+	}
+	SignatureFormat_value = map[string]int32{
+		"SALTPACK_SIGNATURE_FORMAT": 0,
+		"OPENPGP_SIGNATURE_FORMAT":  1,
+		"SSHSIG_SIGNATURE_FORMAT":   2,
+	}
+)
+
+func (x SignatureFormat) Enum() *SignatureFormat {
+	p := new(SignatureFormat)
+	*p = x
+	return p
+}
+
+func (x SignatureFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignatureFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (SignatureFormat) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[0]
+}
+
+func (x SignatureFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignatureFormat.Descriptor instead.
+func (SignatureFormat) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+type StatementStatus int32
+
+const (
+	// STATEMENT_PUBLISHED if saved to the key server.
+	StatementPublished StatementStatus = 0
+	// STATEMENT_PENDING if local only, see SigchainPublish.
+	StatementPending StatementStatus = 1
+)
+
+// Enum value maps for StatementStatus.
+var (
+	StatementStatus_name = map[int32]string{
+		0: "STATEMENT_PUBLISHED",
+		1: "STATEMENT_PENDING",
+	}
+	StatementStatus_value = map[string]int32{
+		"STATEMENT_PUBLISHED": 0,
+		"STATEMENT_PENDING":   1,
+	}
+)
+
+func (x StatementStatus) Enum() *StatementStatus {
+	p := new(StatementStatus)
+	*p = x
+	return p
+}
+
+func (x StatementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (StatementStatus) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[1]
+}
+
+func (x StatementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementStatus.Descriptor instead.
+func (StatementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{1}
+}
+
 type EncryptMode int32
 
 const (
@@ -62,11 +163,11 @@ func (x EncryptMode) String() string {
 }
 
 func (EncryptMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[0].Descriptor()
+	return file_rpc_proto_enumTypes[2].Descriptor()
 }
 
 func (EncryptMode) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[0]
+	return &file_rpc_proto_enumTypes[2]
 }
 
 func (x EncryptMode) Number() protoreflect.EnumNumber {
@@ -75,7 +176,7 @@ func (x EncryptMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EncryptMode.Descriptor instead.
 func (EncryptMode) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{0}
+	return file_rpc_proto_rawDescGZIP(), []int{2}
 }
 
 type AuthStatus int32
@@ -117,11 +218,11 @@ func (x AuthStatus) String() string {
 }
 
 func (AuthStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[1].Descriptor()
+	return file_rpc_proto_enumTypes[3].Descriptor()
 }
 
 func (AuthStatus) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[1]
+	return &file_rpc_proto_enumTypes[3]
 }
 
 func (x AuthStatus) Number() protoreflect.EnumNumber {
@@ -130,7 +231,7 @@ func (x AuthStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthStatus.Descriptor instead.
 func (AuthStatus) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{1}
+	return file_rpc_proto_rawDescGZIP(), []int{3}
 }
 
 type AuthType int32
@@ -172,11 +273,11 @@ func (x AuthType) String() string {
 }
 
 func (AuthType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[2].Descriptor()
+	return file_rpc_proto_enumTypes[4].Descriptor()
 }
 
 func (AuthType) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[2]
+	return &file_rpc_proto_enumTypes[4]
 }
 
 func (x AuthType) Number() protoreflect.EnumNumber {
@@ -185,7 +286,7 @@ func (x AuthType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthType.Descriptor instead.
 func (AuthType) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{2}
+	return file_rpc_proto_rawDescGZIP(), []int{4}
 }
 
 type ExportType int32
@@ -193,6 +294,16 @@ type ExportType int32
 const (
 	DefaultExport ExportType = 0
 	SSHExport     ExportType = 1
+	// PAPER_EXPORT_TYPE is a printable BIP39 phrase (of the seed) and checksum.
+	PaperExport ExportType = 2
+	// JWK_EXPORT_TYPE is JSON Web Key (OKP).
+	JWKExport ExportType = 3
+	// PEM_EXPORT_TYPE is PEM, PKCS#8 (private) or PKIX (public).
+	PEMExport ExportType = 4
+	// AGE_EXPORT_TYPE is an age identity (private) or recipient (public).
+	AgeExport ExportType = 5
+	// OPENPGP_EXPORT_TYPE is an (armored) OpenPGP public key.
+	OpenPGPExport ExportType = 6
 )
 
 // Enum value maps for ExportType.
@@ -200,10 +311,20 @@ var (
 	ExportType_name = map[int32]string{
 		0: "DEFAULT_EXPORT_TYPE",
 		1: "SSH_EXPORT_TYPE",
+		2: "PAPER_EXPORT_TYPE",
+		3: "JWK_EXPORT_TYPE",
+		4: "PEM_EXPORT_TYPE",
+		5: "AGE_EXPORT_TYPE",
+		6: "OPENPGP_EXPORT_TYPE",
 	}
 	ExportType_value = map[string]int32{
 		"DEFAULT_EXPORT_TYPE": 0,
 		"SSH_EXPORT_TYPE":     1,
+		"PAPER_EXPORT_TYPE":   2,
+		"JWK_EXPORT_TYPE":     3,
+		"PEM_EXPORT_TYPE":     4,
+		"AGE_EXPORT_TYPE":     5,
+		"OPENPGP_EXPORT_TYPE": 6,
 	}
 )
 
@@ -218,11 +339,11 @@ func (x ExportType) String() string {
 }
 
 func (ExportType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[3].Descriptor()
+	return file_rpc_proto_enumTypes[5].Descriptor()
 }
 
 func (ExportType) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[3]
+	return &file_rpc_proto_enumTypes[5]
 }
 
 func (x ExportType) Number() protoreflect.EnumNumber {
@@ -231,7 +352,59 @@ func (x ExportType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExportType.Descriptor instead.
 func (ExportType) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{3}
+	return file_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+type SSHAgentPolicy int32
+
+const (
+	// SSH_AGENT_ALLOW lets the ssh agent list and sign with the key.
+	SSHAgentAllow SSHAgentPolicy = 0
+	// SSH_AGENT_CONFIRM requires confirmation for each signature.
+	SSHAgentConfirm SSHAgentPolicy = 1
+	// SSH_AGENT_DENY hides the key from the ssh agent.
+	SSHAgentDeny SSHAgentPolicy = 2
+)
+
+// Enum value maps for SSHAgentPolicy.
+var (
+	SSHAgentPolicy_name = map[int32]string{
+		0: "SSH_AGENT_ALLOW",
+		1: "SSH_AGENT_CONFIRM",
+		2: "SSH_AGENT_DENY",
+	}
+	SSHAgentPolicy_value = map[string]int32{
+		"SSH_AGENT_ALLOW":   0,
+		"SSH_AGENT_CONFIRM": 1,
+		"SSH_AGENT_DENY":    2,
+	}
+)
+
+func (x SSHAgentPolicy) Enum() *SSHAgentPolicy {
+	p := new(SSHAgentPolicy)
+	*p = x
+	return p
+}
+
+func (x SSHAgentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SSHAgentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[6].Descriptor()
+}
+
+func (SSHAgentPolicy) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[6]
+}
+
+func (x SSHAgentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SSHAgentPolicy.Descriptor instead.
+func (SSHAgentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{6}
 }
 
 type SortDirection int32
@@ -264,11 +437,11 @@ func (x SortDirection) String() string {
 }
 
 func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[4].Descriptor()
+	return file_rpc_proto_enumTypes[7].Descriptor()
 }
 
 func (SortDirection) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[4]
+	return &file_rpc_proto_enumTypes[7]
 }
 
 func (x SortDirection) Number() protoreflect.EnumNumber {
@@ -277,7 +450,7 @@ func (x SortDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortDirection.Descriptor instead.
 func (SortDirection) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{4}
+	return file_rpc_proto_rawDescGZIP(), []int{7}
 }
 
 type SecretType int32
@@ -319,11 +492,11 @@ func (x SecretType) String() string {
 }
 
 func (SecretType) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[5].Descriptor()
+	return file_rpc_proto_enumTypes[8].Descriptor()
 }
 
 func (SecretType) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[5]
+	return &file_rpc_proto_enumTypes[8]
 }
 
 func (x SecretType) Number() protoreflect.EnumNumber {
@@ -332,7 +505,116 @@ func (x SecretType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretType.Descriptor instead.
 func (SecretType) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{5}
+	return file_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+// PinMethod is how a pinned key was verified.
+type PinMethod int32
+
+const (
+	PinNone PinMethod = 0
+	// PIN_TOFU (trust on first use) if pinned on first encrypt.
+	PinTOFU     PinMethod = 1
+	PinProof    PinMethod = 2
+	PinWormhole PinMethod = 3
+	PinInPerson PinMethod = 4
+)
+
+// Enum value maps for PinMethod.
+var (
+	PinMethod_name = map[int32]string{
+		0: "PIN_NONE",
+		1: "PIN_TOFU",
+		2: "PIN_PROOF",
+		3: "PIN_WORMHOLE",
+		4: "PIN_IN_PERSON",
+	}
+	PinMethod_value = map[string]int32{
+		"PIN_NONE":      0,
+		"PIN_TOFU":      1,
+		"PIN_PROOF":     2,
+		"PIN_WORMHOLE":  3,
+		"PIN_IN_PERSON": 4,
+	}
+)
+
+func (x PinMethod) Enum() *PinMethod {
+	p := new(PinMethod)
+	*p = x
+	return p
+}
+
+func (x PinMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PinMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[9].Descriptor()
+}
+
+func (PinMethod) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[9]
+}
+
+func (x PinMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PinMethod.Descriptor instead.
+func (PinMethod) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+type TrustLevel int32
+
+const (
+	TrustUnknown  TrustLevel = 0
+	TrustNever    TrustLevel = 1
+	TrustMarginal TrustLevel = 2
+	TrustFull     TrustLevel = 3
+)
+
+// Enum value maps for TrustLevel.
+var (
+	TrustLevel_name = map[int32]string{
+		0: "TRUST_UNKNOWN",
+		1: "TRUST_NEVER",
+		2: "TRUST_MARGINAL",
+		3: "TRUST_FULL",
+	}
+	TrustLevel_value = map[string]int32{
+		"TRUST_UNKNOWN":  0,
+		"TRUST_NEVER":    1,
+		"TRUST_MARGINAL": 2,
+		"TRUST_FULL":     3,
+	}
+)
+
+func (x TrustLevel) Enum() *TrustLevel {
+	p := new(TrustLevel)
+	*p = x
+	return p
+}
+
+func (x TrustLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrustLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[10].Descriptor()
+}
+
+func (TrustLevel) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[10]
+}
+
+func (x TrustLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrustLevel.Descriptor instead.
+func (TrustLevel) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 type Encoding int32
@@ -383,11 +665,11 @@ func (x Encoding) String() string {
 }
 
 func (Encoding) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[6].Descriptor()
+	return file_rpc_proto_enumTypes[11].Descriptor()
 }
 
 func (Encoding) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[6]
+	return &file_rpc_proto_enumTypes[11]
 }
 
 func (x Encoding) Number() protoreflect.EnumNumber {
@@ -396,7 +678,7 @@ func (x Encoding) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Encoding.Descriptor instead.
 func (Encoding) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{6}
+	return file_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 type UserStatus int32
@@ -444,11 +726,11 @@ func (x UserStatus) String() string {
 }
 
 func (UserStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[7].Descriptor()
+	return file_rpc_proto_enumTypes[12].Descriptor()
 }
 
 func (UserStatus) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[7]
+	return &file_rpc_proto_enumTypes[12]
 }
 
 func (x UserStatus) Number() protoreflect.EnumNumber {
@@ -457,56 +739,7 @@ func (x UserStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserStatus.Descriptor instead.
 func (UserStatus) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{7}
-}
-
-type MessageStatus int32
-
-const (
-	MessageSent    MessageStatus = 0
-	MessagePending MessageStatus = 1
-	MessageError   MessageStatus = 2
-)
-
-// Enum value maps for MessageStatus.
-var (
-	MessageStatus_name = map[int32]string{
-		0: "MESSAGE_SENT",
-		1: "MESSAGE_PENDING",
-		2: "MESSAGE_ERROR",
-	}
-	MessageStatus_value = map[string]int32{
-		"MESSAGE_SENT":    0,
-		"MESSAGE_PENDING": 1,
-		"MESSAGE_ERROR":   2,
-	}
-)
-
-func (x MessageStatus) Enum() *MessageStatus {
-	p := new(MessageStatus)
-	*p = x
-	return p
-}
-
-func (x MessageStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[8].Descriptor()
-}
-
-func (MessageStatus) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[8]
-}
-
-func (x MessageStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use MessageStatus.Descriptor instead.
-func (MessageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{8}
+	return file_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 type WormholeStatus int32
@@ -560,11 +793,11 @@ func (x WormholeStatus) String() string {
 }
 
 func (WormholeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[9].Descriptor()
+	return file_rpc_proto_enumTypes[13].Descriptor()
 }
 
 func (WormholeStatus) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[9]
+	return &file_rpc_proto_enumTypes[13]
 }
 
 func (x WormholeStatus) Number() protoreflect.EnumNumber {
@@ -573,7 +806,7 @@ func (x WormholeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WormholeStatus.Descriptor instead.
 func (WormholeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{9}
+	return file_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 type WormholeMessageStatus int32
@@ -609,11 +842,11 @@ func (x WormholeMessageStatus) String() string {
 }
 
 func (WormholeMessageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_proto_enumTypes[10].Descriptor()
+	return file_rpc_proto_enumTypes[14].Descriptor()
 }
 
 func (WormholeMessageStatus) Type() protoreflect.EnumType {
-	return &file_rpc_proto_enumTypes[10]
+	return &file_rpc_proto_enumTypes[14]
 }
 
 func (x WormholeMessageStatus) Number() protoreflect.EnumNumber {
@@ -622,7 +855,7 @@ func (x WormholeMessageStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WormholeMessageStatus.Descriptor instead.
 func (WormholeMessageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{10}
+	return file_rpc_proto_rawDescGZIP(), []int{14}
 }
 
 type SignRequest struct {
@@ -636,6 +869,17 @@ type SignRequest struct {
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached, if true, output will be just the signature.
 	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of signature, defaults to saltpack.
+	Format SignatureFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignatureFormat" json:"format,omitempty"`
+	// Namespace for SSHSIG signatures, defaults to "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Envelope, if true, signs an envelope with the time, context and content
+	// type (saltpack only).
+	Envelope bool `protobuf:"varint,14,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	// Context (optional) for the envelope, what the signature is for.
+	Context string `protobuf:"bytes,15,opt,name=context,proto3" json:"context,omitempty"`
+	// ContentType (optional) for the envelope.
+	ContentType string `protobuf:"bytes,16,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
 func (x *SignRequest) Reset() {
@@ -698,6 +942,41 @@ func (x *SignRequest) GetDetached() bool {
 	return false
 }
 
+func (x *SignRequest) GetFormat() SignatureFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SaltpackSignature
+}
+
+func (x *SignRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SignRequest) GetEnvelope() bool {
+	if x != nil {
+		return x.Envelope
+	}
+	return false
+}
+
+func (x *SignRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *SignRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
 type SignResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -754,6 +1033,7 @@ func (x *SignResponse) GetKID() string {
 	return ""
 }
 
+// SignFileInput for SignFile. Signature envelopes are only supported by Sign.
 type SignFileInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -768,6 +1048,10 @@ type SignFileInput struct {
 	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
 	// Detached, if true, output will be just the signature.
 	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of signature, defaults to saltpack.
+	Format SignatureFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignatureFormat" json:"format,omitempty"`
+	// Namespace for SSHSIG signatures, defaults to "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (x *SignFileInput) Reset() {
@@ -837,6 +1121,20 @@ func (x *SignFileInput) GetDetached() bool {
 	return false
 }
 
+func (x *SignFileInput) GetFormat() SignatureFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SaltpackSignature
+}
+
+func (x *SignFileInput) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
 type SignFileOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -915,6 +1213,8 @@ type VerifyRequest struct {
 
 	// Data is verified output.
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Context (optional) required in the signature envelope.
+	Context string `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 }
 
 func (x *VerifyRequest) Reset() {
@@ -956,6 +1256,13 @@ func (x *VerifyRequest) GetData() []byte {
 	return nil
 }
 
+func (x *VerifyRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
 type VerifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -964,6 +1271,8 @@ type VerifyResponse struct {
 	// Data (if out is not specified in request).
 	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Signer *Key   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Envelope (if signed with an envelope).
+	Envelope *SignatureEnvelope `protobuf:"bytes,3,opt,name=envelope,proto3" json:"envelope,omitempty"`
 }
 
 func (x *VerifyResponse) Reset() {
@@ -1012,18 +1321,28 @@ func (x *VerifyResponse) GetSigner() *Key {
 	return nil
 }
 
-type VerifyDetachedRequest struct {
+func (x *VerifyResponse) GetEnvelope() *SignatureEnvelope {
+	if x != nil {
+		return x.Envelope
+	}
+	return nil
+}
+
+// SignatureEnvelope is signed metadata for a signature.
+type SignatureEnvelope struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to verify.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Sig  []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	// CreatedAt is when the signature was created.
+	CreatedAt int64 `protobuf:"varint,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// Context is what the signature is for.
+	Context     string `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
 }
 
-func (x *VerifyDetachedRequest) Reset() {
-	*x = VerifyDetachedRequest{}
+func (x *SignatureEnvelope) Reset() {
+	*x = SignatureEnvelope{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,13 +1350,13 @@ func (x *VerifyDetachedRequest) Reset() {
 	}
 }
 
-func (x *VerifyDetachedRequest) String() string {
+func (x *SignatureEnvelope) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDetachedRequest) ProtoMessage() {}
+func (*SignatureEnvelope) ProtoMessage() {}
 
-func (x *VerifyDetachedRequest) ProtoReflect() protoreflect.Message {
+func (x *SignatureEnvelope) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,35 +1368,50 @@ func (x *VerifyDetachedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDetachedRequest.ProtoReflect.Descriptor instead.
-func (*VerifyDetachedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignatureEnvelope.ProtoReflect.Descriptor instead.
+func (*SignatureEnvelope) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *VerifyDetachedRequest) GetData() []byte {
+func (x *SignatureEnvelope) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Data
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *VerifyDetachedRequest) GetSig() []byte {
+func (x *SignatureEnvelope) GetContext() string {
 	if x != nil {
-		return x.Sig
+		return x.Context
 	}
-	return nil
+	return ""
 }
 
-type VerifyDetachedResponse struct {
+func (x *SignatureEnvelope) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type VerifyDetachedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer *Key `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Data to verify.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Sig  []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	// Format of signature, defaults to saltpack.
+	Format SignatureFormat `protobuf:"varint,3,opt,name=format,proto3,enum=service.SignatureFormat" json:"format,omitempty"`
+	// Namespace for SSHSIG signatures, defaults to "file".
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Context (optional) required in the signature envelope.
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
 }
 
-func (x *VerifyDetachedResponse) Reset() {
-	*x = VerifyDetachedResponse{}
+func (x *VerifyDetachedRequest) Reset() {
+	*x = VerifyDetachedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1085,13 +1419,13 @@ func (x *VerifyDetachedResponse) Reset() {
 	}
 }
 
-func (x *VerifyDetachedResponse) String() string {
+func (x *VerifyDetachedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDetachedResponse) ProtoMessage() {}
+func (*VerifyDetachedRequest) ProtoMessage() {}
 
-func (x *VerifyDetachedResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyDetachedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1103,29 +1437,58 @@ func (x *VerifyDetachedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDetachedResponse.ProtoReflect.Descriptor instead.
-func (*VerifyDetachedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyDetachedRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDetachedRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VerifyDetachedResponse) GetSigner() *Key {
+func (x *VerifyDetachedRequest) GetData() []byte {
 	if x != nil {
-		return x.Signer
+		return x.Data
 	}
 	return nil
 }
 
-type VerifyInput struct {
+func (x *VerifyDetachedRequest) GetSig() []byte {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+func (x *VerifyDetachedRequest) GetFormat() SignatureFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SaltpackSignature
+}
+
+func (x *VerifyDetachedRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VerifyDetachedRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+type VerifyDetachedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to verify.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signer *Key `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Envelope (if signed with an envelope).
+	Envelope *SignatureEnvelope `protobuf:"bytes,2,opt,name=envelope,proto3" json:"envelope,omitempty"`
 }
 
-func (x *VerifyInput) Reset() {
-	*x = VerifyInput{}
+func (x *VerifyDetachedResponse) Reset() {
+	*x = VerifyDetachedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,13 +1496,13 @@ func (x *VerifyInput) Reset() {
 	}
 }
 
-func (x *VerifyInput) String() string {
+func (x *VerifyDetachedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyInput) ProtoMessage() {}
+func (*VerifyDetachedResponse) ProtoMessage() {}
 
-func (x *VerifyInput) ProtoReflect() protoreflect.Message {
+func (x *VerifyDetachedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1151,30 +1514,40 @@ func (x *VerifyInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyInput.ProtoReflect.Descriptor instead.
-func (*VerifyInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyDetachedResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDetachedResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *VerifyInput) GetData() []byte {
+func (x *VerifyDetachedResponse) GetSigner() *Key {
 	if x != nil {
-		return x.Data
+		return x.Signer
 	}
 	return nil
 }
 
-type VerifyOutput struct {
+func (x *VerifyDetachedResponse) GetEnvelope() *SignatureEnvelope {
+	if x != nil {
+		return x.Envelope
+	}
+	return nil
+}
+
+type SignBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data, verified. If empty, is EOF.
-	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Signer *Key   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Data to sign.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Signers (kid or user@service) to add.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	// Bundle (optional) to add signatures to (countersign).
+	Bundle []byte `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (x *VerifyOutput) Reset() {
-	*x = VerifyOutput{}
+func (x *SignBundleRequest) Reset() {
+	*x = SignBundleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,13 +1555,13 @@ func (x *VerifyOutput) Reset() {
 	}
 }
 
-func (x *VerifyOutput) String() string {
+func (x *SignBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyOutput) ProtoMessage() {}
+func (*SignBundleRequest) ProtoMessage() {}
 
-func (x *VerifyOutput) ProtoReflect() protoreflect.Message {
+func (x *SignBundleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,38 +1573,43 @@ func (x *VerifyOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyOutput.ProtoReflect.Descriptor instead.
-func (*VerifyOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignBundleRequest.ProtoReflect.Descriptor instead.
+func (*SignBundleRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *VerifyOutput) GetData() []byte {
+func (x *SignBundleRequest) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *VerifyOutput) GetSigner() *Key {
+func (x *SignBundleRequest) GetSigners() []string {
 	if x != nil {
-		return x.Signer
+		return x.Signers
 	}
 	return nil
 }
 
-type VerifyFileInput struct {
+func (x *SignBundleRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type SignBundleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// In is input file path.
-	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	// Out is the output file name or directory to save to.
-	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	// Bundle of (detached) signatures.
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (x *VerifyFileInput) Reset() {
-	*x = VerifyFileInput{}
+func (x *SignBundleResponse) Reset() {
+	*x = SignBundleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1239,13 +1617,13 @@ func (x *VerifyFileInput) Reset() {
 	}
 }
 
-func (x *VerifyFileInput) String() string {
+func (x *SignBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyFileInput) ProtoMessage() {}
+func (*SignBundleResponse) ProtoMessage() {}
 
-func (x *VerifyFileInput) ProtoReflect() protoreflect.Message {
+func (x *SignBundleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1257,36 +1635,32 @@ func (x *VerifyFileInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyFileInput.ProtoReflect.Descriptor instead.
-func (*VerifyFileInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignBundleResponse.ProtoReflect.Descriptor instead.
+func (*SignBundleResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *VerifyFileInput) GetIn() string {
-	if x != nil {
-		return x.In
-	}
-	return ""
-}
-
-func (x *VerifyFileInput) GetOut() string {
+func (x *SignBundleResponse) GetBundle() []byte {
 	if x != nil {
-		return x.Out
+		return x.Bundle
 	}
-	return ""
+	return nil
 }
 
-type VerifyFileOutput struct {
+// SignaturePolicy requires signatures from at least threshold of the signers.
+type SignaturePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer *Key   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Out    string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	// Threshold, if 0, all the signers are required.
+	Threshold int32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Signers (kid or user@service), if empty, any signer counts.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (x *VerifyFileOutput) Reset() {
-	*x = VerifyFileOutput{}
+func (x *SignaturePolicy) Reset() {
+	*x = SignaturePolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1294,13 +1668,13 @@ func (x *VerifyFileOutput) Reset() {
 	}
 }
 
-func (x *VerifyFileOutput) String() string {
+func (x *SignaturePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyFileOutput) ProtoMessage() {}
+func (*SignaturePolicy) ProtoMessage() {}
 
-func (x *VerifyFileOutput) ProtoReflect() protoreflect.Message {
+func (x *SignaturePolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,38 +1686,38 @@ func (x *VerifyFileOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyFileOutput.ProtoReflect.Descriptor instead.
-func (*VerifyFileOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignaturePolicy.ProtoReflect.Descriptor instead.
+func (*SignaturePolicy) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VerifyFileOutput) GetSigner() *Key {
+func (x *SignaturePolicy) GetThreshold() int32 {
 	if x != nil {
-		return x.Signer
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-func (x *VerifyFileOutput) GetOut() string {
+func (x *SignaturePolicy) GetSigners() []string {
 	if x != nil {
-		return x.Out
+		return x.Signers
 	}
-	return ""
+	return nil
 }
 
-type VerifyDetachedFileInput struct {
+type VerifyBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// In is input file path.
-	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	// Signature (detached).
-	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	// Data to verify.
+	Data   []byte           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Bundle []byte           `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Policy *SignaturePolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *VerifyDetachedFileInput) Reset() {
-	*x = VerifyDetachedFileInput{}
+func (x *VerifyBundleRequest) Reset() {
+	*x = VerifyBundleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1351,13 +1725,13 @@ func (x *VerifyDetachedFileInput) Reset() {
 	}
 }
 
-func (x *VerifyDetachedFileInput) String() string {
+func (x *VerifyBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDetachedFileInput) ProtoMessage() {}
+func (*VerifyBundleRequest) ProtoMessage() {}
 
-func (x *VerifyDetachedFileInput) ProtoReflect() protoreflect.Message {
+func (x *VerifyBundleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,38 +1743,42 @@ func (x *VerifyDetachedFileInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDetachedFileInput.ProtoReflect.Descriptor instead.
-func (*VerifyDetachedFileInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyBundleRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBundleRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *VerifyDetachedFileInput) GetIn() string {
+func (x *VerifyBundleRequest) GetData() []byte {
 	if x != nil {
-		return x.In
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *VerifyDetachedFileInput) GetSig() []byte {
+func (x *VerifyBundleRequest) GetBundle() []byte {
 	if x != nil {
-		return x.Sig
+		return x.Bundle
 	}
 	return nil
 }
 
-type VerifyDetachedInput struct {
+func (x *VerifyBundleRequest) GetPolicy() *SignaturePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type VerifyBundleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to verify.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Signature (detached).
-	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	Signers []*Key `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
 }
 
-func (x *VerifyDetachedInput) Reset() {
-	*x = VerifyDetachedInput{}
+func (x *VerifyBundleResponse) Reset() {
+	*x = VerifyBundleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1408,13 +1786,13 @@ func (x *VerifyDetachedInput) Reset() {
 	}
 }
 
-func (x *VerifyDetachedInput) String() string {
+func (x *VerifyBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyDetachedInput) ProtoMessage() {}
+func (*VerifyBundleResponse) ProtoMessage() {}
 
-func (x *VerifyDetachedInput) ProtoReflect() protoreflect.Message {
+func (x *VerifyBundleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1426,50 +1804,29 @@ func (x *VerifyDetachedInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyDetachedInput.ProtoReflect.Descriptor instead.
-func (*VerifyDetachedInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyBundleResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBundleResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *VerifyDetachedInput) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *VerifyDetachedInput) GetSig() []byte {
+func (x *VerifyBundleResponse) GetSigners() []*Key {
 	if x != nil {
-		return x.Sig
+		return x.Signers
 	}
 	return nil
 }
 
-type Statement struct {
+type VerifyInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Sig is the signature bytes.
-	Sig []byte `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
-	// Data that was signed.
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// KID is the key that signed.
-	KID string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Seq in a sigchain (1 is root).
-	Seq int32 `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
-	// Prev is a hash of the previous item in the sigchain.
-	Prev []byte `protobuf:"bytes,5,opt,name=prev,proto3" json:"prev,omitempty"`
-	// Revoke refers to a previous signed seq to revoke.
-	Revoke int32 `protobuf:"varint,6,opt,name=revoke,proto3" json:"revoke,omitempty"`
-	// Timestamp ...
-	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Type (optional).
-	Type string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	// Data to verify.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *Statement) Reset() {
-	*x = Statement{}
+func (x *VerifyInput) Reset() {
+	*x = VerifyInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1477,13 +1834,13 @@ func (x *Statement) Reset() {
 	}
 }
 
-func (x *Statement) String() string {
+func (x *VerifyInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Statement) ProtoMessage() {}
+func (*VerifyInput) ProtoMessage() {}
 
-func (x *Statement) ProtoReflect() protoreflect.Message {
+func (x *VerifyInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,77 +1852,30 @@ func (x *Statement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
-func (*Statement) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyInput.ProtoReflect.Descriptor instead.
+func (*VerifyInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Statement) GetSig() []byte {
-	if x != nil {
-		return x.Sig
-	}
-	return nil
-}
-
-func (x *Statement) GetData() []byte {
+func (x *VerifyInput) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *Statement) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *Statement) GetSeq() int32 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
-}
-
-func (x *Statement) GetPrev() []byte {
-	if x != nil {
-		return x.Prev
-	}
-	return nil
-}
-
-func (x *Statement) GetRevoke() int32 {
-	if x != nil {
-		return x.Revoke
-	}
-	return 0
-}
-
-func (x *Statement) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *Statement) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-type SigchainRequest struct {
+type VerifyOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Data, verified. If empty, is EOF.
+	Data   []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signer *Key   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *SigchainRequest) Reset() {
-	*x = SigchainRequest{}
+func (x *VerifyOutput) Reset() {
+	*x = VerifyOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1573,13 +1883,13 @@ func (x *SigchainRequest) Reset() {
 	}
 }
 
-func (x *SigchainRequest) String() string {
+func (x *VerifyOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigchainRequest) ProtoMessage() {}
+func (*VerifyOutput) ProtoMessage() {}
 
-func (x *SigchainRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1591,29 +1901,38 @@ func (x *SigchainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SigchainRequest.ProtoReflect.Descriptor instead.
-func (*SigchainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyOutput.ProtoReflect.Descriptor instead.
+func (*VerifyOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SigchainRequest) GetKID() string {
+func (x *VerifyOutput) GetData() []byte {
 	if x != nil {
-		return x.KID
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type SigchainResponse struct {
+func (x *VerifyOutput) GetSigner() *Key {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+type VerifyFileInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key        *Key         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Statements []*Statement `protobuf:"bytes,2,rep,name=statements,proto3" json:"statements,omitempty"`
+	// In is input file path.
+	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	// Out is the output file name or directory to save to.
+	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
 }
 
-func (x *SigchainResponse) Reset() {
-	*x = SigchainResponse{}
+func (x *VerifyFileInput) Reset() {
+	*x = VerifyFileInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,13 +1940,13 @@ func (x *SigchainResponse) Reset() {
 	}
 }
 
-func (x *SigchainResponse) String() string {
+func (x *VerifyFileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SigchainResponse) ProtoMessage() {}
+func (*VerifyFileInput) ProtoMessage() {}
 
-func (x *SigchainResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyFileInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,36 +1958,36 @@ func (x *SigchainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SigchainResponse.ProtoReflect.Descriptor instead.
-func (*SigchainResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyFileInput.ProtoReflect.Descriptor instead.
+func (*VerifyFileInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SigchainResponse) GetKey() *Key {
+func (x *VerifyFileInput) GetIn() string {
 	if x != nil {
-		return x.Key
+		return x.In
 	}
-	return nil
+	return ""
 }
 
-func (x *SigchainResponse) GetStatements() []*Statement {
+func (x *VerifyFileInput) GetOut() string {
 	if x != nil {
-		return x.Statements
+		return x.Out
 	}
-	return nil
+	return ""
 }
 
-type StatementRequest struct {
+type VerifyFileOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	Seq int32  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Signer *Key   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Out    string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
 }
 
-func (x *StatementRequest) Reset() {
-	*x = StatementRequest{}
+func (x *VerifyFileOutput) Reset() {
+	*x = VerifyFileOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1676,13 +1995,13 @@ func (x *StatementRequest) Reset() {
 	}
 }
 
-func (x *StatementRequest) String() string {
+func (x *VerifyFileOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementRequest) ProtoMessage() {}
+func (*VerifyFileOutput) ProtoMessage() {}
 
-func (x *StatementRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyFileOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1694,35 +2013,38 @@ func (x *StatementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementRequest.ProtoReflect.Descriptor instead.
-func (*StatementRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyFileOutput.ProtoReflect.Descriptor instead.
+func (*VerifyFileOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *StatementRequest) GetKID() string {
+func (x *VerifyFileOutput) GetSigner() *Key {
 	if x != nil {
-		return x.KID
+		return x.Signer
 	}
-	return ""
+	return nil
 }
 
-func (x *StatementRequest) GetSeq() int32 {
+func (x *VerifyFileOutput) GetOut() string {
 	if x != nil {
-		return x.Seq
+		return x.Out
 	}
-	return 0
+	return ""
 }
 
-type StatementResponse struct {
+type VerifyDetachedFileInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// In is input file path.
+	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	// Signature (detached).
+	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 }
 
-func (x *StatementResponse) Reset() {
-	*x = StatementResponse{}
+func (x *VerifyDetachedFileInput) Reset() {
+	*x = VerifyDetachedFileInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1730,13 +2052,13 @@ func (x *StatementResponse) Reset() {
 	}
 }
 
-func (x *StatementResponse) String() string {
+func (x *VerifyDetachedFileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementResponse) ProtoMessage() {}
+func (*VerifyDetachedFileInput) ProtoMessage() {}
 
-func (x *StatementResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyDetachedFileInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1748,31 +2070,38 @@ func (x *StatementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementResponse.ProtoReflect.Descriptor instead.
-func (*StatementResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyDetachedFileInput.ProtoReflect.Descriptor instead.
+func (*VerifyDetachedFileInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StatementResponse) GetStatement() *Statement {
+func (x *VerifyDetachedFileInput) GetIn() string {
 	if x != nil {
-		return x.Statement
+		return x.In
+	}
+	return ""
+}
+
+func (x *VerifyDetachedFileInput) GetSig() []byte {
+	if x != nil {
+		return x.Sig
 	}
 	return nil
 }
 
-type StatementCreateRequest struct {
+type VerifyDetachedInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Data to verify.
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	KID  string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Local, if true, won't save to the current key server.
-	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
+	// Signature (detached).
+	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
 }
 
-func (x *StatementCreateRequest) Reset() {
-	*x = StatementCreateRequest{}
+func (x *VerifyDetachedInput) Reset() {
+	*x = VerifyDetachedInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1780,13 +2109,13 @@ func (x *StatementCreateRequest) Reset() {
 	}
 }
 
-func (x *StatementCreateRequest) String() string {
+func (x *VerifyDetachedInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementCreateRequest) ProtoMessage() {}
+func (*VerifyDetachedInput) ProtoMessage() {}
 
-func (x *StatementCreateRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyDetachedInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1798,42 +2127,54 @@ func (x *StatementCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementCreateRequest.ProtoReflect.Descriptor instead.
-func (*StatementCreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyDetachedInput.ProtoReflect.Descriptor instead.
+func (*VerifyDetachedInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StatementCreateRequest) GetData() []byte {
+func (x *VerifyDetachedInput) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-func (x *StatementCreateRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *StatementCreateRequest) GetLocal() bool {
+func (x *VerifyDetachedInput) GetSig() []byte {
 	if x != nil {
-		return x.Local
+		return x.Sig
 	}
-	return false
+	return nil
 }
 
-type StatementCreateResponse struct {
+type Statement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// Sig is the signature bytes.
+	Sig []byte `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
+	// Data that was signed.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// KID is the key that signed.
+	KID string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Seq in a sigchain (1 is root).
+	Seq int32 `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Prev is a hash of the previous item in the sigchain.
+	Prev []byte `protobuf:"bytes,5,opt,name=prev,proto3" json:"prev,omitempty"`
+	// Revoke refers to a previous signed seq to revoke.
+	Revoke int32 `protobuf:"varint,6,opt,name=revoke,proto3" json:"revoke,omitempty"`
+	// Timestamp ...
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Type (optional).
+	Type string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	// Status is whether the statement was published to the key server.
+	Status StatementStatus `protobuf:"varint,9,opt,name=status,proto3,enum=service.StatementStatus" json:"status,omitempty"`
+	// CreatedAt is when the key server saved the statement (if known).
+	CreatedAt int64 `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *StatementCreateResponse) Reset() {
-	*x = StatementCreateResponse{}
+func (x *Statement) Reset() {
+	*x = Statement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1841,13 +2182,13 @@ func (x *StatementCreateResponse) Reset() {
 	}
 }
 
-func (x *StatementCreateResponse) String() string {
+func (x *Statement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementCreateResponse) ProtoMessage() {}
+func (*Statement) ProtoMessage() {}
 
-func (x *StatementCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *Statement) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1859,32 +2200,91 @@ func (x *StatementCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementCreateResponse.ProtoReflect.Descriptor instead.
-func (*StatementCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StatementCreateResponse) GetStatement() *Statement {
+func (x *Statement) GetSig() []byte {
 	if x != nil {
-		return x.Statement
+		return x.Sig
 	}
 	return nil
 }
 
-type StatementRevokeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Statement) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-	// Seq to revoke.
-	Seq int32  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
-	KID string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Local, if true, won't save to the current key server.
-	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
+func (x *Statement) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
 }
 
-func (x *StatementRevokeRequest) Reset() {
-	*x = StatementRevokeRequest{}
+func (x *Statement) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Statement) GetPrev() []byte {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *Statement) GetRevoke() int32 {
+	if x != nil {
+		return x.Revoke
+	}
+	return 0
+}
+
+func (x *Statement) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Statement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Statement) GetStatus() StatementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StatementPublished
+}
+
+func (x *Statement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SigchainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+}
+
+func (x *SigchainRequest) Reset() {
+	*x = SigchainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,13 +2292,13 @@ func (x *StatementRevokeRequest) Reset() {
 	}
 }
 
-func (x *StatementRevokeRequest) String() string {
+func (x *SigchainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementRevokeRequest) ProtoMessage() {}
+func (*SigchainRequest) ProtoMessage() {}
 
-func (x *StatementRevokeRequest) ProtoReflect() protoreflect.Message {
+func (x *SigchainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,42 +2310,29 @@ func (x *StatementRevokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementRevokeRequest.ProtoReflect.Descriptor instead.
-func (*StatementRevokeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainRequest.ProtoReflect.Descriptor instead.
+func (*SigchainRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StatementRevokeRequest) GetSeq() int32 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
-}
-
-func (x *StatementRevokeRequest) GetKID() string {
+func (x *SigchainRequest) GetKID() string {
 	if x != nil {
 		return x.KID
 	}
 	return ""
 }
 
-func (x *StatementRevokeRequest) GetLocal() bool {
-	if x != nil {
-		return x.Local
-	}
-	return false
-}
-
-type StatementRevokeResponse struct {
+type SigchainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Key        *Key         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Statements []*Statement `protobuf:"bytes,2,rep,name=statements,proto3" json:"statements,omitempty"`
 }
 
-func (x *StatementRevokeResponse) Reset() {
-	*x = StatementRevokeResponse{}
+func (x *SigchainResponse) Reset() {
+	*x = SigchainResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,13 +2340,13 @@ func (x *StatementRevokeResponse) Reset() {
 	}
 }
 
-func (x *StatementRevokeResponse) String() string {
+func (x *SigchainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatementRevokeResponse) ProtoMessage() {}
+func (*SigchainResponse) ProtoMessage() {}
 
-func (x *StatementRevokeResponse) ProtoReflect() protoreflect.Message {
+func (x *SigchainResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,37 +2358,36 @@ func (x *StatementRevokeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatementRevokeResponse.ProtoReflect.Descriptor instead.
-func (*StatementRevokeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainResponse.ProtoReflect.Descriptor instead.
+func (*SigchainResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *StatementRevokeResponse) GetStatement() *Statement {
+func (x *SigchainResponse) GetKey() *Key {
 	if x != nil {
-		return x.Statement
+		return x.Key
 	}
 	return nil
 }
 
-type SignInput struct {
+func (x *SigchainResponse) GetStatements() []*Statement {
+	if x != nil {
+		return x.Statements
+	}
+	return nil
+}
+
+type StatementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// In is input file path (if data is not specified).
-	In string `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
-	// Out is output file path (required if in specified).
-	Out    string `protobuf:"bytes,3,opt,name=out,proto3" json:"out,omitempty"`
-	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
-	// Armored, if true, output will be armored.
-	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
-	// Detached, if true, output will be just the signature.
-	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Seq int32  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (x *SignInput) Reset() {
-	*x = SignInput{}
+func (x *StatementRequest) Reset() {
+	*x = StatementRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2009,13 +2395,13 @@ func (x *SignInput) Reset() {
 	}
 }
 
-func (x *SignInput) String() string {
+func (x *StatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInput) ProtoMessage() {}
+func (*StatementRequest) ProtoMessage() {}
 
-func (x *SignInput) ProtoReflect() protoreflect.Message {
+func (x *StatementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2027,65 +2413,35 @@ func (x *SignInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInput.ProtoReflect.Descriptor instead.
-func (*SignInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementRequest.ProtoReflect.Descriptor instead.
+func (*StatementRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SignInput) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *SignInput) GetIn() string {
-	if x != nil {
-		return x.In
-	}
-	return ""
-}
-
-func (x *SignInput) GetOut() string {
-	if x != nil {
-		return x.Out
-	}
-	return ""
-}
-
-func (x *SignInput) GetSigner() string {
+func (x *StatementRequest) GetKID() string {
 	if x != nil {
-		return x.Signer
+		return x.KID
 	}
 	return ""
 }
 
-func (x *SignInput) GetArmored() bool {
-	if x != nil {
-		return x.Armored
-	}
-	return false
-}
-
-func (x *SignInput) GetDetached() bool {
+func (x *StatementRequest) GetSeq() int32 {
 	if x != nil {
-		return x.Detached
+		return x.Seq
 	}
-	return false
+	return 0
 }
 
-type SignOutput struct {
+type StatementResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data, signed.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	KID  string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *SignOutput) Reset() {
-	*x = SignOutput{}
+func (x *StatementResponse) Reset() {
+	*x = StatementResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2093,13 +2449,13 @@ func (x *SignOutput) Reset() {
 	}
 }
 
-func (x *SignOutput) String() string {
+func (x *StatementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutput) ProtoMessage() {}
+func (*StatementResponse) ProtoMessage() {}
 
-func (x *SignOutput) ProtoReflect() protoreflect.Message {
+func (x *StatementResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2111,42 +2467,34 @@ func (x *SignOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutput.ProtoReflect.Descriptor instead.
-func (*SignOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementResponse.ProtoReflect.Descriptor instead.
+func (*StatementResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SignOutput) GetData() []byte {
+func (x *StatementResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.Data
+		return x.Statement
 	}
 	return nil
 }
 
-func (x *SignOutput) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-type EncryptOptions struct {
+type StatementCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Armored, if true will return armored string output.
-	Armored bool `protobuf:"varint,1,opt,name=armored,proto3" json:"armored,omitempty"`
-	// Mode is the encryption mode.
-	Mode EncryptMode `protobuf:"varint,2,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
-	// NoSenderRecipient if true, won't add sender to recipients list.
-	NoSenderRecipient bool `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
-	// NoSign if true, won't sign with sender.
-	NoSign bool `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	KID  string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Type (optional), if set data is (JSON) for the type: device, email, pgp
+	// or dns. For dns, the domain needs a TXT record keys.pub=<kid>.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *EncryptOptions) Reset() {
-	*x = EncryptOptions{}
+func (x *StatementCreateRequest) Reset() {
+	*x = StatementCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2154,13 +2502,13 @@ func (x *EncryptOptions) Reset() {
 	}
 }
 
-func (x *EncryptOptions) String() string {
+func (x *StatementCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptOptions) ProtoMessage() {}
+func (*StatementCreateRequest) ProtoMessage() {}
 
-func (x *EncryptOptions) ProtoReflect() protoreflect.Message {
+func (x *StatementCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2172,56 +2520,49 @@ func (x *EncryptOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptOptions.ProtoReflect.Descriptor instead.
-func (*EncryptOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementCreateRequest.ProtoReflect.Descriptor instead.
+func (*StatementCreateRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *EncryptOptions) GetArmored() bool {
+func (x *StatementCreateRequest) GetData() []byte {
 	if x != nil {
-		return x.Armored
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-func (x *EncryptOptions) GetMode() EncryptMode {
+func (x *StatementCreateRequest) GetKID() string {
 	if x != nil {
-		return x.Mode
+		return x.KID
 	}
-	return DefaultEncrypt
+	return ""
 }
 
-func (x *EncryptOptions) GetNoSenderRecipient() bool {
+func (x *StatementCreateRequest) GetType() string {
 	if x != nil {
-		return x.NoSenderRecipient
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *EncryptOptions) GetNoSign() bool {
+func (x *StatementCreateRequest) GetLocal() bool {
 	if x != nil {
-		return x.NoSign
+		return x.Local
 	}
 	return false
 }
 
-type EncryptRequest struct {
+type StatementCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to encrypt.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Recipients to encrypt to.
-	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	// Sender, or anonymous.
-	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Options for encrypt.
-	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *EncryptRequest) Reset() {
-	*x = EncryptRequest{}
+func (x *StatementCreateResponse) Reset() {
+	*x = StatementCreateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,13 +2570,13 @@ func (x *EncryptRequest) Reset() {
 	}
 }
 
-func (x *EncryptRequest) String() string {
+func (x *StatementCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptRequest) ProtoMessage() {}
+func (*StatementCreateResponse) ProtoMessage() {}
 
-func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
+func (x *StatementCreateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2247,50 +2588,32 @@ func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
-func (*EncryptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementCreateResponse.ProtoReflect.Descriptor instead.
+func (*StatementCreateResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EncryptRequest) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *EncryptRequest) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
-}
-
-func (x *EncryptRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *EncryptRequest) GetOptions() *EncryptOptions {
+func (x *StatementCreateResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.Options
+		return x.Statement
 	}
 	return nil
 }
 
-type EncryptResponse struct {
+type StatementRevokeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Info string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// Seq to revoke.
+	Seq int32  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	KID string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *EncryptResponse) Reset() {
-	*x = EncryptResponse{}
+func (x *StatementRevokeRequest) Reset() {
+	*x = StatementRevokeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2298,13 +2621,13 @@ func (x *EncryptResponse) Reset() {
 	}
 }
 
-func (x *EncryptResponse) String() string {
+func (x *StatementRevokeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptResponse) ProtoMessage() {}
+func (*StatementRevokeRequest) ProtoMessage() {}
 
-func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
+func (x *StatementRevokeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2316,44 +2639,42 @@ func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptResponse.ProtoReflect.Descriptor instead.
-func (*EncryptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementRevokeRequest.ProtoReflect.Descriptor instead.
+func (*StatementRevokeRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *EncryptResponse) GetData() []byte {
+func (x *StatementRevokeRequest) GetSeq() int32 {
 	if x != nil {
-		return x.Data
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *EncryptResponse) GetInfo() string {
+func (x *StatementRevokeRequest) GetKID() string {
 	if x != nil {
-		return x.Info
+		return x.KID
 	}
 	return ""
 }
 
-type EncryptFileInput struct {
+func (x *StatementRevokeRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type StatementRevokeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// In is input file path.
-	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	// Out is output file path.
-	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
-	// Recipients to encrypt to.
-	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	// Sender, or anonymous.
-	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Options for encrypt.
-	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *EncryptFileInput) Reset() {
-	*x = EncryptFileInput{}
+func (x *StatementRevokeResponse) Reset() {
+	*x = StatementRevokeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2361,13 +2682,13 @@ func (x *EncryptFileInput) Reset() {
 	}
 }
 
-func (x *EncryptFileInput) String() string {
+func (x *StatementRevokeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptFileInput) ProtoMessage() {}
+func (*StatementRevokeResponse) ProtoMessage() {}
 
-func (x *EncryptFileInput) ProtoReflect() protoreflect.Message {
+func (x *StatementRevokeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2379,59 +2700,32 @@ func (x *EncryptFileInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptFileInput.ProtoReflect.Descriptor instead.
-func (*EncryptFileInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatementRevokeResponse.ProtoReflect.Descriptor instead.
+func (*StatementRevokeResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *EncryptFileInput) GetIn() string {
-	if x != nil {
-		return x.In
-	}
-	return ""
-}
-
-func (x *EncryptFileInput) GetOut() string {
-	if x != nil {
-		return x.Out
-	}
-	return ""
-}
-
-func (x *EncryptFileInput) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
-}
-
-func (x *EncryptFileInput) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *EncryptFileInput) GetOptions() *EncryptOptions {
+func (x *StatementRevokeResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.Options
+		return x.Statement
 	}
 	return nil
 }
 
-type EncryptFileOutput struct {
+type SigchainPublishRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Out is the output decrypted file path.
-	Out   string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
-	Bytes int32  `protobuf:"varint,10,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Total int32  `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`
+	// KID to publish, if empty publishes all pending statements.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Rebase, if the key server sigchain diverged, re-signs pending statements
+	// after the statements on the key server.
+	Rebase bool `protobuf:"varint,2,opt,name=rebase,proto3" json:"rebase,omitempty"`
 }
 
-func (x *EncryptFileOutput) Reset() {
-	*x = EncryptFileOutput{}
+func (x *SigchainPublishRequest) Reset() {
+	*x = SigchainPublishRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2439,13 +2733,13 @@ func (x *EncryptFileOutput) Reset() {
 	}
 }
 
-func (x *EncryptFileOutput) String() string {
+func (x *SigchainPublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptFileOutput) ProtoMessage() {}
+func (*SigchainPublishRequest) ProtoMessage() {}
 
-func (x *EncryptFileOutput) ProtoReflect() protoreflect.Message {
+func (x *SigchainPublishRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2457,49 +2751,36 @@ func (x *EncryptFileOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptFileOutput.ProtoReflect.Descriptor instead.
-func (*EncryptFileOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainPublishRequest.ProtoReflect.Descriptor instead.
+func (*SigchainPublishRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *EncryptFileOutput) GetOut() string {
+func (x *SigchainPublishRequest) GetKID() string {
 	if x != nil {
-		return x.Out
+		return x.KID
 	}
 	return ""
 }
 
-func (x *EncryptFileOutput) GetBytes() int32 {
-	if x != nil {
-		return x.Bytes
-	}
-	return 0
-}
-
-func (x *EncryptFileOutput) GetTotal() int32 {
+func (x *SigchainPublishRequest) GetRebase() bool {
 	if x != nil {
-		return x.Total
+		return x.Rebase
 	}
-	return 0
+	return false
 }
 
-type EncryptInput struct {
+type SigchainPublishResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to encrypt. Send empty byte slice as last message.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Recipients to encrypt to.
-	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	// Sender, or anonymous.
-	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Options for encrypt.
-	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
+	// Statements published.
+	Statements []*Statement `protobuf:"bytes,1,rep,name=statements,proto3" json:"statements,omitempty"`
 }
 
-func (x *EncryptInput) Reset() {
-	*x = EncryptInput{}
+func (x *SigchainPublishResponse) Reset() {
+	*x = SigchainPublishResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2507,13 +2788,13 @@ func (x *EncryptInput) Reset() {
 	}
 }
 
-func (x *EncryptInput) String() string {
+func (x *SigchainPublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptInput) ProtoMessage() {}
+func (*SigchainPublishResponse) ProtoMessage() {}
 
-func (x *EncryptInput) ProtoReflect() protoreflect.Message {
+func (x *SigchainPublishResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2525,50 +2806,28 @@ func (x *EncryptInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptInput.ProtoReflect.Descriptor instead.
-func (*EncryptInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainPublishResponse.ProtoReflect.Descriptor instead.
+func (*SigchainPublishResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EncryptInput) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *EncryptInput) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
-}
-
-func (x *EncryptInput) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *EncryptInput) GetOptions() *EncryptOptions {
+func (x *SigchainPublishResponse) GetStatements() []*Statement {
 	if x != nil {
-		return x.Options
+		return x.Statements
 	}
 	return nil
 }
 
-type EncryptOutput struct {
+type SigchainExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data, encrypted.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *EncryptOutput) Reset() {
-	*x = EncryptOutput{}
+func (x *SigchainExportRequest) Reset() {
+	*x = SigchainExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2576,13 +2835,13 @@ func (x *EncryptOutput) Reset() {
 	}
 }
 
-func (x *EncryptOutput) String() string {
+func (x *SigchainExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptOutput) ProtoMessage() {}
+func (*SigchainExportRequest) ProtoMessage() {}
 
-func (x *EncryptOutput) ProtoReflect() protoreflect.Message {
+func (x *SigchainExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2594,29 +2853,29 @@ func (x *EncryptOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptOutput.ProtoReflect.Descriptor instead.
-func (*EncryptOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainExportRequest.ProtoReflect.Descriptor instead.
+func (*SigchainExportRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *EncryptOutput) GetData() []byte {
+func (x *SigchainExportRequest) GetKID() string {
 	if x != nil {
-		return x.Data
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type DecryptRequest struct {
+type SigchainExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data to decrypt.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Bundle (JSON) with statements, key server metadata and user proofs.
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (x *DecryptRequest) Reset() {
-	*x = DecryptRequest{}
+func (x *SigchainExportResponse) Reset() {
+	*x = SigchainExportResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2624,13 +2883,13 @@ func (x *DecryptRequest) Reset() {
 	}
 }
 
-func (x *DecryptRequest) String() string {
+func (x *SigchainExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptRequest) ProtoMessage() {}
+func (*SigchainExportResponse) ProtoMessage() {}
 
-func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
+func (x *SigchainExportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2642,33 +2901,29 @@ func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
-func (*DecryptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainExportResponse.ProtoReflect.Descriptor instead.
+func (*SigchainExportResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DecryptRequest) GetData() []byte {
+func (x *SigchainExportResponse) GetBundle() []byte {
 	if x != nil {
-		return x.Data
+		return x.Bundle
 	}
 	return nil
 }
 
-type DecryptResponse struct {
+type SigchainVerifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data decrypted.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Sender (if not anonymous)
-	Sender *Key `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Mode is the encryption mode for the data.
-	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Bundle from SigchainExport.
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (x *DecryptResponse) Reset() {
-	*x = DecryptResponse{}
+func (x *SigchainVerifyRequest) Reset() {
+	*x = SigchainVerifyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2676,13 +2931,13 @@ func (x *DecryptResponse) Reset() {
 	}
 }
 
-func (x *DecryptResponse) String() string {
+func (x *SigchainVerifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptResponse) ProtoMessage() {}
+func (*SigchainVerifyRequest) ProtoMessage() {}
 
-func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
+func (x *SigchainVerifyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2694,45 +2949,31 @@ func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptResponse.ProtoReflect.Descriptor instead.
-func (*DecryptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainVerifyRequest.ProtoReflect.Descriptor instead.
+func (*SigchainVerifyRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DecryptResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *DecryptResponse) GetSender() *Key {
+func (x *SigchainVerifyRequest) GetBundle() []byte {
 	if x != nil {
-		return x.Sender
+		return x.Bundle
 	}
 	return nil
 }
 
-func (x *DecryptResponse) GetMode() EncryptMode {
-	if x != nil {
-		return x.Mode
-	}
-	return DefaultEncrypt
-}
-
-type DecryptFileInput struct {
+type SigchainVerifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// In is the input encrypted file path.
-	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	// Out is the output file name or directory to save to.
-	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	KID        string       `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Statements []*Statement `protobuf:"bytes,2,rep,name=statements,proto3" json:"statements,omitempty"`
+	// Users with verified proofs.
+	Users []*User `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *DecryptFileInput) Reset() {
-	*x = DecryptFileInput{}
+func (x *SigchainVerifyResponse) Reset() {
+	*x = SigchainVerifyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2740,13 +2981,13 @@ func (x *DecryptFileInput) Reset() {
 	}
 }
 
-func (x *DecryptFileInput) String() string {
+func (x *SigchainVerifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptFileInput) ProtoMessage() {}
+func (*SigchainVerifyResponse) ProtoMessage() {}
 
-func (x *DecryptFileInput) ProtoReflect() protoreflect.Message {
+func (x *SigchainVerifyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2758,41 +2999,56 @@ func (x *DecryptFileInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptFileInput.ProtoReflect.Descriptor instead.
-func (*DecryptFileInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SigchainVerifyResponse.ProtoReflect.Descriptor instead.
+func (*SigchainVerifyResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DecryptFileInput) GetIn() string {
+func (x *SigchainVerifyResponse) GetKID() string {
 	if x != nil {
-		return x.In
+		return x.KID
 	}
 	return ""
 }
 
-func (x *DecryptFileInput) GetOut() string {
+func (x *SigchainVerifyResponse) GetStatements() []*Statement {
 	if x != nil {
-		return x.Out
+		return x.Statements
 	}
-	return ""
+	return nil
 }
 
-type DecryptFileOutput struct {
+func (x *SigchainVerifyResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// SignInput for SignStream. Signature envelopes are only supported by Sign.
+type SignInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender *Key `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Out is the output file path.
-	Out   string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
-	Bytes int32  `protobuf:"varint,10,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Total int32  `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`
-	// Mode is the encryption mode we detected.
-	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// In is input file path (if data is not specified).
+	In string `protobuf:"bytes,2,opt,name=in,proto3" json:"in,omitempty"`
+	// Out is output file path (required if in specified).
+	Out    string `protobuf:"bytes,3,opt,name=out,proto3" json:"out,omitempty"`
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Armored, if true, output will be armored.
+	Armored bool `protobuf:"varint,10,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Detached, if true, output will be just the signature.
+	Detached bool `protobuf:"varint,11,opt,name=detached,proto3" json:"detached,omitempty"`
+	// Format of signature, defaults to saltpack.
+	Format SignatureFormat `protobuf:"varint,12,opt,name=format,proto3,enum=service.SignatureFormat" json:"format,omitempty"`
+	// Namespace for SSHSIG signatures, defaults to "file".
+	Namespace string `protobuf:"bytes,13,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
-func (x *DecryptFileOutput) Reset() {
-	*x = DecryptFileOutput{}
+func (x *SignInput) Reset() {
+	*x = SignInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2800,13 +3056,13 @@ func (x *DecryptFileOutput) Reset() {
 	}
 }
 
-func (x *DecryptFileOutput) String() string {
+func (x *SignInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptFileOutput) ProtoMessage() {}
+func (*SignInput) ProtoMessage() {}
 
-func (x *DecryptFileOutput) ProtoReflect() protoreflect.Message {
+func (x *SignInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2818,57 +3074,79 @@ func (x *DecryptFileOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptFileOutput.ProtoReflect.Descriptor instead.
-func (*DecryptFileOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignInput.ProtoReflect.Descriptor instead.
+func (*SignInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DecryptFileOutput) GetSender() *Key {
+func (x *SignInput) GetData() []byte {
 	if x != nil {
-		return x.Sender
+		return x.Data
 	}
 	return nil
 }
 
-func (x *DecryptFileOutput) GetOut() string {
+func (x *SignInput) GetIn() string {
+	if x != nil {
+		return x.In
+	}
+	return ""
+}
+
+func (x *SignInput) GetOut() string {
 	if x != nil {
 		return x.Out
 	}
 	return ""
 }
 
-func (x *DecryptFileOutput) GetBytes() int32 {
+func (x *SignInput) GetSigner() string {
 	if x != nil {
-		return x.Bytes
+		return x.Signer
 	}
-	return 0
+	return ""
 }
 
-func (x *DecryptFileOutput) GetTotal() int32 {
+func (x *SignInput) GetArmored() bool {
 	if x != nil {
-		return x.Total
+		return x.Armored
 	}
-	return 0
+	return false
 }
 
-func (x *DecryptFileOutput) GetMode() EncryptMode {
+func (x *SignInput) GetDetached() bool {
 	if x != nil {
-		return x.Mode
+		return x.Detached
 	}
-	return DefaultEncrypt
+	return false
 }
 
-type DecryptInput struct {
+func (x *SignInput) GetFormat() SignatureFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SaltpackSignature
+}
+
+func (x *SignInput) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type SignOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data, encrypted.
+	// Data, signed.
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	KID  string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *DecryptInput) Reset() {
-	*x = DecryptInput{}
+func (x *SignOutput) Reset() {
+	*x = SignOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2876,13 +3154,13 @@ func (x *DecryptInput) Reset() {
 	}
 }
 
-func (x *DecryptInput) String() string {
+func (x *SignOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptInput) ProtoMessage() {}
+func (*SignOutput) ProtoMessage() {}
 
-func (x *DecryptInput) ProtoReflect() protoreflect.Message {
+func (x *SignOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2894,33 +3172,42 @@ func (x *DecryptInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptInput.ProtoReflect.Descriptor instead.
-func (*DecryptInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignOutput.ProtoReflect.Descriptor instead.
+func (*SignOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DecryptInput) GetData() []byte {
+func (x *SignOutput) GetData() []byte {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type DecryptOutput struct {
+func (x *SignOutput) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+type EncryptOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Data, decrypted. If empty, is EOF.
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Sender (if not anonymous)
-	Sender *Key `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// Mode is the encryption mode for the data.
-	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// Armored, if true will return armored string output.
+	Armored bool `protobuf:"varint,1,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Mode is the encryption mode.
+	Mode EncryptMode `protobuf:"varint,2,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	// NoSenderRecipient if true, won't add sender to recipients list.
+	NoSenderRecipient bool `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
+	// NoSign if true, won't sign with sender.
+	NoSign bool `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
 }
 
-func (x *DecryptOutput) Reset() {
-	*x = DecryptOutput{}
+func (x *EncryptOptions) Reset() {
+	*x = EncryptOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2928,13 +3215,13 @@ func (x *DecryptOutput) Reset() {
 	}
 }
 
-func (x *DecryptOutput) String() string {
+func (x *EncryptOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecryptOutput) ProtoMessage() {}
+func (*EncryptOptions) ProtoMessage() {}
 
-func (x *DecryptOutput) ProtoReflect() protoreflect.Message {
+func (x *EncryptOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2946,40 +3233,56 @@ func (x *DecryptOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecryptOutput.ProtoReflect.Descriptor instead.
-func (*DecryptOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptOptions.ProtoReflect.Descriptor instead.
+func (*EncryptOptions) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DecryptOutput) GetData() []byte {
+func (x *EncryptOptions) GetArmored() bool {
 	if x != nil {
-		return x.Data
+		return x.Armored
 	}
-	return nil
+	return false
 }
 
-func (x *DecryptOutput) GetSender() *Key {
+func (x *EncryptOptions) GetMode() EncryptMode {
 	if x != nil {
-		return x.Sender
+		return x.Mode
 	}
-	return nil
+	return DefaultEncrypt
 }
 
-func (x *DecryptOutput) GetMode() EncryptMode {
+func (x *EncryptOptions) GetNoSenderRecipient() bool {
 	if x != nil {
-		return x.Mode
+		return x.NoSenderRecipient
 	}
-	return DefaultEncrypt
+	return false
 }
 
-type RuntimeStatusRequest struct {
+func (x *EncryptOptions) GetNoSign() bool {
+	if x != nil {
+		return x.NoSign
+	}
+	return false
+}
+
+type EncryptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Data to encrypt.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Recipients to encrypt to.
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// Sender, or anonymous.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Options for encrypt.
+	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *RuntimeStatusRequest) Reset() {
-	*x = RuntimeStatusRequest{}
+func (x *EncryptRequest) Reset() {
+	*x = EncryptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2987,13 +3290,13 @@ func (x *RuntimeStatusRequest) Reset() {
 	}
 }
 
-func (x *RuntimeStatusRequest) String() string {
+func (x *EncryptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeStatusRequest) ProtoMessage() {}
+func (*EncryptRequest) ProtoMessage() {}
 
-func (x *RuntimeStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *EncryptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3005,32 +3308,51 @@ func (x *RuntimeStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeStatusRequest.ProtoReflect.Descriptor instead.
-func (*RuntimeStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptRequest.ProtoReflect.Descriptor instead.
+func (*EncryptRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-type RuntimeStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EncryptRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
 
-	// Version of running service.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// AppName app name.
-	AppName string `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
-	// Exe is the service executable path.
-	Exe string `protobuf:"bytes,4,opt,name=exe,proto3" json:"exe,omitempty"`
-	// AuthStatus is the status of auth.
-	AuthStatus AuthStatus `protobuf:"varint,5,opt,name=authStatus,proto3,enum=service.AuthStatus" json:"authStatus,omitempty"`
-	// SyncStatus is the status of vault sync.
-	Sync bool `protobuf:"varint,6,opt,name=sync,proto3" json:"sync,omitempty"`
-	// FIDO2 available.
-	FIDO2 bool `protobuf:"varint,20,opt,name=fido2,proto3" json:"fido2,omitempty"`
+func (x *EncryptRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
 }
 
-func (x *RuntimeStatusResponse) Reset() {
-	*x = RuntimeStatusResponse{}
+func (x *EncryptRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EncryptRequest) GetOptions() *EncryptOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type EncryptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Info describes which recipients groups resolved to.
+	Info string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *EncryptResponse) Reset() {
+	*x = EncryptResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3038,13 +3360,13 @@ func (x *RuntimeStatusResponse) Reset() {
 	}
 }
 
-func (x *RuntimeStatusResponse) String() string {
+func (x *EncryptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeStatusResponse) ProtoMessage() {}
+func (*EncryptResponse) ProtoMessage() {}
 
-func (x *RuntimeStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *EncryptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3056,68 +3378,46 @@ func (x *RuntimeStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeStatusResponse.ProtoReflect.Descriptor instead.
-func (*RuntimeStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptResponse.ProtoReflect.Descriptor instead.
+func (*EncryptResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RuntimeStatusResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *RuntimeStatusResponse) GetAppName() string {
+func (x *EncryptResponse) GetData() []byte {
 	if x != nil {
-		return x.AppName
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *RuntimeStatusResponse) GetExe() string {
+func (x *EncryptResponse) GetInfo() string {
 	if x != nil {
-		return x.Exe
+		return x.Info
 	}
 	return ""
 }
 
-func (x *RuntimeStatusResponse) GetAuthStatus() AuthStatus {
-	if x != nil {
-		return x.AuthStatus
-	}
-	return AuthUnknown
-}
-
-func (x *RuntimeStatusResponse) GetSync() bool {
-	if x != nil {
-		return x.Sync
-	}
-	return false
-}
-
-func (x *RuntimeStatusResponse) GetFIDO2() bool {
-	if x != nil {
-		return x.FIDO2
-	}
-	return false
-}
-
-type AuthSetupRequest struct {
+type EncryptFileInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Secret for auth depending on auth type, e.g. password, FIDO2 pin, etc.
-	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	// Type for auth.
-	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
-	// Device path (for FIDO2).
-	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	// In is input file path.
+	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	// Out is output file path.
+	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	// Recipients to encrypt to.
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// Sender, or anonymous.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Archive, if true, encrypts a tar archive of the In directory.
+	Archive bool `protobuf:"varint,5,opt,name=archive,proto3" json:"archive,omitempty"`
+	// Options for encrypt.
+	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *AuthSetupRequest) Reset() {
-	*x = AuthSetupRequest{}
+func (x *EncryptFileInput) Reset() {
+	*x = EncryptFileInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3125,13 +3425,13 @@ func (x *AuthSetupRequest) Reset() {
 	}
 }
 
-func (x *AuthSetupRequest) String() string {
+func (x *EncryptFileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthSetupRequest) ProtoMessage() {}
+func (*EncryptFileInput) ProtoMessage() {}
 
-func (x *AuthSetupRequest) ProtoReflect() protoreflect.Message {
+func (x *EncryptFileInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3143,40 +3443,66 @@ func (x *AuthSetupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthSetupRequest.ProtoReflect.Descriptor instead.
-func (*AuthSetupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptFileInput.ProtoReflect.Descriptor instead.
+func (*EncryptFileInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AuthSetupRequest) GetSecret() string {
+func (x *EncryptFileInput) GetIn() string {
 	if x != nil {
-		return x.Secret
+		return x.In
 	}
 	return ""
 }
 
-func (x *AuthSetupRequest) GetType() AuthType {
+func (x *EncryptFileInput) GetOut() string {
 	if x != nil {
-		return x.Type
+		return x.Out
 	}
-	return UnknownAuth
+	return ""
 }
 
-func (x *AuthSetupRequest) GetDevice() string {
+func (x *EncryptFileInput) GetRecipients() []string {
 	if x != nil {
-		return x.Device
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *EncryptFileInput) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
 	return ""
 }
 
-type AuthSetupResponse struct {
+func (x *EncryptFileInput) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *EncryptFileInput) GetOptions() *EncryptOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type EncryptFileOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Out is the output decrypted file path.
+	Out   string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	Bytes int32  `protobuf:"varint,10,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Total int32  `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *AuthSetupResponse) Reset() {
-	*x = AuthSetupResponse{}
+func (x *EncryptFileOutput) Reset() {
+	*x = EncryptFileOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3184,13 +3510,13 @@ func (x *AuthSetupResponse) Reset() {
 	}
 }
 
-func (x *AuthSetupResponse) String() string {
+func (x *EncryptFileOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthSetupResponse) ProtoMessage() {}
+func (*EncryptFileOutput) ProtoMessage() {}
 
-func (x *AuthSetupResponse) ProtoReflect() protoreflect.Message {
+func (x *EncryptFileOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3202,21 +3528,49 @@ func (x *AuthSetupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthSetupResponse.ProtoReflect.Descriptor instead.
-func (*AuthSetupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptFileOutput.ProtoReflect.Descriptor instead.
+func (*EncryptFileOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{41}
 }
 
-type AuthVaultRequest struct {
+func (x *EncryptFileOutput) GetOut() string {
+	if x != nil {
+		return x.Out
+	}
+	return ""
+}
+
+func (x *EncryptFileOutput) GetBytes() int32 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *EncryptFileOutput) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type EncryptInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	// Data to encrypt. Send empty byte slice as last message.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Recipients to encrypt to.
+	Recipients []string `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// Sender, or anonymous.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Options for encrypt.
+	Options *EncryptOptions `protobuf:"bytes,10,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *AuthVaultRequest) Reset() {
-	*x = AuthVaultRequest{}
+func (x *EncryptInput) Reset() {
+	*x = EncryptInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,13 +3578,13 @@ func (x *AuthVaultRequest) Reset() {
 	}
 }
 
-func (x *AuthVaultRequest) String() string {
+func (x *EncryptInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthVaultRequest) ProtoMessage() {}
+func (*EncryptInput) ProtoMessage() {}
 
-func (x *AuthVaultRequest) ProtoReflect() protoreflect.Message {
+func (x *EncryptInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3242,26 +3596,50 @@ func (x *AuthVaultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthVaultRequest.ProtoReflect.Descriptor instead.
-func (*AuthVaultRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptInput.ProtoReflect.Descriptor instead.
+func (*EncryptInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AuthVaultRequest) GetPhrase() string {
+func (x *EncryptInput) GetData() []byte {
 	if x != nil {
-		return x.Phrase
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EncryptInput) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *EncryptInput) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
 	return ""
 }
 
-type AuthVaultResponse struct {
+func (x *EncryptInput) GetOptions() *EncryptOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type EncryptOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Data, encrypted.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *AuthVaultResponse) Reset() {
-	*x = AuthVaultResponse{}
+func (x *EncryptOutput) Reset() {
+	*x = EncryptOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3269,13 +3647,13 @@ func (x *AuthVaultResponse) Reset() {
 	}
 }
 
-func (x *AuthVaultResponse) String() string {
+func (x *EncryptOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthVaultResponse) ProtoMessage() {}
+func (*EncryptOutput) ProtoMessage() {}
 
-func (x *AuthVaultResponse) ProtoReflect() protoreflect.Message {
+func (x *EncryptOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3287,26 +3665,29 @@ func (x *AuthVaultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthVaultResponse.ProtoReflect.Descriptor instead.
-func (*AuthVaultResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EncryptOutput.ProtoReflect.Descriptor instead.
+func (*EncryptOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{43}
 }
 
-type AuthUnlockRequest struct {
+func (x *EncryptOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DecryptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Secret for auth depending on auth type, e.g. password, pin, etc.
-	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	// Type for auth.
-	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
-	// Client name.
-	Client string `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	// Data to decrypt.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *AuthUnlockRequest) Reset() {
-	*x = AuthUnlockRequest{}
+func (x *DecryptRequest) Reset() {
+	*x = DecryptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3314,13 +3695,13 @@ func (x *AuthUnlockRequest) Reset() {
 	}
 }
 
-func (x *AuthUnlockRequest) String() string {
+func (x *DecryptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthUnlockRequest) ProtoMessage() {}
+func (*DecryptRequest) ProtoMessage() {}
 
-func (x *AuthUnlockRequest) ProtoReflect() protoreflect.Message {
+func (x *DecryptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3332,43 +3713,33 @@ func (x *AuthUnlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthUnlockRequest.ProtoReflect.Descriptor instead.
-func (*AuthUnlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptRequest.ProtoReflect.Descriptor instead.
+func (*DecryptRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AuthUnlockRequest) GetSecret() string {
-	if x != nil {
-		return x.Secret
-	}
-	return ""
-}
-
-func (x *AuthUnlockRequest) GetType() AuthType {
-	if x != nil {
-		return x.Type
-	}
-	return UnknownAuth
-}
-
-func (x *AuthUnlockRequest) GetClient() string {
+func (x *DecryptRequest) GetData() []byte {
 	if x != nil {
-		return x.Client
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type AuthUnlockResponse struct {
+type DecryptResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// AuthToken to use for requests.
-	AuthToken string `protobuf:"bytes,1,opt,name=authToken,proto3" json:"authToken,omitempty"`
+	// Data decrypted.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Sender (if not anonymous)
+	Sender *Key `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Mode is the encryption mode for the data.
+	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
 }
 
-func (x *AuthUnlockResponse) Reset() {
-	*x = AuthUnlockResponse{}
+func (x *DecryptResponse) Reset() {
+	*x = DecryptResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3376,13 +3747,13 @@ func (x *AuthUnlockResponse) Reset() {
 	}
 }
 
-func (x *AuthUnlockResponse) String() string {
+func (x *DecryptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthUnlockResponse) ProtoMessage() {}
+func (*DecryptResponse) ProtoMessage() {}
 
-func (x *AuthUnlockResponse) ProtoReflect() protoreflect.Message {
+func (x *DecryptResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3394,35 +3765,43 @@ func (x *AuthUnlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthUnlockResponse.ProtoReflect.Descriptor instead.
-func (*AuthUnlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptResponse.ProtoReflect.Descriptor instead.
+func (*DecryptResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AuthUnlockResponse) GetAuthToken() string {
+func (x *DecryptResponse) GetData() []byte {
 	if x != nil {
-		return x.AuthToken
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type AuthProvisionRequest struct {
+func (x *DecryptResponse) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *DecryptResponse) GetMode() EncryptMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DefaultEncrypt
+}
+
+type DecryptInspectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Secret for auth depending on auth type, e.g. password, phrase, FIDO2 pin, etc.
-	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	// Type for auth.
-	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
-	// Device path (for FIDO2).
-	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
-	// Generate (for FIDO2 make credential).
-	Generate bool `protobuf:"varint,7,opt,name=generate,proto3" json:"generate,omitempty"`
+	// Data is the encrypted message (or at least its header).
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *AuthProvisionRequest) Reset() {
-	*x = AuthProvisionRequest{}
+func (x *DecryptInspectRequest) Reset() {
+	*x = DecryptInspectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3430,13 +3809,13 @@ func (x *AuthProvisionRequest) Reset() {
 	}
 }
 
-func (x *AuthProvisionRequest) String() string {
+func (x *DecryptInspectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionRequest) ProtoMessage() {}
+func (*DecryptInspectRequest) ProtoMessage() {}
 
-func (x *AuthProvisionRequest) ProtoReflect() protoreflect.Message {
+func (x *DecryptInspectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3448,49 +3827,40 @@ func (x *AuthProvisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionRequest.ProtoReflect.Descriptor instead.
-func (*AuthProvisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptInspectRequest.ProtoReflect.Descriptor instead.
+func (*DecryptInspectRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AuthProvisionRequest) GetSecret() string {
-	if x != nil {
-		return x.Secret
-	}
-	return ""
-}
-
-func (x *AuthProvisionRequest) GetType() AuthType {
-	if x != nil {
-		return x.Type
-	}
-	return UnknownAuth
-}
-
-func (x *AuthProvisionRequest) GetDevice() string {
-	if x != nil {
-		return x.Device
-	}
-	return ""
-}
-
-func (x *AuthProvisionRequest) GetGenerate() bool {
+func (x *DecryptInspectRequest) GetData() []byte {
 	if x != nil {
-		return x.Generate
+		return x.Data
 	}
-	return false
+	return nil
 }
 
-type AuthProvisionResponse struct {
+type DecryptInspectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provision *AuthProvision `protobuf:"bytes,1,opt,name=provision,proto3" json:"provision,omitempty"`
-}
-
-func (x *AuthProvisionResponse) Reset() {
-	*x = AuthProvisionResponse{}
+	// Mode is the encryption mode for the data.
+	Mode    EncryptMode `protobuf:"varint,1,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
+	Armored bool        `protobuf:"varint,2,opt,name=armored,proto3" json:"armored,omitempty"`
+	// Recipients with visible keys.
+	Recipients []*Key `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// AnonymousRecipients is the number of recipients with hidden keys.
+	AnonymousRecipients int32 `protobuf:"varint,4,opt,name=anonymousRecipients,proto3" json:"anonymousRecipients,omitempty"`
+	// CanOpen is true if we have a key that can decrypt the message.
+	CanOpen bool `protobuf:"varint,5,opt,name=canOpen,proto3" json:"canOpen,omitempty"`
+	// Sender (if we can open it and not anonymous)
+	Sender *Key `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Recipient is our key that can open the message.
+	Recipient *Key `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (x *DecryptInspectResponse) Reset() {
+	*x = DecryptInspectResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3498,13 +3868,13 @@ func (x *AuthProvisionResponse) Reset() {
 	}
 }
 
-func (x *AuthProvisionResponse) String() string {
+func (x *DecryptInspectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionResponse) ProtoMessage() {}
+func (*DecryptInspectResponse) ProtoMessage() {}
 
-func (x *AuthProvisionResponse) ProtoReflect() protoreflect.Message {
+func (x *DecryptInspectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3516,28 +3886,76 @@ func (x *AuthProvisionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionResponse.ProtoReflect.Descriptor instead.
-func (*AuthProvisionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptInspectResponse.ProtoReflect.Descriptor instead.
+func (*DecryptInspectResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *AuthProvisionResponse) GetProvision() *AuthProvision {
+func (x *DecryptInspectResponse) GetMode() EncryptMode {
 	if x != nil {
-		return x.Provision
+		return x.Mode
+	}
+	return DefaultEncrypt
+}
+
+func (x *DecryptInspectResponse) GetArmored() bool {
+	if x != nil {
+		return x.Armored
+	}
+	return false
+}
+
+func (x *DecryptInspectResponse) GetRecipients() []*Key {
+	if x != nil {
+		return x.Recipients
 	}
 	return nil
 }
 
-type AuthDeprovisionRequest struct {
+func (x *DecryptInspectResponse) GetAnonymousRecipients() int32 {
+	if x != nil {
+		return x.AnonymousRecipients
+	}
+	return 0
+}
+
+func (x *DecryptInspectResponse) GetCanOpen() bool {
+	if x != nil {
+		return x.CanOpen
+	}
+	return false
+}
+
+func (x *DecryptInspectResponse) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *DecryptInspectResponse) GetRecipient() *Key {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+type DecryptFileInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// In is the input encrypted file path.
+	In string `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	// Out is the output file name or directory to save to.
+	Out string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	// Archive, if true, extracts the decrypted tar archive to the Out
+	// directory.
+	Archive bool `protobuf:"varint,3,opt,name=archive,proto3" json:"archive,omitempty"`
 }
 
-func (x *AuthDeprovisionRequest) Reset() {
-	*x = AuthDeprovisionRequest{}
+func (x *DecryptFileInput) Reset() {
+	*x = DecryptFileInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3545,13 +3963,13 @@ func (x *AuthDeprovisionRequest) Reset() {
 	}
 }
 
-func (x *AuthDeprovisionRequest) String() string {
+func (x *DecryptFileInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthDeprovisionRequest) ProtoMessage() {}
+func (*DecryptFileInput) ProtoMessage() {}
 
-func (x *AuthDeprovisionRequest) ProtoReflect() protoreflect.Message {
+func (x *DecryptFileInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3563,26 +3981,48 @@ func (x *AuthDeprovisionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthDeprovisionRequest.ProtoReflect.Descriptor instead.
-func (*AuthDeprovisionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptFileInput.ProtoReflect.Descriptor instead.
+func (*DecryptFileInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AuthDeprovisionRequest) GetID() string {
+func (x *DecryptFileInput) GetIn() string {
 	if x != nil {
-		return x.ID
+		return x.In
 	}
 	return ""
 }
 
-type AuthDeprovisionResponse struct {
+func (x *DecryptFileInput) GetOut() string {
+	if x != nil {
+		return x.Out
+	}
+	return ""
+}
+
+func (x *DecryptFileInput) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+type DecryptFileOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Sender *Key `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Out is the output file path.
+	Out   string `protobuf:"bytes,2,opt,name=out,proto3" json:"out,omitempty"`
+	Bytes int32  `protobuf:"varint,10,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Total int32  `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`
+	// Mode is the encryption mode we detected.
+	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
 }
 
-func (x *AuthDeprovisionResponse) Reset() {
-	*x = AuthDeprovisionResponse{}
+func (x *DecryptFileOutput) Reset() {
+	*x = DecryptFileOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3590,13 +4030,13 @@ func (x *AuthDeprovisionResponse) Reset() {
 	}
 }
 
-func (x *AuthDeprovisionResponse) String() string {
+func (x *DecryptFileOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthDeprovisionResponse) ProtoMessage() {}
+func (*DecryptFileOutput) ProtoMessage() {}
 
-func (x *AuthDeprovisionResponse) ProtoReflect() protoreflect.Message {
+func (x *DecryptFileOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3608,22 +4048,57 @@ func (x *AuthDeprovisionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthDeprovisionResponse.ProtoReflect.Descriptor instead.
-func (*AuthDeprovisionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptFileOutput.ProtoReflect.Descriptor instead.
+func (*DecryptFileOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{49}
 }
 
-type AuthPasswordChangeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *DecryptFileOutput) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *DecryptFileOutput) GetOut() string {
+	if x != nil {
+		return x.Out
+	}
+	return ""
+}
+
+func (x *DecryptFileOutput) GetBytes() int32 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *DecryptFileOutput) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DecryptFileOutput) GetMode() EncryptMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DefaultEncrypt
+}
+
+type DecryptInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
-	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	// Data, encrypted.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *AuthPasswordChangeRequest) Reset() {
-	*x = AuthPasswordChangeRequest{}
+func (x *DecryptInput) Reset() {
+	*x = DecryptInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3631,13 +4106,13 @@ func (x *AuthPasswordChangeRequest) Reset() {
 	}
 }
 
-func (x *AuthPasswordChangeRequest) String() string {
+func (x *DecryptInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthPasswordChangeRequest) ProtoMessage() {}
+func (*DecryptInput) ProtoMessage() {}
 
-func (x *AuthPasswordChangeRequest) ProtoReflect() protoreflect.Message {
+func (x *DecryptInput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3649,33 +4124,33 @@ func (x *AuthPasswordChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthPasswordChangeRequest.ProtoReflect.Descriptor instead.
-func (*AuthPasswordChangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptInput.ProtoReflect.Descriptor instead.
+func (*DecryptInput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AuthPasswordChangeRequest) GetOld() string {
-	if x != nil {
-		return x.Old
-	}
-	return ""
-}
-
-func (x *AuthPasswordChangeRequest) GetNew() string {
+func (x *DecryptInput) GetData() []byte {
 	if x != nil {
-		return x.New
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type AuthPasswordChangeResponse struct {
+type DecryptOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Data, decrypted. If empty, is EOF.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Sender (if not anonymous)
+	Sender *Key `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Mode is the encryption mode for the data.
+	Mode EncryptMode `protobuf:"varint,5,opt,name=mode,proto3,enum=service.EncryptMode" json:"mode,omitempty"`
 }
 
-func (x *AuthPasswordChangeResponse) Reset() {
-	*x = AuthPasswordChangeResponse{}
+func (x *DecryptOutput) Reset() {
+	*x = DecryptOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3683,13 +4158,13 @@ func (x *AuthPasswordChangeResponse) Reset() {
 	}
 }
 
-func (x *AuthPasswordChangeResponse) String() string {
+func (x *DecryptOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthPasswordChangeResponse) ProtoMessage() {}
+func (*DecryptOutput) ProtoMessage() {}
 
-func (x *AuthPasswordChangeResponse) ProtoReflect() protoreflect.Message {
+func (x *DecryptOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3701,27 +4176,40 @@ func (x *AuthPasswordChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthPasswordChangeResponse.ProtoReflect.Descriptor instead.
-func (*AuthPasswordChangeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecryptOutput.ProtoReflect.Descriptor instead.
+func (*DecryptOutput) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{51}
 }
 
-type AuthProvision struct {
+func (x *DecryptOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DecryptOutput) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *DecryptOutput) GetMode() EncryptMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DefaultEncrypt
+}
+
+type RuntimeStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type      AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
-	CreatedAt int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	// For FIDO2
-	// AAGUID is a device "identifier" (only unique across batches for privacy reasons).
-	AAGUID string `protobuf:"bytes,100,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
-	NoPin  bool   `protobuf:"varint,101,opt,name=noPin,proto3" json:"noPin,omitempty"`
 }
 
-func (x *AuthProvision) Reset() {
-	*x = AuthProvision{}
+func (x *RuntimeStatusRequest) Reset() {
+	*x = RuntimeStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3729,13 +4217,13 @@ func (x *AuthProvision) Reset() {
 	}
 }
 
-func (x *AuthProvision) String() string {
+func (x *RuntimeStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvision) ProtoMessage() {}
+func (*RuntimeStatusRequest) ProtoMessage() {}
 
-func (x *AuthProvision) ProtoReflect() protoreflect.Message {
+func (x *RuntimeStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3747,54 +4235,34 @@ func (x *AuthProvision) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvision.ProtoReflect.Descriptor instead.
-func (*AuthProvision) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuntimeStatusRequest.ProtoReflect.Descriptor instead.
+func (*RuntimeStatusRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AuthProvision) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *AuthProvision) GetType() AuthType {
-	if x != nil {
-		return x.Type
-	}
-	return UnknownAuth
-}
-
-func (x *AuthProvision) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *AuthProvision) GetAAGUID() string {
-	if x != nil {
-		return x.AAGUID
-	}
-	return ""
-}
-
-func (x *AuthProvision) GetNoPin() bool {
-	if x != nil {
-		return x.NoPin
-	}
-	return false
-}
-
-type AuthProvisionsRequest struct {
+type RuntimeStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Version of running service.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// AppName app name.
+	AppName string `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
+	// Exe is the service executable path.
+	Exe string `protobuf:"bytes,4,opt,name=exe,proto3" json:"exe,omitempty"`
+	// AuthStatus is the status of auth.
+	AuthStatus AuthStatus `protobuf:"varint,5,opt,name=authStatus,proto3,enum=service.AuthStatus" json:"authStatus,omitempty"`
+	// SyncStatus is the status of vault sync.
+	Sync bool `protobuf:"varint,6,opt,name=sync,proto3" json:"sync,omitempty"`
+	// FIDO2 available.
+	FIDO2 bool `protobuf:"varint,20,opt,name=fido2,proto3" json:"fido2,omitempty"`
+	// KeyExpiries are keys that expired or will expire soon (if unlocked).
+	KeyExpiries []*KeyExpiry `protobuf:"bytes,30,rep,name=keyExpiries,proto3" json:"keyExpiries,omitempty"`
 }
 
-func (x *AuthProvisionsRequest) Reset() {
-	*x = AuthProvisionsRequest{}
+func (x *RuntimeStatusResponse) Reset() {
+	*x = RuntimeStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3802,13 +4270,13 @@ func (x *AuthProvisionsRequest) Reset() {
 	}
 }
 
-func (x *AuthProvisionsRequest) String() string {
+func (x *RuntimeStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionsRequest) ProtoMessage() {}
+func (*RuntimeStatusResponse) ProtoMessage() {}
 
-func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
+func (x *RuntimeStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3820,21 +4288,73 @@ func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionsRequest.ProtoReflect.Descriptor instead.
-func (*AuthProvisionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuntimeStatusResponse.ProtoReflect.Descriptor instead.
+func (*RuntimeStatusResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{53}
 }
 
-type AuthProvisionsResponse struct {
+func (x *RuntimeStatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RuntimeStatusResponse) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *RuntimeStatusResponse) GetExe() string {
+	if x != nil {
+		return x.Exe
+	}
+	return ""
+}
+
+func (x *RuntimeStatusResponse) GetAuthStatus() AuthStatus {
+	if x != nil {
+		return x.AuthStatus
+	}
+	return AuthUnknown
+}
+
+func (x *RuntimeStatusResponse) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *RuntimeStatusResponse) GetFIDO2() bool {
+	if x != nil {
+		return x.FIDO2
+	}
+	return false
+}
+
+func (x *RuntimeStatusResponse) GetKeyExpiries() []*KeyExpiry {
+	if x != nil {
+		return x.KeyExpiries
+	}
+	return nil
+}
+
+type KeyExpiry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provisions []*AuthProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions,omitempty"`
+	KID       string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Label     string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Expired   bool   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
 }
 
-func (x *AuthProvisionsResponse) Reset() {
-	*x = AuthProvisionsResponse{}
+func (x *KeyExpiry) Reset() {
+	*x = KeyExpiry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3842,13 +4362,13 @@ func (x *AuthProvisionsResponse) Reset() {
 	}
 }
 
-func (x *AuthProvisionsResponse) String() string {
+func (x *KeyExpiry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthProvisionsResponse) ProtoMessage() {}
+func (*KeyExpiry) ProtoMessage() {}
 
-func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyExpiry) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3860,26 +4380,54 @@ func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthProvisionsResponse.ProtoReflect.Descriptor instead.
-func (*AuthProvisionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyExpiry.ProtoReflect.Descriptor instead.
+func (*KeyExpiry) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AuthProvisionsResponse) GetProvisions() []*AuthProvision {
+func (x *KeyExpiry) GetKID() string {
 	if x != nil {
-		return x.Provisions
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type AuthLockRequest struct {
+func (x *KeyExpiry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *KeyExpiry) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *KeyExpiry) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type AuthSetupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Secret for auth depending on auth type, e.g. password, FIDO2 pin, etc.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Type for auth.
+	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Device path (for FIDO2).
+	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
 }
 
-func (x *AuthLockRequest) Reset() {
-	*x = AuthLockRequest{}
+func (x *AuthSetupRequest) Reset() {
+	*x = AuthSetupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3887,13 +4435,13 @@ func (x *AuthLockRequest) Reset() {
 	}
 }
 
-func (x *AuthLockRequest) String() string {
+func (x *AuthSetupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthLockRequest) ProtoMessage() {}
+func (*AuthSetupRequest) ProtoMessage() {}
 
-func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthSetupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3905,19 +4453,40 @@ func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthLockRequest.ProtoReflect.Descriptor instead.
-func (*AuthLockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthSetupRequest.ProtoReflect.Descriptor instead.
+func (*AuthSetupRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{55}
 }
 
-type AuthLockResponse struct {
+func (x *AuthSetupRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AuthSetupRequest) GetType() AuthType {
+	if x != nil {
+		return x.Type
+	}
+	return UnknownAuth
+}
+
+func (x *AuthSetupRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type AuthSetupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AuthLockResponse) Reset() {
-	*x = AuthLockResponse{}
+func (x *AuthSetupResponse) Reset() {
+	*x = AuthSetupResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3925,13 +4494,13 @@ func (x *AuthLockResponse) Reset() {
 	}
 }
 
-func (x *AuthLockResponse) String() string {
+func (x *AuthSetupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthLockResponse) ProtoMessage() {}
+func (*AuthSetupResponse) ProtoMessage() {}
 
-func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthSetupResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3943,21 +4512,21 @@ func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthLockResponse.ProtoReflect.Descriptor instead.
-func (*AuthLockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthSetupResponse.ProtoReflect.Descriptor instead.
+func (*AuthSetupResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{56}
 }
 
-type AuthResetRequest struct {
+type AuthVaultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppName string `protobuf:"bytes,1,opt,name=appName,proto3" json:"appName,omitempty"`
+	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
 }
 
-func (x *AuthResetRequest) Reset() {
-	*x = AuthResetRequest{}
+func (x *AuthVaultRequest) Reset() {
+	*x = AuthVaultRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3965,13 +4534,13 @@ func (x *AuthResetRequest) Reset() {
 	}
 }
 
-func (x *AuthResetRequest) String() string {
+func (x *AuthVaultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResetRequest) ProtoMessage() {}
+func (*AuthVaultRequest) ProtoMessage() {}
 
-func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthVaultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3983,26 +4552,26 @@ func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResetRequest.ProtoReflect.Descriptor instead.
-func (*AuthResetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthVaultRequest.ProtoReflect.Descriptor instead.
+func (*AuthVaultRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AuthResetRequest) GetAppName() string {
+func (x *AuthVaultRequest) GetPhrase() string {
 	if x != nil {
-		return x.AppName
+		return x.Phrase
 	}
 	return ""
 }
 
-type AuthResetResponse struct {
+type AuthVaultResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AuthResetResponse) Reset() {
-	*x = AuthResetResponse{}
+func (x *AuthVaultResponse) Reset() {
+	*x = AuthVaultResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4010,13 +4579,13 @@ func (x *AuthResetResponse) Reset() {
 	}
 }
 
-func (x *AuthResetResponse) String() string {
+func (x *AuthVaultResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResetResponse) ProtoMessage() {}
+func (*AuthVaultResponse) ProtoMessage() {}
 
-func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthVaultResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4028,22 +4597,26 @@ func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResetResponse.ProtoReflect.Descriptor instead.
-func (*AuthResetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthVaultResponse.ProtoReflect.Descriptor instead.
+func (*AuthVaultResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{58}
 }
 
-type AuthRecoverRequest struct {
+type AuthUnlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PaperKey    string `protobuf:"bytes,1,opt,name=paperKey,proto3" json:"paperKey,omitempty"`
-	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// Secret for auth depending on auth type, e.g. password, pin, etc.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Type for auth.
+	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Client name.
+	Client string `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *AuthRecoverRequest) Reset() {
-	*x = AuthRecoverRequest{}
+func (x *AuthUnlockRequest) Reset() {
+	*x = AuthUnlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4051,13 +4624,13 @@ func (x *AuthRecoverRequest) Reset() {
 	}
 }
 
-func (x *AuthRecoverRequest) String() string {
+func (x *AuthUnlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRecoverRequest) ProtoMessage() {}
+func (*AuthUnlockRequest) ProtoMessage() {}
 
-func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthUnlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4069,35 +4642,43 @@ func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRecoverRequest.ProtoReflect.Descriptor instead.
-func (*AuthRecoverRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthUnlockRequest.ProtoReflect.Descriptor instead.
+func (*AuthUnlockRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AuthRecoverRequest) GetPaperKey() string {
+func (x *AuthUnlockRequest) GetSecret() string {
 	if x != nil {
-		return x.PaperKey
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *AuthRecoverRequest) GetNewPassword() string {
+func (x *AuthUnlockRequest) GetType() AuthType {
 	if x != nil {
-		return x.NewPassword
+		return x.Type
+	}
+	return UnknownAuth
+}
+
+func (x *AuthUnlockRequest) GetClient() string {
+	if x != nil {
+		return x.Client
 	}
 	return ""
 }
 
-type AuthRecoverResponse struct {
+type AuthUnlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// AuthToken to use for requests.
 	AuthToken string `protobuf:"bytes,1,opt,name=authToken,proto3" json:"authToken,omitempty"`
 }
 
-func (x *AuthRecoverResponse) Reset() {
-	*x = AuthRecoverResponse{}
+func (x *AuthUnlockResponse) Reset() {
+	*x = AuthUnlockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4105,13 +4686,13 @@ func (x *AuthRecoverResponse) Reset() {
 	}
 }
 
-func (x *AuthRecoverResponse) String() string {
+func (x *AuthUnlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRecoverResponse) ProtoMessage() {}
+func (*AuthUnlockResponse) ProtoMessage() {}
 
-func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthUnlockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4123,28 +4704,35 @@ func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRecoverResponse.ProtoReflect.Descriptor instead.
-func (*AuthRecoverResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthUnlockResponse.ProtoReflect.Descriptor instead.
+func (*AuthUnlockResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *AuthRecoverResponse) GetAuthToken() string {
+func (x *AuthUnlockResponse) GetAuthToken() string {
 	if x != nil {
 		return x.AuthToken
 	}
 	return ""
 }
 
-type KeyGenerateRequest struct {
+type AuthProvisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Secret for auth depending on auth type, e.g. password, phrase, FIDO2 pin, etc.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Type for auth.
+	Type AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	// Device path (for FIDO2).
+	Device string `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	// Generate (for FIDO2 make credential).
+	Generate bool `protobuf:"varint,7,opt,name=generate,proto3" json:"generate,omitempty"`
 }
 
-func (x *KeyGenerateRequest) Reset() {
-	*x = KeyGenerateRequest{}
+func (x *AuthProvisionRequest) Reset() {
+	*x = AuthProvisionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4152,13 +4740,13 @@ func (x *KeyGenerateRequest) Reset() {
 	}
 }
 
-func (x *KeyGenerateRequest) String() string {
+func (x *AuthProvisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyGenerateRequest) ProtoMessage() {}
+func (*AuthProvisionRequest) ProtoMessage() {}
 
-func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthProvisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4170,28 +4758,49 @@ func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyGenerateRequest.ProtoReflect.Descriptor instead.
-func (*KeyGenerateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionRequest.ProtoReflect.Descriptor instead.
+func (*AuthProvisionRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *KeyGenerateRequest) GetType() string {
+func (x *AuthProvisionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AuthProvisionRequest) GetType() AuthType {
 	if x != nil {
 		return x.Type
 	}
+	return UnknownAuth
+}
+
+func (x *AuthProvisionRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
 	return ""
 }
 
-type KeyGenerateResponse struct {
+func (x *AuthProvisionRequest) GetGenerate() bool {
+	if x != nil {
+		return x.Generate
+	}
+	return false
+}
+
+type AuthProvisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Provision *AuthProvision `protobuf:"bytes,1,opt,name=provision,proto3" json:"provision,omitempty"`
 }
 
-func (x *KeyGenerateResponse) Reset() {
-	*x = KeyGenerateResponse{}
+func (x *AuthProvisionResponse) Reset() {
+	*x = AuthProvisionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4199,13 +4808,13 @@ func (x *KeyGenerateResponse) Reset() {
 	}
 }
 
-func (x *KeyGenerateResponse) String() string {
+func (x *AuthProvisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyGenerateResponse) ProtoMessage() {}
+func (*AuthProvisionResponse) ProtoMessage() {}
 
-func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthProvisionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4217,31 +4826,28 @@ func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyGenerateResponse.ProtoReflect.Descriptor instead.
-func (*KeyGenerateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionResponse.ProtoReflect.Descriptor instead.
+func (*AuthProvisionResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *KeyGenerateResponse) GetKID() string {
+func (x *AuthProvisionResponse) GetProvision() *AuthProvision {
 	if x != nil {
-		return x.KID
+		return x.Provision
 	}
-	return ""
+	return nil
 }
 
-type UserServiceRequest struct {
+type AuthDeprovisionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UserServiceRequest) Reset() {
-	*x = UserServiceRequest{}
+func (x *AuthDeprovisionRequest) Reset() {
+	*x = AuthDeprovisionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4249,13 +4855,13 @@ func (x *UserServiceRequest) Reset() {
 	}
 }
 
-func (x *UserServiceRequest) String() string {
+func (x *AuthDeprovisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserServiceRequest) ProtoMessage() {}
+func (*AuthDeprovisionRequest) ProtoMessage() {}
 
-func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthDeprovisionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4267,36 +4873,26 @@ func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserServiceRequest.ProtoReflect.Descriptor instead.
-func (*UserServiceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthDeprovisionRequest.ProtoReflect.Descriptor instead.
+func (*AuthDeprovisionRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UserServiceRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *UserServiceRequest) GetService() string {
+func (x *AuthDeprovisionRequest) GetID() string {
 	if x != nil {
-		return x.Service
+		return x.ID
 	}
 	return ""
 }
 
-type UserServiceResponse struct {
+type AuthDeprovisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *UserServiceResponse) Reset() {
-	*x = UserServiceResponse{}
+func (x *AuthDeprovisionResponse) Reset() {
+	*x = AuthDeprovisionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4304,13 +4900,13 @@ func (x *UserServiceResponse) Reset() {
 	}
 }
 
-func (x *UserServiceResponse) String() string {
+func (x *AuthDeprovisionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserServiceResponse) ProtoMessage() {}
+func (*AuthDeprovisionResponse) ProtoMessage() {}
 
-func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthDeprovisionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4322,33 +4918,22 @@ func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserServiceResponse.ProtoReflect.Descriptor instead.
-func (*UserServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthDeprovisionResponse.ProtoReflect.Descriptor instead.
+func (*AuthDeprovisionResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UserServiceResponse) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
-}
-
-type UserSignRequest struct {
+type AuthPasswordChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	// Name is username on the service.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
 }
 
-func (x *UserSignRequest) Reset() {
-	*x = UserSignRequest{}
+func (x *AuthPasswordChangeRequest) Reset() {
+	*x = AuthPasswordChangeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4356,13 +4941,13 @@ func (x *UserSignRequest) Reset() {
 	}
 }
 
-func (x *UserSignRequest) String() string {
+func (x *AuthPasswordChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSignRequest) ProtoMessage() {}
+func (*AuthPasswordChangeRequest) ProtoMessage() {}
 
-func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthPasswordChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4374,45 +4959,33 @@ func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSignRequest.ProtoReflect.Descriptor instead.
-func (*UserSignRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthPasswordChangeRequest.ProtoReflect.Descriptor instead.
+func (*AuthPasswordChangeRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UserSignRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *UserSignRequest) GetService() string {
+func (x *AuthPasswordChangeRequest) GetOld() string {
 	if x != nil {
-		return x.Service
+		return x.Old
 	}
 	return ""
 }
 
-func (x *UserSignRequest) GetName() string {
+func (x *AuthPasswordChangeRequest) GetNew() string {
 	if x != nil {
-		return x.Name
+		return x.New
 	}
 	return ""
 }
 
-type UserSignResponse struct {
+type AuthPasswordChangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Message is signed message.
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	// Name in request.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UserSignResponse) Reset() {
-	*x = UserSignResponse{}
+func (x *AuthPasswordChangeResponse) Reset() {
+	*x = AuthPasswordChangeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4420,13 +4993,13 @@ func (x *UserSignResponse) Reset() {
 	}
 }
 
-func (x *UserSignResponse) String() string {
+func (x *AuthPasswordChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSignResponse) ProtoMessage() {}
+func (*AuthPasswordChangeResponse) ProtoMessage() {}
 
-func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthPasswordChangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4438,44 +5011,27 @@ func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSignResponse.ProtoReflect.Descriptor instead.
-func (*UserSignResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthPasswordChangeResponse.ProtoReflect.Descriptor instead.
+func (*AuthPasswordChangeResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UserSignResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *UserSignResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type UserAddRequest struct {
+type AuthProvision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// KID to use, or if empty the current key.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	// Service such as twitter, github.
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	// Name is username on the service.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// URL is location of signed message on the service.
-	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	// Local, if true, won't save to the current key server.
-	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
+	ID        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      AuthType `protobuf:"varint,2,opt,name=type,proto3,enum=service.AuthType" json:"type,omitempty"`
+	CreatedAt int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// For FIDO2
+	// AAGUID is a device "identifier" (only unique across batches for privacy reasons).
+	AAGUID string `protobuf:"bytes,100,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	NoPin  bool   `protobuf:"varint,101,opt,name=noPin,proto3" json:"noPin,omitempty"`
 }
 
-func (x *UserAddRequest) Reset() {
-	*x = UserAddRequest{}
+func (x *AuthProvision) Reset() {
+	*x = AuthProvision{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4483,13 +5039,13 @@ func (x *UserAddRequest) Reset() {
 	}
 }
 
-func (x *UserAddRequest) String() string {
+func (x *AuthProvision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAddRequest) ProtoMessage() {}
+func (*AuthProvision) ProtoMessage() {}
 
-func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthProvision) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4501,57 +5057,54 @@ func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAddRequest.ProtoReflect.Descriptor instead.
-func (*UserAddRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvision.ProtoReflect.Descriptor instead.
+func (*AuthProvision) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UserAddRequest) GetKID() string {
+func (x *AuthProvision) GetID() string {
 	if x != nil {
-		return x.KID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *UserAddRequest) GetService() string {
+func (x *AuthProvision) GetType() AuthType {
 	if x != nil {
-		return x.Service
+		return x.Type
 	}
-	return ""
+	return UnknownAuth
 }
 
-func (x *UserAddRequest) GetName() string {
+func (x *AuthProvision) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Name
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *UserAddRequest) GetURL() string {
+func (x *AuthProvision) GetAAGUID() string {
 	if x != nil {
-		return x.URL
+		return x.AAGUID
 	}
 	return ""
 }
 
-func (x *UserAddRequest) GetLocal() bool {
+func (x *AuthProvision) GetNoPin() bool {
 	if x != nil {
-		return x.Local
+		return x.NoPin
 	}
 	return false
 }
 
-type UserAddResponse struct {
+type AuthProvisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	User      *User      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Statement *Statement `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *UserAddResponse) Reset() {
-	*x = UserAddResponse{}
+func (x *AuthProvisionsRequest) Reset() {
+	*x = AuthProvisionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4559,13 +5112,13 @@ func (x *UserAddResponse) Reset() {
 	}
 }
 
-func (x *UserAddResponse) String() string {
+func (x *AuthProvisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAddResponse) ProtoMessage() {}
+func (*AuthProvisionsRequest) ProtoMessage() {}
 
-func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthProvisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4577,39 +5130,21 @@ func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAddResponse.ProtoReflect.Descriptor instead.
-func (*UserAddResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionsRequest.ProtoReflect.Descriptor instead.
+func (*AuthProvisionsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserAddResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-func (x *UserAddResponse) GetStatement() *Statement {
-	if x != nil {
-		return x.Statement
-	}
-	return nil
-}
-
-type KeyExportRequest struct {
+type AuthProvisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID        string     `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	Password   string     `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Type       ExportType `protobuf:"varint,3,opt,name=type,proto3,enum=service.ExportType" json:"type,omitempty"`
-	Public     bool       `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
-	NoPassword bool       `protobuf:"varint,5,opt,name=noPassword,proto3" json:"noPassword,omitempty"`
+	Provisions []*AuthProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions,omitempty"`
 }
 
-func (x *KeyExportRequest) Reset() {
-	*x = KeyExportRequest{}
+func (x *AuthProvisionsResponse) Reset() {
+	*x = AuthProvisionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4617,13 +5152,13 @@ func (x *KeyExportRequest) Reset() {
 	}
 }
 
-func (x *KeyExportRequest) String() string {
+func (x *AuthProvisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyExportRequest) ProtoMessage() {}
+func (*AuthProvisionsResponse) ProtoMessage() {}
 
-func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthProvisionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4635,70 +5170,40 @@ func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyExportRequest.ProtoReflect.Descriptor instead.
-func (*KeyExportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthProvisionsResponse.ProtoReflect.Descriptor instead.
+func (*AuthProvisionsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *KeyExportRequest) GetKID() string {
+func (x *AuthProvisionsResponse) GetProvisions() []*AuthProvision {
 	if x != nil {
-		return x.KID
+		return x.Provisions
 	}
-	return ""
+	return nil
 }
 
-func (x *KeyExportRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+type AuthLockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *KeyExportRequest) GetType() ExportType {
-	if x != nil {
-		return x.Type
-	}
-	return DefaultExport
-}
-
-func (x *KeyExportRequest) GetPublic() bool {
-	if x != nil {
-		return x.Public
-	}
-	return false
-}
-
-func (x *KeyExportRequest) GetNoPassword() bool {
-	if x != nil {
-		return x.NoPassword
-	}
-	return false
-}
-
-type KeyExportResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Export []byte `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
-}
-
-func (x *KeyExportResponse) Reset() {
-	*x = KeyExportResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[70]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuthLockRequest) Reset() {
+	*x = AuthLockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeyExportResponse) String() string {
+func (x *AuthLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyExportResponse) ProtoMessage() {}
+func (*AuthLockRequest) ProtoMessage() {}
 
-func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4710,30 +5215,19 @@ func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyExportResponse.ProtoReflect.Descriptor instead.
-func (*KeyExportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLockRequest.ProtoReflect.Descriptor instead.
+func (*AuthLockRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *KeyExportResponse) GetExport() []byte {
-	if x != nil {
-		return x.Export
-	}
-	return nil
-}
-
-type KeyImportRequest struct {
+type AuthLockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	In       []byte `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Update   bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *KeyImportRequest) Reset() {
-	*x = KeyImportRequest{}
+func (x *AuthLockResponse) Reset() {
+	*x = AuthLockResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4741,13 +5235,13 @@ func (x *KeyImportRequest) Reset() {
 	}
 }
 
-func (x *KeyImportRequest) String() string {
+func (x *AuthLockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyImportRequest) ProtoMessage() {}
+func (*AuthLockResponse) ProtoMessage() {}
 
-func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthLockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4759,42 +5253,21 @@ func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyImportRequest.ProtoReflect.Descriptor instead.
-func (*KeyImportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthLockResponse.ProtoReflect.Descriptor instead.
+func (*AuthLockResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *KeyImportRequest) GetIn() []byte {
-	if x != nil {
-		return x.In
-	}
-	return nil
-}
-
-func (x *KeyImportRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *KeyImportRequest) GetUpdate() bool {
-	if x != nil {
-		return x.Update
-	}
-	return false
-}
-
-type KeyImportResponse struct {
+type AuthResetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	AppName string `protobuf:"bytes,1,opt,name=appName,proto3" json:"appName,omitempty"`
 }
 
-func (x *KeyImportResponse) Reset() {
-	*x = KeyImportResponse{}
+func (x *AuthResetRequest) Reset() {
+	*x = AuthResetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4802,13 +5275,13 @@ func (x *KeyImportResponse) Reset() {
 	}
 }
 
-func (x *KeyImportResponse) String() string {
+func (x *AuthResetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyImportResponse) ProtoMessage() {}
+func (*AuthResetRequest) ProtoMessage() {}
 
-func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthResetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4820,29 +5293,26 @@ func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyImportResponse.ProtoReflect.Descriptor instead.
-func (*KeyImportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthResetRequest.ProtoReflect.Descriptor instead.
+func (*AuthResetRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *KeyImportResponse) GetKID() string {
+func (x *AuthResetRequest) GetAppName() string {
 	if x != nil {
-		return x.KID
+		return x.AppName
 	}
 	return ""
 }
 
-type KeyRemoveRequest struct {
+type AuthResetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// KID of key to remove.
-	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *KeyRemoveRequest) Reset() {
-	*x = KeyRemoveRequest{}
+func (x *AuthResetResponse) Reset() {
+	*x = AuthResetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4850,13 +5320,13 @@ func (x *KeyRemoveRequest) Reset() {
 	}
 }
 
-func (x *KeyRemoveRequest) String() string {
+func (x *AuthResetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRemoveRequest) ProtoMessage() {}
+func (*AuthResetResponse) ProtoMessage() {}
 
-func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
+func (x *AuthResetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4868,26 +5338,22 @@ func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRemoveRequest.ProtoReflect.Descriptor instead.
-func (*KeyRemoveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthResetResponse.ProtoReflect.Descriptor instead.
+func (*AuthResetResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *KeyRemoveRequest) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-type KeyRemoveResponse struct {
+type AuthRecoverRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PaperKey    string `protobuf:"bytes,1,opt,name=paperKey,proto3" json:"paperKey,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-func (x *KeyRemoveResponse) Reset() {
-	*x = KeyRemoveResponse{}
+func (x *AuthRecoverRequest) Reset() {
+	*x = AuthRecoverRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4895,13 +5361,13 @@ func (x *KeyRemoveResponse) Reset() {
 	}
 }
 
-func (x *KeyRemoveResponse) String() string {
+func (x *AuthRecoverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRemoveResponse) ProtoMessage() {}
+func (*AuthRecoverRequest) ProtoMessage() {}
 
-func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
+func (x *AuthRecoverRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4913,33 +5379,35 @@ func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRemoveResponse.ProtoReflect.Descriptor instead.
-func (*KeyRemoveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRecoverRequest.ProtoReflect.Descriptor instead.
+func (*AuthRecoverRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
-type Key struct {
+func (x *AuthRecoverRequest) GetPaperKey() string {
+	if x != nil {
+		return x.PaperKey
+	}
+	return ""
+}
+
+func (x *AuthRecoverRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type AuthRecoverResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID identifier.
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Type of key.
-	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	// IsPrivate if private key.
-	IsPrivate bool `protobuf:"varint,4,opt,name=isPrivate,proto3" json:"isPrivate,omitempty"`
-	// User associated with this key.
-	User *User `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
-	// Saved if saved locally.
-	Saved bool `protobuf:"varint,10,opt,name=saved,proto3" json:"saved,omitempty"`
-	// SigchainLength is length of sigchain (if any).
-	SigchainLength    int32 `protobuf:"varint,40,opt,name=sigchainLength,proto3" json:"sigchainLength,omitempty"`
-	SigchainUpdatedAt int64 `protobuf:"varint,41,opt,name=sigchainUpdatedAt,proto3" json:"sigchainUpdatedAt,omitempty"`
+	AuthToken string `protobuf:"bytes,1,opt,name=authToken,proto3" json:"authToken,omitempty"`
 }
 
-func (x *Key) Reset() {
-	*x = Key{}
+func (x *AuthRecoverResponse) Reset() {
+	*x = AuthRecoverResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4947,13 +5415,13 @@ func (x *Key) Reset() {
 	}
 }
 
-func (x *Key) String() string {
+func (x *AuthRecoverResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Key) ProtoMessage() {}
+func (*AuthRecoverResponse) ProtoMessage() {}
 
-func (x *Key) ProtoReflect() protoreflect.Message {
+func (x *AuthRecoverResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4965,90 +5433,108 @@ func (x *Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Key.ProtoReflect.Descriptor instead.
-func (*Key) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRecoverResponse.ProtoReflect.Descriptor instead.
+func (*AuthRecoverResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Key) GetID() string {
+func (x *AuthRecoverResponse) GetAuthToken() string {
 	if x != nil {
-		return x.ID
+		return x.AuthToken
 	}
 	return ""
 }
 
-func (x *Key) GetType() string {
+type KeyGenerateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Root (optional) EdX25519 key to derive the key from, requires path.
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// Path for key derivation, for example "backend/signing".
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *KeyGenerateRequest) Reset() {
+	*x = KeyGenerateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyGenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyGenerateRequest) ProtoMessage() {}
+
+func (x *KeyGenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyGenerateRequest.ProtoReflect.Descriptor instead.
+func (*KeyGenerateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KeyGenerateRequest) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *Key) GetIsPrivate() bool {
+func (x *KeyGenerateRequest) GetRoot() string {
 	if x != nil {
-		return x.IsPrivate
+		return x.Root
 	}
-	return false
+	return ""
 }
 
-func (x *Key) GetUser() *User {
+func (x *KeyGenerateRequest) GetPath() string {
 	if x != nil {
-		return x.User
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *Key) GetSaved() bool {
-	if x != nil {
-		return x.Saved
-	}
-	return false
-}
-
-func (x *Key) GetSigchainLength() int32 {
-	if x != nil {
-		return x.SigchainLength
-	}
-	return 0
-}
-
-func (x *Key) GetSigchainUpdatedAt() int64 {
-	if x != nil {
-		return x.SigchainUpdatedAt
-	}
-	return 0
-}
-
-type KeyRequest struct {
+type KeyGenerateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Key can be kid or user@service.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Search, if true, will search for the key on the server.
-	Search bool `protobuf:"varint,4,opt,name=search,proto3" json:"search,omitempty"`
-	// Update, if true, will update the sigchain from the server.
-	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *KeyRequest) Reset() {
-	*x = KeyRequest{}
+func (x *KeyGenerateResponse) Reset() {
+	*x = KeyGenerateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[76]
+		mi := &file_rpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeyRequest) String() string {
+func (x *KeyGenerateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyRequest) ProtoMessage() {}
+func (*KeyGenerateResponse) ProtoMessage() {}
 
-func (x *KeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+func (x *KeyGenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5059,57 +5545,46 @@ func (x *KeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
-func (*KeyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use KeyGenerateResponse.ProtoReflect.Descriptor instead.
+func (*KeyGenerateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *KeyRequest) GetKey() string {
+func (x *KeyGenerateResponse) GetKID() string {
 	if x != nil {
-		return x.Key
+		return x.KID
 	}
 	return ""
 }
 
-func (x *KeyRequest) GetSearch() bool {
-	if x != nil {
-		return x.Search
-	}
-	return false
-}
-
-func (x *KeyRequest) GetUpdate() bool {
-	if x != nil {
-		return x.Update
-	}
-	return false
-}
-
-type KeyResponse struct {
+type UserServiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key *Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *KeyResponse) Reset() {
-	*x = KeyResponse{}
+func (x *UserServiceRequest) Reset() {
+	*x = UserServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[77]
+		mi := &file_rpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeyResponse) String() string {
+func (x *UserServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyResponse) ProtoMessage() {}
+func (*UserServiceRequest) ProtoMessage() {}
 
-func (x *KeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+func (x *UserServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5120,46 +5595,51 @@ func (x *KeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyResponse.ProtoReflect.Descriptor instead.
-func (*KeyResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use UserServiceRequest.ProtoReflect.Descriptor instead.
+func (*UserServiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *KeyResponse) GetKey() *Key {
+func (x *UserServiceRequest) GetKID() string {
 	if x != nil {
-		return x.Key
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type KeysRequest struct {
+func (x *UserServiceRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type UserServiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Types         []string      `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 }
 
-func (x *KeysRequest) Reset() {
-	*x = KeysRequest{}
+func (x *UserServiceResponse) Reset() {
+	*x = UserServiceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[78]
+		mi := &file_rpc_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeysRequest) String() string {
+func (x *UserServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeysRequest) ProtoMessage() {}
+func (*UserServiceResponse) ProtoMessage() {}
 
-func (x *KeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+func (x *UserServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5170,66 +5650,50 @@ func (x *KeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
-func (*KeysRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *KeysRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *KeysRequest) GetTypes() []string {
-	if x != nil {
-		return x.Types
-	}
-	return nil
+// Deprecated: Use UserServiceResponse.ProtoReflect.Descriptor instead.
+func (*UserServiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *KeysRequest) GetSortField() string {
+func (x *UserServiceResponse) GetService() string {
 	if x != nil {
-		return x.SortField
+		return x.Service
 	}
 	return ""
 }
 
-func (x *KeysRequest) GetSortDirection() SortDirection {
-	if x != nil {
-		return x.SortDirection
-	}
-	return SortAsc
-}
-
-type KeysResponse struct {
+type UserSignRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys          []*Key        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Name is username on the service.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Forge (base URL) for service forge, for example https://git.example.com.
+	Forge string `protobuf:"bytes,4,opt,name=forge,proto3" json:"forge,omitempty"`
 }
 
-func (x *KeysResponse) Reset() {
-	*x = KeysResponse{}
+func (x *UserSignRequest) Reset() {
+	*x = UserSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[79]
+		mi := &file_rpc_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KeysResponse) String() string {
+func (x *UserSignRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeysResponse) ProtoMessage() {}
+func (*UserSignRequest) ProtoMessage() {}
 
-func (x *KeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+func (x *UserSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5240,65 +5704,67 @@ func (x *KeysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
-func (*KeysResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use UserSignRequest.ProtoReflect.Descriptor instead.
+func (*UserSignRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *KeysResponse) GetKeys() []*Key {
+func (x *UserSignRequest) GetKID() string {
 	if x != nil {
-		return x.Keys
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-func (x *KeysResponse) GetSortField() string {
+func (x *UserSignRequest) GetService() string {
 	if x != nil {
-		return x.SortField
+		return x.Service
 	}
 	return ""
 }
 
-func (x *KeysResponse) GetSortDirection() SortDirection {
+func (x *UserSignRequest) GetName() string {
 	if x != nil {
-		return x.SortDirection
+		return x.Name
 	}
-	return SortAsc
+	return ""
 }
 
-type Secret struct {
+func (x *UserSignRequest) GetForge() string {
+	if x != nil {
+		return x.Forge
+	}
+	return ""
+}
+
+type UserSignResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type      SecretType `protobuf:"varint,3,opt,name=type,proto3,enum=service.SecretType" json:"type,omitempty"`
-	Username  string     `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
-	Password  string     `protobuf:"bytes,11,opt,name=password,proto3" json:"password,omitempty"`
-	URL       string     `protobuf:"bytes,20,opt,name=url,proto3" json:"url,omitempty"`
-	Notes     string     `protobuf:"bytes,30,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt int64      `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt int64      `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Message is signed message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Name in request.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Secret) Reset() {
-	*x = Secret{}
+func (x *UserSignResponse) Reset() {
+	*x = UserSignResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[80]
+		mi := &file_rpc_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Secret) String() string {
+func (x *UserSignResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secret) ProtoMessage() {}
+func (*UserSignResponse) ProtoMessage() {}
 
-func (x *Secret) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+func (x *UserSignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5309,99 +5775,144 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
-func (*Secret) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use UserSignResponse.ProtoReflect.Descriptor instead.
+func (*UserSignResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *Secret) GetID() string {
+func (x *UserSignResponse) GetMessage() string {
 	if x != nil {
-		return x.ID
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Secret) GetName() string {
+func (x *UserSignResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Secret) GetType() SecretType {
-	if x != nil {
-		return x.Type
+type UserAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// KID to use, or if empty the current key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Service such as twitter, github.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// Name is username on the service.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// URL is location of signed message on the service.
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
+	// Forge (base URL) for service forge, registered on the key server.
+	Forge string `protobuf:"bytes,6,opt,name=forge,proto3" json:"forge,omitempty"`
+}
+
+func (x *UserAddRequest) Reset() {
+	*x = UserAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return UnknownSecret
 }
 
-func (x *Secret) GetUsername() string {
+func (x *UserAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAddRequest) ProtoMessage() {}
+
+func (x *UserAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAddRequest.ProtoReflect.Descriptor instead.
+func (*UserAddRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UserAddRequest) GetKID() string {
 	if x != nil {
-		return x.Username
+		return x.KID
 	}
 	return ""
 }
 
-func (x *Secret) GetPassword() string {
+func (x *UserAddRequest) GetService() string {
 	if x != nil {
-		return x.Password
+		return x.Service
 	}
 	return ""
 }
 
-func (x *Secret) GetURL() string {
+func (x *UserAddRequest) GetName() string {
 	if x != nil {
-		return x.URL
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Secret) GetNotes() string {
+func (x *UserAddRequest) GetURL() string {
 	if x != nil {
-		return x.Notes
+		return x.URL
 	}
 	return ""
 }
 
-func (x *Secret) GetCreatedAt() int64 {
+func (x *UserAddRequest) GetLocal() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Local
 	}
-	return 0
+	return false
 }
 
-func (x *Secret) GetUpdatedAt() int64 {
+func (x *UserAddRequest) GetForge() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Forge
 	}
-	return 0
+	return ""
 }
 
-type SecretRequest struct {
+type UserAddResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User      *User      `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Statement *Statement `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *SecretRequest) Reset() {
-	*x = SecretRequest{}
+func (x *UserAddResponse) Reset() {
+	*x = UserAddResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[81]
+		mi := &file_rpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretRequest) String() string {
+func (x *UserAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRequest) ProtoMessage() {}
+func (*UserAddResponse) ProtoMessage() {}
 
-func (x *SecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+func (x *UserAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,43 +5923,56 @@ func (x *SecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
-func (*SecretRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use UserAddResponse.ProtoReflect.Descriptor instead.
+func (*UserAddResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *SecretRequest) GetID() string {
+func (x *UserAddResponse) GetUser() *User {
 	if x != nil {
-		return x.ID
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-type SecretResponse struct {
+func (x *UserAddResponse) GetStatement() *Statement {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+type KeyExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	KID      string     `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Password string     `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Type     ExportType `protobuf:"varint,3,opt,name=type,proto3,enum=service.ExportType" json:"type,omitempty"`
+	Public   bool       `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	// NoPassword, required to export a private key without a password, except
+	// for paper and jwk exports, which can't have a password.
+	NoPassword bool `protobuf:"varint,5,opt,name=noPassword,proto3" json:"noPassword,omitempty"`
 }
 
-func (x *SecretResponse) Reset() {
-	*x = SecretResponse{}
+func (x *KeyExportRequest) Reset() {
+	*x = KeyExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[82]
+		mi := &file_rpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretResponse) String() string {
+func (x *KeyExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretResponse) ProtoMessage() {}
+func (*KeyExportRequest) ProtoMessage() {}
 
-func (x *SecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+func (x *KeyExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5459,43 +5983,71 @@ func (x *SecretResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
-func (*SecretResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use KeyExportRequest.ProtoReflect.Descriptor instead.
+func (*KeyExportRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *SecretResponse) GetSecret() *Secret {
+func (x *KeyExportRequest) GetKID() string {
 	if x != nil {
-		return x.Secret
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-type SecretSaveRequest struct {
+func (x *KeyExportRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *KeyExportRequest) GetType() ExportType {
+	if x != nil {
+		return x.Type
+	}
+	return DefaultExport
+}
+
+func (x *KeyExportRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *KeyExportRequest) GetNoPassword() bool {
+	if x != nil {
+		return x.NoPassword
+	}
+	return false
+}
+
+type KeyExportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Export []byte `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 }
 
-func (x *SecretSaveRequest) Reset() {
-	*x = SecretSaveRequest{}
+func (x *KeyExportResponse) Reset() {
+	*x = KeyExportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[83]
+		mi := &file_rpc_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretSaveRequest) String() string {
+func (x *KeyExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSaveRequest) ProtoMessage() {}
+func (*KeyExportResponse) ProtoMessage() {}
 
-func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+func (x *KeyExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5506,43 +6058,45 @@ func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSaveRequest.ProtoReflect.Descriptor instead.
-func (*SecretSaveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use KeyExportResponse.ProtoReflect.Descriptor instead.
+func (*KeyExportResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SecretSaveRequest) GetSecret() *Secret {
+func (x *KeyExportResponse) GetExport() []byte {
 	if x != nil {
-		return x.Secret
+		return x.Export
 	}
 	return nil
 }
 
-type SecretSaveResponse struct {
+type KeyImportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	In       []byte `protobuf:"bytes,1,opt,name=in,proto3" json:"in,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Update   bool   `protobuf:"varint,3,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *SecretSaveResponse) Reset() {
-	*x = SecretSaveResponse{}
+func (x *KeyImportRequest) Reset() {
+	*x = KeyImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[84]
+		mi := &file_rpc_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretSaveResponse) String() string {
+func (x *KeyImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretSaveResponse) ProtoMessage() {}
+func (*KeyImportRequest) ProtoMessage() {}
 
-func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+func (x *KeyImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5553,43 +6107,59 @@ func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretSaveResponse.ProtoReflect.Descriptor instead.
-func (*SecretSaveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use KeyImportRequest.ProtoReflect.Descriptor instead.
+func (*KeyImportRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *SecretSaveResponse) GetSecret() *Secret {
+func (x *KeyImportRequest) GetIn() []byte {
 	if x != nil {
-		return x.Secret
+		return x.In
 	}
 	return nil
 }
 
-type SecretRemoveRequest struct {
+func (x *KeyImportRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *KeyImportRequest) GetUpdate() bool {
+	if x != nil {
+		return x.Update
+	}
+	return false
+}
+
+type KeyImportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Format detected, for example saltpack, ssh, pkcs8, age or paper.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 }
 
-func (x *SecretRemoveRequest) Reset() {
-	*x = SecretRemoveRequest{}
+func (x *KeyImportResponse) Reset() {
+	*x = KeyImportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[85]
+		mi := &file_rpc_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretRemoveRequest) String() string {
+func (x *KeyImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRemoveRequest) ProtoMessage() {}
+func (*KeyImportResponse) ProtoMessage() {}
 
-func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+func (x *KeyImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5600,41 +6170,51 @@ func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRemoveRequest.ProtoReflect.Descriptor instead.
-func (*SecretRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use KeyImportResponse.ProtoReflect.Descriptor instead.
+func (*KeyImportResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SecretRemoveRequest) GetID() string {
+func (x *KeyImportResponse) GetKID() string {
 	if x != nil {
-		return x.ID
+		return x.KID
 	}
 	return ""
 }
 
-type SecretRemoveResponse struct {
+func (x *KeyImportResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type KeyRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// KID of key to remove.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-func (x *SecretRemoveResponse) Reset() {
-	*x = SecretRemoveResponse{}
+func (x *KeyRemoveRequest) Reset() {
+	*x = KeyRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[86]
+		mi := &file_rpc_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretRemoveResponse) String() string {
+func (x *KeyRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretRemoveResponse) ProtoMessage() {}
+func (*KeyRemoveRequest) ProtoMessage() {}
 
-func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+func (x *KeyRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5645,38 +6225,41 @@ func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretRemoveResponse.ProtoReflect.Descriptor instead.
-func (*SecretRemoveResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use KeyRemoveRequest.ProtoReflect.Descriptor instead.
+func (*KeyRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
-type SecretsRequest struct {
+func (x *KeyRemoveRequest) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+type KeyRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *SecretsRequest) Reset() {
-	*x = SecretsRequest{}
+func (x *KeyRemoveResponse) Reset() {
+	*x = KeyRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[87]
+		mi := &file_rpc_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretsRequest) String() string {
+func (x *KeyRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretsRequest) ProtoMessage() {}
+func (*KeyRemoveResponse) ProtoMessage() {}
 
-func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+func (x *KeyRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5687,59 +6270,41 @@ func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretsRequest.ProtoReflect.Descriptor instead.
-func (*SecretsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *SecretsRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *SecretsRequest) GetSortField() string {
-	if x != nil {
-		return x.SortField
-	}
-	return ""
-}
-
-func (x *SecretsRequest) GetSortDirection() SortDirection {
-	if x != nil {
-		return x.SortDirection
-	}
-	return SortAsc
+// Deprecated: Use KeyRemoveResponse.ProtoReflect.Descriptor instead.
+func (*KeyRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
-type SecretsResponse struct {
+type KeyRotateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Secrets       []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
-	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
+	// KID of (EdX25519) key to rotate.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Successor (EdX25519) key, if not specified a new key is generated.
+	Successor string `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *SecretsResponse) Reset() {
-	*x = SecretsResponse{}
+func (x *KeyRotateRequest) Reset() {
+	*x = KeyRotateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[88]
+		mi := &file_rpc_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SecretsResponse) String() string {
+func (x *KeyRotateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SecretsResponse) ProtoMessage() {}
+func (*KeyRotateRequest) ProtoMessage() {}
 
-func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+func (x *KeyRotateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5750,60 +6315,60 @@ func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SecretsResponse.ProtoReflect.Descriptor instead.
-func (*SecretsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use KeyRotateRequest.ProtoReflect.Descriptor instead.
+func (*KeyRotateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *SecretsResponse) GetSecrets() []*Secret {
+func (x *KeyRotateRequest) GetKID() string {
 	if x != nil {
-		return x.Secrets
+		return x.KID
 	}
-	return nil
+	return ""
 }
 
-func (x *SecretsResponse) GetSortField() string {
+func (x *KeyRotateRequest) GetSuccessor() string {
 	if x != nil {
-		return x.SortField
+		return x.Successor
 	}
 	return ""
 }
 
-func (x *SecretsResponse) GetSortDirection() SortDirection {
+func (x *KeyRotateRequest) GetLocal() bool {
 	if x != nil {
-		return x.SortDirection
+		return x.Local
 	}
-	return SortAsc
+	return false
 }
 
-type RandRequest struct {
+type KeyRotateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumBytes  int32    `protobuf:"varint,1,opt,name=numBytes,proto3" json:"numBytes,omitempty"`
-	Encoding  Encoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=service.Encoding" json:"encoding,omitempty"`
-	NoPadding bool     `protobuf:"varint,10,opt,name=noPadding,proto3" json:"noPadding,omitempty"`
-	Lowercase bool     `protobuf:"varint,11,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
+	// Statement (key rotation) added to the sigchain of the rotated key.
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// Successor key.
+	Successor *Key `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
 }
 
-func (x *RandRequest) Reset() {
-	*x = RandRequest{}
+func (x *KeyRotateResponse) Reset() {
+	*x = KeyRotateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[89]
+		mi := &file_rpc_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RandRequest) String() string {
+func (x *KeyRotateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandRequest) ProtoMessage() {}
+func (*KeyRotateResponse) ProtoMessage() {}
 
-func (x *RandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+func (x *KeyRotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5814,64 +6379,55 @@ func (x *RandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandRequest.ProtoReflect.Descriptor instead.
-func (*RandRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *RandRequest) GetNumBytes() int32 {
-	if x != nil {
-		return x.NumBytes
-	}
-	return 0
-}
-
-func (x *RandRequest) GetEncoding() Encoding {
-	if x != nil {
-		return x.Encoding
-	}
-	return Hex
+// Deprecated: Use KeyRotateResponse.ProtoReflect.Descriptor instead.
+func (*KeyRotateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *RandRequest) GetNoPadding() bool {
+func (x *KeyRotateResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.NoPadding
+		return x.Statement
 	}
-	return false
+	return nil
 }
 
-func (x *RandRequest) GetLowercase() bool {
+func (x *KeyRotateResponse) GetSuccessor() *Key {
 	if x != nil {
-		return x.Lowercase
+		return x.Successor
 	}
-	return false
+	return nil
 }
 
-type RandResponse struct {
+type KeyEndorseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// KID of (EdX25519) key to endorse with.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Key to endorse (kid or user@service).
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Local, if true, won't save to the current key server.
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *RandResponse) Reset() {
-	*x = RandResponse{}
+func (x *KeyEndorseRequest) Reset() {
+	*x = KeyEndorseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[90]
+		mi := &file_rpc_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RandResponse) String() string {
+func (x *KeyEndorseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandResponse) ProtoMessage() {}
+func (*KeyEndorseRequest) ProtoMessage() {}
 
-func (x *RandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+func (x *KeyEndorseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5882,43 +6438,58 @@ func (x *RandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandResponse.ProtoReflect.Descriptor instead.
-func (*RandResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use KeyEndorseRequest.ProtoReflect.Descriptor instead.
+func (*KeyEndorseRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *RandResponse) GetData() string {
+func (x *KeyEndorseRequest) GetKID() string {
 	if x != nil {
-		return x.Data
+		return x.KID
 	}
 	return ""
 }
 
-type RandPasswordRequest struct {
+func (x *KeyEndorseRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyEndorseRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type KeyEndorseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	// Statement (endorsement) added to the sigchain of the endorsing key.
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *RandPasswordRequest) Reset() {
-	*x = RandPasswordRequest{}
+func (x *KeyEndorseResponse) Reset() {
+	*x = KeyEndorseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[91]
+		mi := &file_rpc_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RandPasswordRequest) String() string {
+func (x *KeyEndorseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandPasswordRequest) ProtoMessage() {}
+func (*KeyEndorseResponse) ProtoMessage() {}
 
-func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+func (x *KeyEndorseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5929,43 +6500,50 @@ func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandPasswordRequest.ProtoReflect.Descriptor instead.
-func (*RandPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use KeyEndorseResponse.ProtoReflect.Descriptor instead.
+func (*KeyEndorseResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *RandPasswordRequest) GetLength() int32 {
+func (x *KeyEndorseResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.Length
+		return x.Statement
 	}
-	return 0
+	return nil
 }
 
-type RandPasswordResponse struct {
+// Endorsement of a key, by a pinned key.
+type Endorsement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// KID of the endorsing key.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// User (name@service) the endorsing key was pinned for.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Trust level of the endorsing key.
+	Trust     TrustLevel `protobuf:"varint,3,opt,name=trust,proto3,enum=service.TrustLevel" json:"trust,omitempty"`
+	CreatedAt int64      `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *RandPasswordResponse) Reset() {
-	*x = RandPasswordResponse{}
+func (x *Endorsement) Reset() {
+	*x = Endorsement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[92]
+		mi := &file_rpc_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RandPasswordResponse) String() string {
+func (x *Endorsement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RandPasswordResponse) ProtoMessage() {}
+func (*Endorsement) ProtoMessage() {}
 
-func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+func (x *Endorsement) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,90 +6554,79 @@ func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RandPasswordResponse.ProtoReflect.Descriptor instead.
-func (*RandPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use Endorsement.ProtoReflect.Descriptor instead.
+func (*Endorsement) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RandPasswordResponse) GetPassword() string {
+func (x *Endorsement) GetKID() string {
 	if x != nil {
-		return x.Password
+		return x.KID
 	}
 	return ""
 }
 
-type PullRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (x *Endorsement) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
 }
 
-func (x *PullRequest) Reset() {
-	*x = PullRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[93]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PullRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PullRequest) ProtoMessage() {}
-
-func (x *PullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Endorsement) GetTrust() TrustLevel {
+	if x != nil {
+		return x.Trust
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
-func (*PullRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return TrustUnknown
 }
 
-func (x *PullRequest) GetKey() string {
+func (x *Endorsement) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Key
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-type PullResponse struct {
+// KeyUpdateRequest updates key metadata.
+// Only the fields listed in fields are updated. If fields is empty, only the
+// non-zero fields are updated; to clear a field, list it in fields.
+type KeyUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KIDs []string `protobuf:"bytes,1,rep,name=kids,proto3" json:"kids,omitempty"`
-}
-
-func (x *PullResponse) Reset() {
-	*x = PullResponse{}
+	// KID of key to update.
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// Label describing what the key is for.
+	Label string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Notes string   `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags  []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ExpiresAt (optional), 0 if key doesn't expire.
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// SSHAgent policy for the key.
+	SSHAgent SSHAgentPolicy `protobuf:"varint,6,opt,name=sshAgent,proto3,enum=service.SSHAgentPolicy" json:"sshAgent,omitempty"`
+	// Fields to update (label, notes, tags, expiresAt, sshAgent), or empty to
+	// update the non-zero fields.
+	Fields []string `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *KeyUpdateRequest) Reset() {
+	*x = KeyUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[94]
+		mi := &file_rpc_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PullResponse) String() string {
+func (x *KeyUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullResponse) ProtoMessage() {}
+func (*KeyUpdateRequest) ProtoMessage() {}
 
-func (x *PullResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+func (x *KeyUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6070,84 +6637,70 @@ func (x *PullResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullResponse.ProtoReflect.Descriptor instead.
-func (*PullResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use KeyUpdateRequest.ProtoReflect.Descriptor instead.
+func (*KeyUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *PullResponse) GetKIDs() []string {
+func (x *KeyUpdateRequest) GetKID() string {
 	if x != nil {
-		return x.KIDs
+		return x.KID
 	}
-	return nil
-}
-
-type PushRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	RemoteCheck bool   `protobuf:"varint,2,opt,name=remoteCheck,proto3" json:"remoteCheck,omitempty"`
+	return ""
 }
 
-func (x *PushRequest) Reset() {
-	*x = PushRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[95]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *KeyUpdateRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
+	return ""
 }
 
-func (x *PushRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *KeyUpdateRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
 }
 
-func (*PushRequest) ProtoMessage() {}
-
-func (x *PushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *KeyUpdateRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
-func (*PushRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+func (x *KeyUpdateRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
 }
 
-func (x *PushRequest) GetKey() string {
+func (x *KeyUpdateRequest) GetSSHAgent() SSHAgentPolicy {
 	if x != nil {
-		return x.Key
+		return x.SSHAgent
 	}
-	return ""
+	return SSHAgentAllow
 }
 
-func (x *PushRequest) GetRemoteCheck() bool {
+func (x *KeyUpdateRequest) GetFields() []string {
 	if x != nil {
-		return x.RemoteCheck
+		return x.Fields
 	}
-	return false
+	return nil
 }
 
-type PushResponse struct {
+type KeyUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID  string   `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	URLs []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Key *Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *PushResponse) Reset() {
-	*x = PushResponse{}
+func (x *KeyUpdateResponse) Reset() {
+	*x = KeyUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6155,13 +6708,13 @@ func (x *PushResponse) Reset() {
 	}
 }
 
-func (x *PushResponse) String() string {
+func (x *KeyUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushResponse) ProtoMessage() {}
+func (*KeyUpdateResponse) ProtoMessage() {}
 
-func (x *PushResponse) ProtoReflect() protoreflect.Message {
+func (x *KeyUpdateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6173,35 +6726,61 @@ func (x *PushResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
-func (*PushResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyUpdateResponse.ProtoReflect.Descriptor instead.
+func (*KeyUpdateResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *PushResponse) GetKID() string {
-	if x != nil {
-		return x.KID
-	}
-	return ""
-}
-
-func (x *PushResponse) GetURLs() []string {
+func (x *KeyUpdateResponse) GetKey() *Key {
 	if x != nil {
-		return x.URLs
+		return x.Key
 	}
 	return nil
 }
 
-type Collection struct {
+type Key struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// ID identifier.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type of key.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// IsPrivate if private key.
+	IsPrivate bool `protobuf:"varint,4,opt,name=isPrivate,proto3" json:"isPrivate,omitempty"`
+	// User associated with this key.
+	User *User `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	// Saved if saved locally.
+	Saved bool `protobuf:"varint,10,opt,name=saved,proto3" json:"saved,omitempty"`
+	// Label describing what the key is for.
+	Label string   `protobuf:"bytes,20,opt,name=label,proto3" json:"label,omitempty"`
+	Notes string   `protobuf:"bytes,21,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tags  []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ExpiresAt (optional), 0 if key doesn't expire.
+	ExpiresAt int64 `protobuf:"varint,23,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// Expired if key has an expiry in the past.
+	Expired bool `protobuf:"varint,24,opt,name=expired,proto3" json:"expired,omitempty"`
+	// Root key ID and path if key was derived.
+	Root string `protobuf:"bytes,25,opt,name=root,proto3" json:"root,omitempty"`
+	Path string `protobuf:"bytes,26,opt,name=path,proto3" json:"path,omitempty"`
+	// SSHAgent policy for the key.
+	SSHAgent SSHAgentPolicy `protobuf:"varint,27,opt,name=sshAgent,proto3,enum=service.SSHAgentPolicy" json:"sshAgent,omitempty"`
+	// RotatedTo is the successor key, if the key was rotated.
+	RotatedTo string `protobuf:"bytes,28,opt,name=rotatedTo,proto3" json:"rotatedTo,omitempty"`
+	// Trust level, if the key is pinned.
+	Trust TrustLevel `protobuf:"varint,29,opt,name=trust,proto3,enum=service.TrustLevel" json:"trust,omitempty"`
+	// TrustWarning if the key is not the one pinned for the user (on verify).
+	TrustWarning string `protobuf:"bytes,30,opt,name=trustWarning,proto3" json:"trustWarning,omitempty"`
+	// Endorsements by pinned keys (from Key).
+	Endorsements []*Endorsement `protobuf:"bytes,31,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	// SigchainLength is length of sigchain (if any).
+	SigchainLength    int32 `protobuf:"varint,40,opt,name=sigchainLength,proto3" json:"sigchainLength,omitempty"`
+	SigchainUpdatedAt int64 `protobuf:"varint,41,opt,name=sigchainUpdatedAt,proto3" json:"sigchainUpdatedAt,omitempty"`
 }
 
-func (x *Collection) Reset() {
-	*x = Collection{}
+func (x *Key) Reset() {
+	*x = Key{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6209,13 +6788,13 @@ func (x *Collection) Reset() {
 	}
 }
 
-func (x *Collection) String() string {
+func (x *Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collection) ProtoMessage() {}
+func (*Key) ProtoMessage() {}
 
-func (x *Collection) ProtoReflect() protoreflect.Message {
+func (x *Key) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6227,149 +6806,174 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
-func (*Collection) Descriptor() ([]byte, []int) {
+// Deprecated: Use Key.ProtoReflect.Descriptor instead.
+func (*Key) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *Collection) GetPath() string {
+func (x *Key) GetID() string {
 	if x != nil {
-		return x.Path
+		return x.ID
 	}
 	return ""
 }
 
-type CollectionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// DB is "service" or "vault".
-	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
-}
-
-func (x *CollectionsRequest) Reset() {
-	*x = CollectionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[98]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Key) GetType() string {
+	if x != nil {
+		return x.Type
 	}
+	return ""
 }
 
-func (x *CollectionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Key) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
 }
 
-func (*CollectionsRequest) ProtoMessage() {}
-
-func (x *CollectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Key) GetUser() *User {
+	if x != nil {
+		return x.User
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CollectionsRequest.ProtoReflect.Descriptor instead.
-func (*CollectionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+func (x *Key) GetSaved() bool {
+	if x != nil {
+		return x.Saved
+	}
+	return false
 }
 
-func (x *CollectionsRequest) GetParent() string {
+func (x *Key) GetLabel() string {
 	if x != nil {
-		return x.Parent
+		return x.Label
 	}
 	return ""
 }
 
-func (x *CollectionsRequest) GetDB() string {
+func (x *Key) GetNotes() string {
 	if x != nil {
-		return x.DB
+		return x.Notes
 	}
 	return ""
 }
 
-type CollectionsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+func (x *Key) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *CollectionsResponse) Reset() {
-	*x = CollectionsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[99]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Key) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
 	}
+	return 0
 }
 
-func (x *CollectionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Key) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
 }
 
-func (*CollectionsResponse) ProtoMessage() {}
+func (x *Key) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
 
-func (x *CollectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Key) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CollectionsResponse.ProtoReflect.Descriptor instead.
-func (*CollectionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
+func (x *Key) GetSSHAgent() SSHAgentPolicy {
+	if x != nil {
+		return x.SSHAgent
+	}
+	return SSHAgentAllow
 }
 
-func (x *CollectionsResponse) GetCollections() []*Collection {
+func (x *Key) GetRotatedTo() string {
 	if x != nil {
-		return x.Collections
+		return x.RotatedTo
+	}
+	return ""
+}
+
+func (x *Key) GetTrust() TrustLevel {
+	if x != nil {
+		return x.Trust
+	}
+	return TrustUnknown
+}
+
+func (x *Key) GetTrustWarning() string {
+	if x != nil {
+		return x.TrustWarning
+	}
+	return ""
+}
+
+func (x *Key) GetEndorsements() []*Endorsement {
+	if x != nil {
+		return x.Endorsements
 	}
 	return nil
 }
 
-type Document struct {
+func (x *Key) GetSigchainLength() int32 {
+	if x != nil {
+		return x.SigchainLength
+	}
+	return 0
+}
+
+func (x *Key) GetSigchainUpdatedAt() int64 {
+	if x != nil {
+		return x.SigchainUpdatedAt
+	}
+	return 0
+}
+
+type KeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	CreatedAt int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Key can be kid or user@service.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Search, if true, will search for the key on the server.
+	Search bool `protobuf:"varint,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Update, if true, will update the sigchain from the server.
+	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
 }
 
-func (x *Document) Reset() {
-	*x = Document{}
+func (x *KeyRequest) Reset() {
+	*x = KeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[100]
+		mi := &file_rpc_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Document) String() string {
+func (x *KeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Document) ProtoMessage() {}
+func (*KeyRequest) ProtoMessage() {}
 
-func (x *Document) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+func (x *KeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6380,66 +6984,57 @@ func (x *Document) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Document.ProtoReflect.Descriptor instead.
-func (*Document) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *Document) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use KeyRequest.ProtoReflect.Descriptor instead.
+func (*KeyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *Document) GetValue() string {
+func (x *KeyRequest) GetKey() string {
 	if x != nil {
-		return x.Value
+		return x.Key
 	}
 	return ""
 }
 
-func (x *Document) GetCreatedAt() int64 {
+func (x *KeyRequest) GetSearch() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Search
 	}
-	return 0
+	return false
 }
 
-func (x *Document) GetUpdatedAt() int64 {
+func (x *KeyRequest) GetUpdate() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Update
 	}
-	return 0
+	return false
 }
 
-type DocumentsRequest struct {
+type KeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	// DB is "service" or "vault".
-	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
+	Key *Key `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *DocumentsRequest) Reset() {
-	*x = DocumentsRequest{}
+func (x *KeyResponse) Reset() {
+	*x = KeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[101]
+		mi := &file_rpc_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentsRequest) String() string {
+func (x *KeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentsRequest) ProtoMessage() {}
+func (*KeyResponse) ProtoMessage() {}
 
-func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
+func (x *KeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6450,50 +7045,46 @@ func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentsRequest.ProtoReflect.Descriptor instead.
-func (*DocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *DocumentsRequest) GetPrefix() string {
-	if x != nil {
-		return x.Prefix
-	}
-	return ""
+// Deprecated: Use KeyResponse.ProtoReflect.Descriptor instead.
+func (*KeyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DocumentsRequest) GetDB() string {
+func (x *KeyResponse) GetKey() *Key {
 	if x != nil {
-		return x.DB
+		return x.Key
 	}
-	return ""
+	return nil
 }
 
-type DocumentsResponse struct {
+type KeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Types         []string      `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *DocumentsResponse) Reset() {
-	*x = DocumentsResponse{}
+func (x *KeysRequest) Reset() {
+	*x = KeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[102]
+		mi := &file_rpc_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentsResponse) String() string {
+func (x *KeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentsResponse) ProtoMessage() {}
+func (*KeysRequest) ProtoMessage() {}
 
-func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[102]
+func (x *KeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6504,44 +7095,66 @@ func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentsResponse.ProtoReflect.Descriptor instead.
-func (*DocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
+func (*KeysRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *DocumentsResponse) GetDocuments() []*Document {
+func (x *KeysRequest) GetQuery() string {
 	if x != nil {
-		return x.Documents
+		return x.Query
+	}
+	return ""
+}
+
+func (x *KeysRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
 	}
 	return nil
 }
 
-type DocumentDeleteRequest struct {
+func (x *KeysRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *KeysRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+type KeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	DB   string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
+	Keys          []*Key        `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *DocumentDeleteRequest) Reset() {
-	*x = DocumentDeleteRequest{}
+func (x *KeysResponse) Reset() {
+	*x = KeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[103]
+		mi := &file_rpc_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentDeleteRequest) String() string {
+func (x *KeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentDeleteRequest) ProtoMessage() {}
+func (*KeysResponse) ProtoMessage() {}
 
-func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[103]
+func (x *KeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6552,48 +7165,65 @@ func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentDeleteRequest.ProtoReflect.Descriptor instead.
-func (*DocumentDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
+func (*KeysResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DocumentDeleteRequest) GetPath() string {
+func (x *KeysResponse) GetKeys() []*Key {
 	if x != nil {
-		return x.Path
+		return x.Keys
 	}
-	return ""
+	return nil
 }
 
-func (x *DocumentDeleteRequest) GetDB() string {
+func (x *KeysResponse) GetSortField() string {
 	if x != nil {
-		return x.DB
+		return x.SortField
 	}
 	return ""
 }
 
-type DocumentDeleteResponse struct {
+func (x *KeysResponse) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ID        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type      SecretType `protobuf:"varint,3,opt,name=type,proto3,enum=service.SecretType" json:"type,omitempty"`
+	Username  string     `protobuf:"bytes,10,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string     `protobuf:"bytes,11,opt,name=password,proto3" json:"password,omitempty"`
+	URL       string     `protobuf:"bytes,20,opt,name=url,proto3" json:"url,omitempty"`
+	Notes     string     `protobuf:"bytes,30,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt int64      `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64      `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *DocumentDeleteResponse) Reset() {
-	*x = DocumentDeleteResponse{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[104]
+		mi := &file_rpc_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentDeleteResponse) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentDeleteResponse) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[104]
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6604,164 +7234,99 @@ func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentDeleteResponse.ProtoReflect.Descriptor instead.
-func (*DocumentDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{104}
-}
-
-type User struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ID         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	KID        string     `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	Seq        int32      `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
-	Service    string     `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
-	URL        string     `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
-	Status     UserStatus `protobuf:"varint,10,opt,name=status,proto3,enum=service.UserStatus" json:"status,omitempty"`
-	VerifiedAt int64      `protobuf:"varint,11,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
-	Timestamp  int64      `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Proxied    bool       `protobuf:"varint,13,opt,name=proxied,proto3" json:"proxied,omitempty"`
-	Err        string     `protobuf:"bytes,20,opt,name=err,proto3" json:"err,omitempty"`
-}
-
-func (x *User) Reset() {
-	*x = User{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[105]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*User) ProtoMessage() {}
-
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[105]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *User) GetID() string {
+func (x *Secret) GetID() string {
 	if x != nil {
 		return x.ID
 	}
 	return ""
 }
 
-func (x *User) GetName() string {
+func (x *Secret) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *User) GetKID() string {
+func (x *Secret) GetType() SecretType {
 	if x != nil {
-		return x.KID
+		return x.Type
 	}
-	return ""
+	return UnknownSecret
 }
 
-func (x *User) GetSeq() int32 {
+func (x *Secret) GetUsername() string {
 	if x != nil {
-		return x.Seq
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-func (x *User) GetService() string {
+func (x *Secret) GetPassword() string {
 	if x != nil {
-		return x.Service
+		return x.Password
 	}
 	return ""
 }
 
-func (x *User) GetURL() string {
+func (x *Secret) GetURL() string {
 	if x != nil {
 		return x.URL
 	}
 	return ""
 }
 
-func (x *User) GetStatus() UserStatus {
+func (x *Secret) GetNotes() string {
 	if x != nil {
-		return x.Status
+		return x.Notes
 	}
-	return UserStatusUnknown
+	return ""
 }
 
-func (x *User) GetVerifiedAt() int64 {
+func (x *Secret) GetCreatedAt() int64 {
 	if x != nil {
-		return x.VerifiedAt
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *User) GetTimestamp() int64 {
+func (x *Secret) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *User) GetProxied() bool {
-	if x != nil {
-		return x.Proxied
-	}
-	return false
-}
-
-func (x *User) GetErr() string {
-	if x != nil {
-		return x.Err
-	}
-	return ""
-}
-
-type UserRequest struct {
+type SecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID   string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UserRequest) Reset() {
-	*x = UserRequest{}
+func (x *SecretRequest) Reset() {
+	*x = SecretRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[106]
+		mi := &file_rpc_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRequest) String() string {
+func (x *SecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRequest) ProtoMessage() {}
+func (*SecretRequest) ProtoMessage() {}
 
-func (x *UserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[106]
+func (x *SecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6772,50 +7337,43 @@ func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
-func (*UserRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use SecretRequest.ProtoReflect.Descriptor instead.
+func (*SecretRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UserRequest) GetKID() string {
+func (x *SecretRequest) GetID() string {
 	if x != nil {
-		return x.KID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *UserRequest) GetLocal() bool {
-	if x != nil {
-		return x.Local
-	}
-	return false
-}
-
-type UserResponse struct {
+type SecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
+func (x *SecretResponse) Reset() {
+	*x = SecretResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[107]
+		mi := &file_rpc_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserResponse) String() string {
+func (x *SecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*SecretResponse) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[107]
+func (x *SecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6826,45 +7384,43 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use SecretResponse.ProtoReflect.Descriptor instead.
+func (*SecretResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UserResponse) GetUser() *User {
+func (x *SecretResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.User
+		return x.Secret
 	}
 	return nil
 }
 
-type UserSearchRequest struct {
+type SecretSaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *UserSearchRequest) Reset() {
-	*x = UserSearchRequest{}
+func (x *SecretSaveRequest) Reset() {
+	*x = SecretSaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[108]
+		mi := &file_rpc_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSearchRequest) String() string {
+func (x *SecretSaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSearchRequest) ProtoMessage() {}
+func (*SecretSaveRequest) ProtoMessage() {}
 
-func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[108]
+func (x *SecretSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6875,57 +7431,43 @@ func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
-func (*UserSearchRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{108}
-}
-
-func (x *UserSearchRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *UserSearchRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use SecretSaveRequest.ProtoReflect.Descriptor instead.
+func (*SecretSaveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *UserSearchRequest) GetLocal() bool {
+func (x *SecretSaveRequest) GetSecret() *Secret {
 	if x != nil {
-		return x.Local
+		return x.Secret
 	}
-	return false
+	return nil
 }
 
-type UserSearchResponse struct {
+type SecretSaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *UserSearchResponse) Reset() {
-	*x = UserSearchResponse{}
+func (x *SecretSaveResponse) Reset() {
+	*x = SecretSaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[109]
+		mi := &file_rpc_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSearchResponse) String() string {
+func (x *SecretSaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSearchResponse) ProtoMessage() {}
+func (*SecretSaveResponse) ProtoMessage() {}
 
-func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[109]
+func (x *SecretSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6936,43 +7478,43 @@ func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSearchResponse.ProtoReflect.Descriptor instead.
-func (*UserSearchResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use SecretSaveResponse.ProtoReflect.Descriptor instead.
+func (*SecretSaveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *UserSearchResponse) GetUsers() []*User {
+func (x *SecretSaveResponse) GetSecret() *Secret {
 	if x != nil {
-		return x.Users
+		return x.Secret
 	}
 	return nil
 }
 
-type SearchRequest struct {
+type SecretRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SearchRequest) Reset() {
-	*x = SearchRequest{}
+func (x *SecretRemoveRequest) Reset() {
+	*x = SecretRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[110]
+		mi := &file_rpc_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchRequest) String() string {
+func (x *SecretRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchRequest) ProtoMessage() {}
+func (*SecretRemoveRequest) ProtoMessage() {}
 
-func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[110]
+func (x *SecretRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6983,43 +7525,41 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use SecretRemoveRequest.ProtoReflect.Descriptor instead.
+func (*SecretRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *SearchRequest) GetQuery() string {
+func (x *SecretRemoveRequest) GetID() string {
 	if x != nil {
-		return x.Query
+		return x.ID
 	}
 	return ""
 }
 
-type SearchResponse struct {
+type SecretRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keys []*Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *SearchResponse) Reset() {
-	*x = SearchResponse{}
+func (x *SecretRemoveResponse) Reset() {
+	*x = SecretRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[111]
+		mi := &file_rpc_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchResponse) String() string {
+func (x *SecretRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchResponse) ProtoMessage() {}
+func (*SecretRemoveResponse) ProtoMessage() {}
 
-func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[111]
+func (x *SecretRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7030,41 +7570,38 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{111}
-}
-
-func (x *SearchResponse) GetKeys() []*Key {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
+// Deprecated: Use SecretRemoveResponse.ProtoReflect.Descriptor instead.
+func (*SecretRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
-type VaultSyncRequest struct {
+type SecretsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query         string        `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *VaultSyncRequest) Reset() {
-	*x = VaultSyncRequest{}
+func (x *SecretsRequest) Reset() {
+	*x = SecretsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[112]
+		mi := &file_rpc_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultSyncRequest) String() string {
+func (x *SecretsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultSyncRequest) ProtoMessage() {}
+func (*SecretsRequest) ProtoMessage() {}
 
-func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[112]
+func (x *SecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7075,34 +7612,59 @@ func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultSyncRequest.ProtoReflect.Descriptor instead.
-func (*VaultSyncRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use SecretsRequest.ProtoReflect.Descriptor instead.
+func (*SecretsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
-type VaultSyncResponse struct {
+func (x *SecretsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *SecretsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+type SecretsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Secrets       []*Secret     `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	SortField     string        `protobuf:"bytes,10,opt,name=sortField,proto3" json:"sortField,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sortDirection,proto3,enum=service.SortDirection" json:"sortDirection,omitempty"`
 }
 
-func (x *VaultSyncResponse) Reset() {
-	*x = VaultSyncResponse{}
+func (x *SecretsResponse) Reset() {
+	*x = SecretsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[113]
+		mi := &file_rpc_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultSyncResponse) String() string {
+func (x *SecretsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultSyncResponse) ProtoMessage() {}
+func (*SecretsResponse) ProtoMessage() {}
 
-func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[113]
+func (x *SecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7113,34 +7675,63 @@ func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultSyncResponse.ProtoReflect.Descriptor instead.
-func (*VaultSyncResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use SecretsResponse.ProtoReflect.Descriptor instead.
+func (*SecretsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
-type VaultUnsyncRequest struct {
+func (x *SecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *SecretsResponse) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *SecretsResponse) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortAsc
+}
+
+// Group is a named set of recipients, used as @name.
+type Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Members (kid, user@service or @group).
+	Members   []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt int64    `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64    `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *VaultUnsyncRequest) Reset() {
-	*x = VaultUnsyncRequest{}
+func (x *Group) Reset() {
+	*x = Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[114]
+		mi := &file_rpc_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultUnsyncRequest) String() string {
+func (x *Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUnsyncRequest) ProtoMessage() {}
+func (*Group) ProtoMessage() {}
 
-func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[114]
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7151,34 +7742,71 @@ func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUnsyncRequest.ProtoReflect.Descriptor instead.
-func (*VaultUnsyncRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
-type VaultUnsyncResponse struct {
+func (x *Group) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Group) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Group) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *VaultUnsyncResponse) Reset() {
-	*x = VaultUnsyncResponse{}
+func (x *GroupRequest) Reset() {
+	*x = GroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[115]
+		mi := &file_rpc_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultUnsyncResponse) String() string {
+func (x *GroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUnsyncResponse) ProtoMessage() {}
+func (*GroupRequest) ProtoMessage() {}
 
-func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[115]
+func (x *GroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7189,34 +7817,43 @@ func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUnsyncResponse.ProtoReflect.Descriptor instead.
-func (*VaultUnsyncResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
+func (*GroupRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
-type VaultAuthRequest struct {
+func (x *GroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *VaultAuthRequest) Reset() {
-	*x = VaultAuthRequest{}
+func (x *GroupResponse) Reset() {
+	*x = GroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[116]
+		mi := &file_rpc_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultAuthRequest) String() string {
+func (x *GroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultAuthRequest) ProtoMessage() {}
+func (*GroupResponse) ProtoMessage() {}
 
-func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *GroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7227,36 +7864,44 @@ func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultAuthRequest.ProtoReflect.Descriptor instead.
-func (*VaultAuthRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use GroupResponse.ProtoReflect.Descriptor instead.
+func (*GroupResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
-type VaultAuthResponse struct {
+func (x *GroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupSaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *VaultAuthResponse) Reset() {
-	*x = VaultAuthResponse{}
+func (x *GroupSaveRequest) Reset() {
+	*x = GroupSaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[117]
+		mi := &file_rpc_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultAuthResponse) String() string {
+func (x *GroupSaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultAuthResponse) ProtoMessage() {}
+func (*GroupSaveRequest) ProtoMessage() {}
 
-func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *GroupSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7267,41 +7912,50 @@ func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultAuthResponse.ProtoReflect.Descriptor instead.
-func (*VaultAuthResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use GroupSaveRequest.ProtoReflect.Descriptor instead.
+func (*GroupSaveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *VaultAuthResponse) GetPhrase() string {
+func (x *GroupSaveRequest) GetName() string {
 	if x != nil {
-		return x.Phrase
+		return x.Name
 	}
 	return ""
 }
 
-type VaultStatusRequest struct {
+func (x *GroupSaveRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GroupSaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *VaultStatusRequest) Reset() {
-	*x = VaultStatusRequest{}
+func (x *GroupSaveResponse) Reset() {
+	*x = GroupSaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[118]
+		mi := &file_rpc_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultStatusRequest) String() string {
+func (x *GroupSaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultStatusRequest) ProtoMessage() {}
+func (*GroupSaveResponse) ProtoMessage() {}
 
-func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *GroupSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7312,37 +7966,43 @@ func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultStatusRequest.ProtoReflect.Descriptor instead.
-func (*VaultStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use GroupSaveResponse.ProtoReflect.Descriptor instead.
+func (*GroupSaveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
-type VaultStatusResponse struct {
+func (x *GroupSaveResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KID      string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
-	SyncedAt int64  `protobuf:"varint,2,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *VaultStatusResponse) Reset() {
-	*x = VaultStatusResponse{}
+func (x *GroupRemoveRequest) Reset() {
+	*x = GroupRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[119]
+		mi := &file_rpc_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultStatusResponse) String() string {
+func (x *GroupRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultStatusResponse) ProtoMessage() {}
+func (*GroupRemoveRequest) ProtoMessage() {}
 
-func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *GroupRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7353,48 +8013,79 @@ func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultStatusResponse.ProtoReflect.Descriptor instead.
-func (*VaultStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use GroupRemoveRequest.ProtoReflect.Descriptor instead.
+func (*GroupRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *VaultStatusResponse) GetKID() string {
+func (x *GroupRemoveRequest) GetName() string {
 	if x != nil {
-		return x.KID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *VaultStatusResponse) GetSyncedAt() int64 {
-	if x != nil {
-		return x.SyncedAt
+type GroupRemoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GroupRemoveResponse) Reset() {
+	*x = GroupRemoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type VaultUpdateRequest struct {
+func (x *GroupRemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupRemoveResponse) ProtoMessage() {}
+
+func (x *GroupRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupRemoveResponse.ProtoReflect.Descriptor instead.
+func (*GroupRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{117}
+}
+
+type GroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *VaultUpdateRequest) Reset() {
-	*x = VaultUpdateRequest{}
+func (x *GroupsRequest) Reset() {
+	*x = GroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[120]
+		mi := &file_rpc_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultUpdateRequest) String() string {
+func (x *GroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUpdateRequest) ProtoMessage() {}
+func (*GroupsRequest) ProtoMessage() {}
 
-func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[120]
+func (x *GroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7405,34 +8096,36 @@ func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUpdateRequest.ProtoReflect.Descriptor instead.
-func (*VaultUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use GroupsRequest.ProtoReflect.Descriptor instead.
+func (*GroupsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
 }
 
-type VaultUpdateResponse struct {
+type GroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *VaultUpdateResponse) Reset() {
-	*x = VaultUpdateResponse{}
+func (x *GroupsResponse) Reset() {
+	*x = GroupsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[121]
+		mi := &file_rpc_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VaultUpdateResponse) String() string {
+func (x *GroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VaultUpdateResponse) ProtoMessage() {}
+func (*GroupsResponse) ProtoMessage() {}
 
-func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[121]
+func (x *GroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7443,40 +8136,54 @@ func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VaultUpdateResponse.ProtoReflect.Descriptor instead.
-func (*VaultUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use GroupsResponse.ProtoReflect.Descriptor instead.
+func (*GroupsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
 }
 
-type Message struct {
+// Contact is a person (or service) in the address book, with their keys and
+// users. The name can be used as a recipient.
+type Contact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender    *Key          `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Text      []string      `protobuf:"bytes,10,rep,name=text,proto3" json:"text,omitempty"`
-	Status    MessageStatus `protobuf:"varint,20,opt,name=status,proto3,enum=service.MessageStatus" json:"status,omitempty"`
-	CreatedAt int64         `protobuf:"varint,31,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ID   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KIDs []string `protobuf:"bytes,3,rep,name=kids,proto3" json:"kids,omitempty"`
+	// Users (name@service).
+	Users []string `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	Notes string   `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Groups (recipient groups) the contact is in.
+	Groups    []string `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	CreatedAt int64    `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64    `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *Contact) Reset() {
+	*x = Contact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[122]
+		mi := &file_rpc_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Message) String() string {
+func (x *Contact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*Contact) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7487,73 +8194,92 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *Message) GetID() string {
+func (x *Contact) GetID() string {
 	if x != nil {
 		return x.ID
 	}
 	return ""
 }
 
-func (x *Message) GetSender() *Key {
+func (x *Contact) GetName() string {
 	if x != nil {
-		return x.Sender
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetKIDs() []string {
+	if x != nil {
+		return x.KIDs
 	}
 	return nil
 }
 
-func (x *Message) GetText() []string {
+func (x *Contact) GetUsers() []string {
 	if x != nil {
-		return x.Text
+		return x.Users
 	}
 	return nil
 }
 
-func (x *Message) GetStatus() MessageStatus {
+func (x *Contact) GetNotes() string {
 	if x != nil {
-		return x.Status
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Contact) GetGroups() []string {
+	if x != nil {
+		return x.Groups
 	}
-	return MessageSent
+	return nil
 }
 
-func (x *Message) GetCreatedAt() int64 {
+func (x *Contact) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type MessagePrepareRequest struct {
+func (x *Contact) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ContactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Text    string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *MessagePrepareRequest) Reset() {
-	*x = MessagePrepareRequest{}
+func (x *ContactRequest) Reset() {
+	*x = ContactRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[123]
+		mi := &file_rpc_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessagePrepareRequest) String() string {
+func (x *ContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagePrepareRequest) ProtoMessage() {}
+func (*ContactRequest) ProtoMessage() {}
 
-func (x *MessagePrepareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[123]
+func (x *ContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7564,57 +8290,43 @@ func (x *MessagePrepareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagePrepareRequest.ProtoReflect.Descriptor instead.
-func (*MessagePrepareRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{123}
-}
-
-func (x *MessagePrepareRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *MessagePrepareRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
+// Deprecated: Use ContactRequest.ProtoReflect.Descriptor instead.
+func (*ContactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *MessagePrepareRequest) GetText() string {
+func (x *ContactRequest) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-type MessagePrepareResponse struct {
+type ContactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *MessagePrepareResponse) Reset() {
-	*x = MessagePrepareResponse{}
+func (x *ContactResponse) Reset() {
+	*x = ContactResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[124]
+		mi := &file_rpc_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessagePrepareResponse) String() string {
+func (x *ContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagePrepareResponse) ProtoMessage() {}
+func (*ContactResponse) ProtoMessage() {}
 
-func (x *MessagePrepareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[124]
+func (x *ContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7625,48 +8337,44 @@ func (x *MessagePrepareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagePrepareResponse.ProtoReflect.Descriptor instead.
-func (*MessagePrepareResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use ContactResponse.ProtoReflect.Descriptor instead.
+func (*ContactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *MessagePrepareResponse) GetMessage() *Message {
+func (x *ContactResponse) GetContact() *Contact {
 	if x != nil {
-		return x.Message
+		return x.Contact
 	}
 	return nil
 }
 
-type MessageCreateRequest struct {
+type ContactSaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	// ID is optional, if message was prepared you can use that ID, otherwise it
-	// is autogenerated.
-	ID   string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Text string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	// Contact to save, creates the contact if ID is empty.
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *MessageCreateRequest) Reset() {
-	*x = MessageCreateRequest{}
+func (x *ContactSaveRequest) Reset() {
+	*x = ContactSaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[125]
+		mi := &file_rpc_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageCreateRequest) String() string {
+func (x *ContactSaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageCreateRequest) ProtoMessage() {}
+func (*ContactSaveRequest) ProtoMessage() {}
 
-func (x *MessageCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+func (x *ContactSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7677,64 +8385,43 @@ func (x *MessageCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageCreateRequest.ProtoReflect.Descriptor instead.
-func (*MessageCreateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
-}
-
-func (x *MessageCreateRequest) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *MessageCreateRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *MessageCreateRequest) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
+// Deprecated: Use ContactSaveRequest.ProtoReflect.Descriptor instead.
+func (*ContactSaveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *MessageCreateRequest) GetText() string {
+func (x *ContactSaveRequest) GetContact() *Contact {
 	if x != nil {
-		return x.Text
+		return x.Contact
 	}
-	return ""
+	return nil
 }
 
-type MessageCreateResponse struct {
+type ContactSaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *MessageCreateResponse) Reset() {
-	*x = MessageCreateResponse{}
+func (x *ContactSaveResponse) Reset() {
+	*x = ContactSaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[126]
+		mi := &file_rpc_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageCreateResponse) String() string {
+func (x *ContactSaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageCreateResponse) ProtoMessage() {}
+func (*ContactSaveResponse) ProtoMessage() {}
 
-func (x *MessageCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *ContactSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7745,45 +8432,43 @@ func (x *MessageCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageCreateResponse.ProtoReflect.Descriptor instead.
-func (*MessageCreateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use ContactSaveResponse.ProtoReflect.Descriptor instead.
+func (*ContactSaveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *MessageCreateResponse) GetMessage() *Message {
+func (x *ContactSaveResponse) GetContact() *Contact {
 	if x != nil {
-		return x.Message
+		return x.Contact
 	}
 	return nil
 }
 
-type MessagesRequest struct {
+type ContactRemoveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// Update, if true will update from the server.
-	Update bool `protobuf:"varint,5,opt,name=update,proto3" json:"update,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *MessagesRequest) Reset() {
-	*x = MessagesRequest{}
+func (x *ContactRemoveRequest) Reset() {
+	*x = ContactRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[127]
+		mi := &file_rpc_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessagesRequest) String() string {
+func (x *ContactRemoveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagesRequest) ProtoMessage() {}
+func (*ContactRemoveRequest) ProtoMessage() {}
 
-func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *ContactRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7794,50 +8479,41 @@ func (x *MessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagesRequest.ProtoReflect.Descriptor instead.
-func (*MessagesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use ContactRemoveRequest.ProtoReflect.Descriptor instead.
+func (*ContactRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *MessagesRequest) GetChannel() string {
+func (x *ContactRemoveRequest) GetName() string {
 	if x != nil {
-		return x.Channel
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MessagesRequest) GetUpdate() bool {
-	if x != nil {
-		return x.Update
-	}
-	return false
-}
-
-type MessagesResponse struct {
+type ContactRemoveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Messages []*Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-func (x *MessagesResponse) Reset() {
-	*x = MessagesResponse{}
+func (x *ContactRemoveResponse) Reset() {
+	*x = ContactRemoveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[128]
+		mi := &file_rpc_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessagesResponse) String() string {
+func (x *ContactRemoveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessagesResponse) ProtoMessage() {}
+func (*ContactRemoveResponse) ProtoMessage() {}
 
-func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+func (x *ContactRemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7848,44 +8524,37 @@ func (x *MessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessagesResponse.ProtoReflect.Descriptor instead.
-func (*MessagesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
-}
-
-func (x *MessagesResponse) GetMessages() []*Message {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
+// Deprecated: Use ContactRemoveResponse.ProtoReflect.Descriptor instead.
+func (*ContactRemoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
-type RelayRequest struct {
+type ContactsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
-	User string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Query (name, kid or user).
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *RelayRequest) Reset() {
-	*x = RelayRequest{}
+func (x *ContactsRequest) Reset() {
+	*x = ContactsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[129]
+		mi := &file_rpc_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelayRequest) String() string {
+func (x *ContactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelayRequest) ProtoMessage() {}
+func (*ContactsRequest) ProtoMessage() {}
 
-func (x *RelayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *ContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7896,52 +8565,43 @@ func (x *RelayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelayRequest.ProtoReflect.Descriptor instead.
-func (*RelayRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
-}
-
-func (x *RelayRequest) GetKeys() []string {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
+// Deprecated: Use ContactsRequest.ProtoReflect.Descriptor instead.
+func (*ContactsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *RelayRequest) GetUser() string {
+func (x *ContactsRequest) GetQuery() string {
 	if x != nil {
-		return x.User
+		return x.Query
 	}
 	return ""
 }
 
-type RelayOutput struct {
+type ContactsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Index   int64  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Contacts []*Contact `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
 }
 
-func (x *RelayOutput) Reset() {
-	*x = RelayOutput{}
+func (x *ContactsResponse) Reset() {
+	*x = ContactsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[130]
+		mi := &file_rpc_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RelayOutput) String() string {
+func (x *ContactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelayOutput) ProtoMessage() {}
+func (*ContactsResponse) ProtoMessage() {}
 
-func (x *RelayOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *ContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7952,62 +8612,50 @@ func (x *RelayOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelayOutput.ProtoReflect.Descriptor instead.
-func (*RelayOutput) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
-}
-
-func (x *RelayOutput) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *RelayOutput) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
+// Deprecated: Use ContactsResponse.ProtoReflect.Descriptor instead.
+func (*ContactsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RelayOutput) GetIndex() int64 {
+func (x *ContactsResponse) GetContacts() []*Contact {
 	if x != nil {
-		return x.Index
+		return x.Contacts
 	}
-	return 0
+	return nil
 }
 
-type Channel struct {
+// Pin is a pinned (trusted) key for a contact.
+type Pin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Snippet   string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
-	UpdatedAt int64  `protobuf:"varint,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Index     int64  `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
-	ReadIndex int64  `protobuf:"varint,10,opt,name=readIndex,proto3" json:"readIndex,omitempty"`
+	KID string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// User (name@service) the key was pinned for.
+	User      string     `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Method    PinMethod  `protobuf:"varint,3,opt,name=method,proto3,enum=service.PinMethod" json:"method,omitempty"`
+	Level     TrustLevel `protobuf:"varint,4,opt,name=level,proto3,enum=service.TrustLevel" json:"level,omitempty"`
+	CreatedAt int64      `protobuf:"varint,100,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64      `protobuf:"varint,101,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *Channel) Reset() {
-	*x = Channel{}
+func (x *Pin) Reset() {
+	*x = Pin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[131]
+		mi := &file_rpc_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Channel) String() string {
+func (x *Pin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Channel) ProtoMessage() {}
+func (*Pin) ProtoMessage() {}
 
-func (x *Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *Pin) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8018,78 +8666,82 @@ func (x *Channel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
-func (*Channel) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use Pin.ProtoReflect.Descriptor instead.
+func (*Pin) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *Channel) GetID() string {
+func (x *Pin) GetKID() string {
 	if x != nil {
-		return x.ID
+		return x.KID
 	}
 	return ""
 }
 
-func (x *Channel) GetName() string {
+func (x *Pin) GetUser() string {
 	if x != nil {
-		return x.Name
+		return x.User
 	}
 	return ""
 }
 
-func (x *Channel) GetSnippet() string {
+func (x *Pin) GetMethod() PinMethod {
 	if x != nil {
-		return x.Snippet
+		return x.Method
 	}
-	return ""
+	return PinNone
 }
 
-func (x *Channel) GetUpdatedAt() int64 {
+func (x *Pin) GetLevel() TrustLevel {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Level
 	}
-	return 0
+	return TrustUnknown
 }
 
-func (x *Channel) GetIndex() int64 {
+func (x *Pin) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Index
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *Channel) GetReadIndex() int64 {
+func (x *Pin) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.ReadIndex
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-type ChannelsRequest struct {
+type TrustPinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Key (kid or user@service).
+	Key    string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Method PinMethod `protobuf:"varint,2,opt,name=method,proto3,enum=service.PinMethod" json:"method,omitempty"`
+	// Level, defaults to full for in person or wormhole, marginal otherwise.
+	Level TrustLevel `protobuf:"varint,3,opt,name=level,proto3,enum=service.TrustLevel" json:"level,omitempty"`
 }
 
-func (x *ChannelsRequest) Reset() {
-	*x = ChannelsRequest{}
+func (x *TrustPinRequest) Reset() {
+	*x = TrustPinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[132]
+		mi := &file_rpc_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelsRequest) String() string {
+func (x *TrustPinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelsRequest) ProtoMessage() {}
+func (*TrustPinRequest) ProtoMessage() {}
 
-func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *TrustPinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8100,43 +8752,57 @@ func (x *ChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelsRequest.ProtoReflect.Descriptor instead.
-func (*ChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use TrustPinRequest.ProtoReflect.Descriptor instead.
+func (*TrustPinRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ChannelsRequest) GetUser() string {
+func (x *TrustPinRequest) GetKey() string {
 	if x != nil {
-		return x.User
+		return x.Key
 	}
 	return ""
 }
 
-type ChannelsResponse struct {
+func (x *TrustPinRequest) GetMethod() PinMethod {
+	if x != nil {
+		return x.Method
+	}
+	return PinNone
+}
+
+func (x *TrustPinRequest) GetLevel() TrustLevel {
+	if x != nil {
+		return x.Level
+	}
+	return TrustUnknown
+}
+
+type TrustPinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Pin *Pin `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
 }
 
-func (x *ChannelsResponse) Reset() {
-	*x = ChannelsResponse{}
+func (x *TrustPinResponse) Reset() {
+	*x = TrustPinResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[133]
+		mi := &file_rpc_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelsResponse) String() string {
+func (x *TrustPinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelsResponse) ProtoMessage() {}
+func (*TrustPinResponse) ProtoMessage() {}
 
-func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *TrustPinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8147,44 +8813,44 @@ func (x *ChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelsResponse.ProtoReflect.Descriptor instead.
-func (*ChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use TrustPinResponse.ProtoReflect.Descriptor instead.
+func (*TrustPinResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *ChannelsResponse) GetChannels() []*Channel {
+func (x *TrustPinResponse) GetPin() *Pin {
 	if x != nil {
-		return x.Channels
+		return x.Pin
 	}
 	return nil
 }
 
-type ChannelCreateRequest struct {
+type TrustUnpinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Key (kid or user@service).
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *ChannelCreateRequest) Reset() {
-	*x = ChannelCreateRequest{}
+func (x *TrustUnpinRequest) Reset() {
+	*x = TrustUnpinRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[134]
+		mi := &file_rpc_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelCreateRequest) String() string {
+func (x *TrustUnpinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCreateRequest) ProtoMessage() {}
+func (*TrustUnpinRequest) ProtoMessage() {}
 
-func (x *ChannelCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *TrustUnpinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8195,35 +8861,104 @@ func (x *ChannelCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCreateRequest.ProtoReflect.Descriptor instead.
-func (*ChannelCreateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use TrustUnpinRequest.ProtoReflect.Descriptor instead.
+func (*TrustUnpinRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ChannelCreateRequest) GetName() string {
+func (x *TrustUnpinRequest) GetKey() string {
 	if x != nil {
-		return x.Name
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ChannelCreateRequest) GetUser() string {
-	if x != nil {
-		return x.User
+type TrustUnpinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrustUnpinResponse) Reset() {
+	*x = TrustUnpinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type ChannelCreateResponse struct {
+func (x *TrustUnpinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustUnpinResponse) ProtoMessage() {}
+
+func (x *TrustUnpinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustUnpinResponse.ProtoReflect.Descriptor instead.
+func (*TrustUnpinResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{133}
+}
+
+type PinsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinsRequest) Reset() {
+	*x = PinsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinsRequest) ProtoMessage() {}
+
+func (x *PinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinsRequest.ProtoReflect.Descriptor instead.
+func (*PinsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{134}
+}
+
+type PinsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel *Channel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pins []*Pin `protobuf:"bytes,1,rep,name=pins,proto3" json:"pins,omitempty"`
 }
 
-func (x *ChannelCreateResponse) Reset() {
-	*x = ChannelCreateResponse{}
+func (x *PinsResponse) Reset() {
+	*x = PinsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8231,13 +8966,13 @@ func (x *ChannelCreateResponse) Reset() {
 	}
 }
 
-func (x *ChannelCreateResponse) String() string {
+func (x *PinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCreateResponse) ProtoMessage() {}
+func (*PinsResponse) ProtoMessage() {}
 
-func (x *ChannelCreateResponse) ProtoReflect() protoreflect.Message {
+func (x *PinsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8249,28 +8984,31 @@ func (x *ChannelCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCreateResponse.ProtoReflect.Descriptor instead.
-func (*ChannelCreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinsResponse.ProtoReflect.Descriptor instead.
+func (*PinsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ChannelCreateResponse) GetChannel() *Channel {
+func (x *PinsResponse) GetPins() []*Pin {
 	if x != nil {
-		return x.Channel
+		return x.Pins
 	}
 	return nil
 }
 
-type ChannelLeaveRequest struct {
+type RandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	NumBytes  int32    `protobuf:"varint,1,opt,name=numBytes,proto3" json:"numBytes,omitempty"`
+	Encoding  Encoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=service.Encoding" json:"encoding,omitempty"`
+	NoPadding bool     `protobuf:"varint,10,opt,name=noPadding,proto3" json:"noPadding,omitempty"`
+	Lowercase bool     `protobuf:"varint,11,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
 }
 
-func (x *ChannelLeaveRequest) Reset() {
-	*x = ChannelLeaveRequest{}
+func (x *RandRequest) Reset() {
+	*x = RandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8278,13 +9016,13 @@ func (x *ChannelLeaveRequest) Reset() {
 	}
 }
 
-func (x *ChannelLeaveRequest) String() string {
+func (x *RandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelLeaveRequest) ProtoMessage() {}
+func (*RandRequest) ProtoMessage() {}
 
-func (x *ChannelLeaveRequest) ProtoReflect() protoreflect.Message {
+func (x *RandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8296,26 +9034,49 @@ func (x *ChannelLeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelLeaveRequest.ProtoReflect.Descriptor instead.
-func (*ChannelLeaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandRequest.ProtoReflect.Descriptor instead.
+func (*RandRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ChannelLeaveRequest) GetChannel() string {
+func (x *RandRequest) GetNumBytes() int32 {
 	if x != nil {
-		return x.Channel
+		return x.NumBytes
 	}
-	return ""
+	return 0
+}
+
+func (x *RandRequest) GetEncoding() Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return Hex
+}
+
+func (x *RandRequest) GetNoPadding() bool {
+	if x != nil {
+		return x.NoPadding
+	}
+	return false
+}
+
+func (x *RandRequest) GetLowercase() bool {
+	if x != nil {
+		return x.Lowercase
+	}
+	return false
 }
 
-type ChannelLeaveResponse struct {
+type RandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ChannelLeaveResponse) Reset() {
-	*x = ChannelLeaveResponse{}
+func (x *RandResponse) Reset() {
+	*x = RandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8323,13 +9084,13 @@ func (x *ChannelLeaveResponse) Reset() {
 	}
 }
 
-func (x *ChannelLeaveResponse) String() string {
+func (x *RandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelLeaveResponse) ProtoMessage() {}
+func (*RandResponse) ProtoMessage() {}
 
-func (x *ChannelLeaveResponse) ProtoReflect() protoreflect.Message {
+func (x *RandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8341,22 +9102,28 @@ func (x *ChannelLeaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelLeaveResponse.ProtoReflect.Descriptor instead.
-func (*ChannelLeaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandResponse.ProtoReflect.Descriptor instead.
+func (*RandResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
-type ChannelReadRequest struct {
+func (x *RandResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type RandPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Index   int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
 }
 
-func (x *ChannelReadRequest) Reset() {
-	*x = ChannelReadRequest{}
+func (x *RandPasswordRequest) Reset() {
+	*x = RandPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8364,13 +9131,13 @@ func (x *ChannelReadRequest) Reset() {
 	}
 }
 
-func (x *ChannelReadRequest) String() string {
+func (x *RandPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelReadRequest) ProtoMessage() {}
+func (*RandPasswordRequest) ProtoMessage() {}
 
-func (x *ChannelReadRequest) ProtoReflect() protoreflect.Message {
+func (x *RandPasswordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8382,33 +9149,28 @@ func (x *ChannelReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelReadRequest.ProtoReflect.Descriptor instead.
-func (*ChannelReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandPasswordRequest.ProtoReflect.Descriptor instead.
+func (*RandPasswordRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *ChannelReadRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *ChannelReadRequest) GetIndex() int64 {
+func (x *RandPasswordRequest) GetLength() int32 {
 	if x != nil {
-		return x.Index
+		return x.Length
 	}
 	return 0
 }
 
-type ChannelReadResponse struct {
+type RandPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *ChannelReadResponse) Reset() {
-	*x = ChannelReadResponse{}
+func (x *RandPasswordResponse) Reset() {
+	*x = RandPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8416,13 +9178,13 @@ func (x *ChannelReadResponse) Reset() {
 	}
 }
 
-func (x *ChannelReadResponse) String() string {
+func (x *RandPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelReadResponse) ProtoMessage() {}
+func (*RandPasswordResponse) ProtoMessage() {}
 
-func (x *ChannelReadResponse) ProtoReflect() protoreflect.Message {
+func (x *RandPasswordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8434,23 +9196,28 @@ func (x *ChannelReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelReadResponse.ProtoReflect.Descriptor instead.
-func (*ChannelReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RandPasswordResponse.ProtoReflect.Descriptor instead.
+func (*RandPasswordResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
-type ChannelInviteRequest struct {
+func (x *RandPasswordResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type PullRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel    string   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Recipients []string `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	Sender     string   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *ChannelInviteRequest) Reset() {
-	*x = ChannelInviteRequest{}
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8458,13 +9225,13 @@ func (x *ChannelInviteRequest) Reset() {
 	}
 }
 
-func (x *ChannelInviteRequest) String() string {
+func (x *PullRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInviteRequest) ProtoMessage() {}
+func (*PullRequest) ProtoMessage() {}
 
-func (x *ChannelInviteRequest) ProtoReflect() protoreflect.Message {
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8476,42 +9243,28 @@ func (x *ChannelInviteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInviteRequest.ProtoReflect.Descriptor instead.
-func (*ChannelInviteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *ChannelInviteRequest) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *ChannelInviteRequest) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
-}
-
-func (x *ChannelInviteRequest) GetSender() string {
+func (x *PullRequest) GetKey() string {
 	if x != nil {
-		return x.Sender
+		return x.Key
 	}
 	return ""
 }
 
-type ChannelInviteResponse struct {
+type PullResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	KIDs []string `protobuf:"bytes,1,rep,name=kids,proto3" json:"kids,omitempty"`
 }
 
-func (x *ChannelInviteResponse) Reset() {
-	*x = ChannelInviteResponse{}
+func (x *PullResponse) Reset() {
+	*x = PullResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8519,13 +9272,13 @@ func (x *ChannelInviteResponse) Reset() {
 	}
 }
 
-func (x *ChannelInviteResponse) String() string {
+func (x *PullResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelInviteResponse) ProtoMessage() {}
+func (*PullResponse) ProtoMessage() {}
 
-func (x *ChannelInviteResponse) ProtoReflect() protoreflect.Message {
+func (x *PullResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8537,29 +9290,29 @@ func (x *ChannelInviteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelInviteResponse.ProtoReflect.Descriptor instead.
-func (*ChannelInviteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PullResponse.ProtoReflect.Descriptor instead.
+func (*PullResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *ChannelInviteResponse) GetMessage() *Message {
+func (x *PullResponse) GetKIDs() []string {
 	if x != nil {
-		return x.Message
+		return x.KIDs
 	}
 	return nil
 }
 
-type Follow struct {
+type PushRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RemoteCheck bool   `protobuf:"varint,2,opt,name=remoteCheck,proto3" json:"remoteCheck,omitempty"`
 }
 
-func (x *Follow) Reset() {
-	*x = Follow{}
+func (x *PushRequest) Reset() {
+	*x = PushRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8567,13 +9320,13 @@ func (x *Follow) Reset() {
 	}
 }
 
-func (x *Follow) String() string {
+func (x *PushRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Follow) ProtoMessage() {}
+func (*PushRequest) ProtoMessage() {}
 
-func (x *Follow) ProtoReflect() protoreflect.Message {
+func (x *PushRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8585,36 +9338,36 @@ func (x *Follow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
-func (*Follow) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushRequest.ProtoReflect.Descriptor instead.
+func (*PushRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *Follow) GetRecipient() string {
+func (x *PushRequest) GetKey() string {
 	if x != nil {
-		return x.Recipient
+		return x.Key
 	}
 	return ""
 }
 
-func (x *Follow) GetSender() string {
+func (x *PushRequest) GetRemoteCheck() bool {
 	if x != nil {
-		return x.Sender
+		return x.RemoteCheck
 	}
-	return ""
+	return false
 }
 
-type FollowRequest struct {
+type PushResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	KID  string   `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	URLs []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
 }
 
-func (x *FollowRequest) Reset() {
-	*x = FollowRequest{}
+func (x *PushResponse) Reset() {
+	*x = PushResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8622,13 +9375,13 @@ func (x *FollowRequest) Reset() {
 	}
 }
 
-func (x *FollowRequest) String() string {
+func (x *PushResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowRequest) ProtoMessage() {}
+func (*PushResponse) ProtoMessage() {}
 
-func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+func (x *PushResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8640,35 +9393,35 @@ func (x *FollowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
-func (*FollowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushResponse.ProtoReflect.Descriptor instead.
+func (*PushResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *FollowRequest) GetRecipient() string {
+func (x *PushResponse) GetKID() string {
 	if x != nil {
-		return x.Recipient
+		return x.KID
 	}
 	return ""
 }
 
-func (x *FollowRequest) GetSender() string {
+func (x *PushResponse) GetURLs() []string {
 	if x != nil {
-		return x.Sender
+		return x.URLs
 	}
-	return ""
+	return nil
 }
 
-type FollowResponse struct {
+type Collection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Follow *Follow `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *FollowResponse) Reset() {
-	*x = FollowResponse{}
+func (x *Collection) Reset() {
+	*x = Collection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8676,13 +9429,13 @@ func (x *FollowResponse) Reset() {
 	}
 }
 
-func (x *FollowResponse) String() string {
+func (x *Collection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowResponse) ProtoMessage() {}
+func (*Collection) ProtoMessage() {}
 
-func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+func (x *Collection) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8694,29 +9447,30 @@ func (x *FollowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
-func (*FollowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *FollowResponse) GetFollow() *Follow {
+func (x *Collection) GetPath() string {
 	if x != nil {
-		return x.Follow
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type FollowsRequest struct {
+type CollectionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Update    bool   `protobuf:"varint,2,opt,name=update,proto3" json:"update,omitempty"`
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// DB is "service" or "vault".
+	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *FollowsRequest) Reset() {
-	*x = FollowsRequest{}
+func (x *CollectionsRequest) Reset() {
+	*x = CollectionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8724,13 +9478,13 @@ func (x *FollowsRequest) Reset() {
 	}
 }
 
-func (x *FollowsRequest) String() string {
+func (x *CollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowsRequest) ProtoMessage() {}
+func (*CollectionsRequest) ProtoMessage() {}
 
-func (x *FollowsRequest) ProtoReflect() protoreflect.Message {
+func (x *CollectionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8742,35 +9496,35 @@ func (x *FollowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowsRequest.ProtoReflect.Descriptor instead.
-func (*FollowsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionsRequest.ProtoReflect.Descriptor instead.
+func (*CollectionsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *FollowsRequest) GetRecipient() string {
+func (x *CollectionsRequest) GetParent() string {
 	if x != nil {
-		return x.Recipient
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *FollowsRequest) GetUpdate() bool {
+func (x *CollectionsRequest) GetDB() string {
 	if x != nil {
-		return x.Update
+		return x.DB
 	}
-	return false
+	return ""
 }
 
-type FollowsResponse struct {
+type CollectionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Follows []*Follow `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
 }
 
-func (x *FollowsResponse) Reset() {
-	*x = FollowsResponse{}
+func (x *CollectionsResponse) Reset() {
+	*x = CollectionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8778,13 +9532,13 @@ func (x *FollowsResponse) Reset() {
 	}
 }
 
-func (x *FollowsResponse) String() string {
+func (x *CollectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowsResponse) ProtoMessage() {}
+func (*CollectionsResponse) ProtoMessage() {}
 
-func (x *FollowsResponse) ProtoReflect() protoreflect.Message {
+func (x *CollectionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8796,30 +9550,31 @@ func (x *FollowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowsResponse.ProtoReflect.Descriptor instead.
-func (*FollowsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionsResponse.ProtoReflect.Descriptor instead.
+func (*CollectionsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *FollowsResponse) GetFollows() []*Follow {
+func (x *CollectionsResponse) GetCollections() []*Collection {
 	if x != nil {
-		return x.Follows
+		return x.Collections
 	}
 	return nil
 }
 
-type AdminSignURLRequest struct {
+type Document struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	URL    string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 }
 
-func (x *AdminSignURLRequest) Reset() {
-	*x = AdminSignURLRequest{}
+func (x *Document) Reset() {
+	*x = Document{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8827,13 +9582,13 @@ func (x *AdminSignURLRequest) Reset() {
 	}
 }
 
-func (x *AdminSignURLRequest) String() string {
+func (x *Document) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSignURLRequest) ProtoMessage() {}
+func (*Document) ProtoMessage() {}
 
-func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
+func (x *Document) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8845,44 +9600,51 @@ func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSignURLRequest.ProtoReflect.Descriptor instead.
-func (*AdminSignURLRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *AdminSignURLRequest) GetSigner() string {
+func (x *Document) GetPath() string {
 	if x != nil {
-		return x.Signer
+		return x.Path
 	}
 	return ""
 }
 
-func (x *AdminSignURLRequest) GetMethod() string {
+func (x *Document) GetValue() string {
 	if x != nil {
-		return x.Method
+		return x.Value
 	}
 	return ""
 }
 
-func (x *AdminSignURLRequest) GetURL() string {
+func (x *Document) GetCreatedAt() int64 {
 	if x != nil {
-		return x.URL
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-type AdminSignURLResponse struct {
+func (x *Document) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type DocumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth string `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
-	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	CURL string `protobuf:"bytes,3,opt,name=curl,proto3" json:"curl,omitempty"`
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// DB is "service" or "vault".
+	DB string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *AdminSignURLResponse) Reset() {
-	*x = AdminSignURLResponse{}
+func (x *DocumentsRequest) Reset() {
+	*x = DocumentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8890,13 +9652,13 @@ func (x *AdminSignURLResponse) Reset() {
 	}
 }
 
-func (x *AdminSignURLResponse) String() string {
+func (x *DocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminSignURLResponse) ProtoMessage() {}
+func (*DocumentsRequest) ProtoMessage() {}
 
-func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
+func (x *DocumentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8908,43 +9670,35 @@ func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminSignURLResponse.ProtoReflect.Descriptor instead.
-func (*AdminSignURLResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentsRequest.ProtoReflect.Descriptor instead.
+func (*DocumentsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *AdminSignURLResponse) GetAuth() string {
-	if x != nil {
-		return x.Auth
-	}
-	return ""
-}
-
-func (x *AdminSignURLResponse) GetURL() string {
+func (x *DocumentsRequest) GetPrefix() string {
 	if x != nil {
-		return x.URL
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *AdminSignURLResponse) GetCURL() string {
+func (x *DocumentsRequest) GetDB() string {
 	if x != nil {
-		return x.CURL
+		return x.DB
 	}
 	return ""
 }
 
-type AdminCheckRequest struct {
+type DocumentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Check  string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	Documents []*Document `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 }
 
-func (x *AdminCheckRequest) Reset() {
-	*x = AdminCheckRequest{}
+func (x *DocumentsResponse) Reset() {
+	*x = DocumentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8952,13 +9706,13 @@ func (x *AdminCheckRequest) Reset() {
 	}
 }
 
-func (x *AdminCheckRequest) String() string {
+func (x *DocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCheckRequest) ProtoMessage() {}
+func (*DocumentsResponse) ProtoMessage() {}
 
-func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *DocumentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8970,33 +9724,29 @@ func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCheckRequest.ProtoReflect.Descriptor instead.
-func (*AdminCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentsResponse.ProtoReflect.Descriptor instead.
+func (*DocumentsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *AdminCheckRequest) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
-}
-
-func (x *AdminCheckRequest) GetCheck() string {
+func (x *DocumentsResponse) GetDocuments() []*Document {
 	if x != nil {
-		return x.Check
+		return x.Documents
 	}
-	return ""
+	return nil
 }
 
-type AdminCheckResponse struct {
+type DocumentDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	DB   string `protobuf:"bytes,5,opt,name=db,proto3" json:"db,omitempty"`
 }
 
-func (x *AdminCheckResponse) Reset() {
-	*x = AdminCheckResponse{}
+func (x *DocumentDeleteRequest) Reset() {
+	*x = DocumentDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9004,13 +9754,13 @@ func (x *AdminCheckResponse) Reset() {
 	}
 }
 
-func (x *AdminCheckResponse) String() string {
+func (x *DocumentDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminCheckResponse) ProtoMessage() {}
+func (*DocumentDeleteRequest) ProtoMessage() {}
 
-func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *DocumentDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9022,23 +9772,33 @@ func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminCheckResponse.ProtoReflect.Descriptor instead.
-func (*AdminCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentDeleteRequest.ProtoReflect.Descriptor instead.
+func (*DocumentDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
-type Config struct {
+func (x *DocumentDeleteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DocumentDeleteRequest) GetDB() string {
+	if x != nil {
+		return x.DB
+	}
+	return ""
+}
+
+type DocumentDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	App     *Config_App     `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
-	Encrypt *Config_Encrypt `protobuf:"bytes,10,opt,name=encrypt,proto3" json:"encrypt,omitempty"`
-	Sign    *Config_Sign    `protobuf:"bytes,11,opt,name=sign,proto3" json:"sign,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *DocumentDeleteResponse) Reset() {
+	*x = DocumentDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9046,13 +9806,13 @@ func (x *Config) Reset() {
 	}
 }
 
-func (x *Config) String() string {
+func (x *DocumentDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*DocumentDeleteResponse) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *DocumentDeleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9064,42 +9824,31 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use DocumentDeleteResponse.ProtoReflect.Descriptor instead.
+func (*DocumentDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *Config) GetApp() *Config_App {
-	if x != nil {
-		return x.App
-	}
-	return nil
-}
-
-func (x *Config) GetEncrypt() *Config_Encrypt {
-	if x != nil {
-		return x.Encrypt
-	}
-	return nil
-}
-
-func (x *Config) GetSign() *Config_Sign {
-	if x != nil {
-		return x.Sign
-	}
-	return nil
-}
-
-type ConfigGetRequest struct {
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ID         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KID        string     `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	Seq        int32      `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`
+	Service    string     `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	URL        string     `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Status     UserStatus `protobuf:"varint,10,opt,name=status,proto3,enum=service.UserStatus" json:"status,omitempty"`
+	VerifiedAt int64      `protobuf:"varint,11,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
+	Timestamp  int64      `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Proxied    bool       `protobuf:"varint,13,opt,name=proxied,proto3" json:"proxied,omitempty"`
+	Err        string     `protobuf:"bytes,20,opt,name=err,proto3" json:"err,omitempty"`
 }
 
-func (x *ConfigGetRequest) Reset() {
-	*x = ConfigGetRequest{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9107,13 +9856,13 @@ func (x *ConfigGetRequest) Reset() {
 	}
 }
 
-func (x *ConfigGetRequest) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigGetRequest) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9125,28 +9874,102 @@ func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigGetRequest.ProtoReflect.Descriptor instead.
-func (*ConfigGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *ConfigGetRequest) GetName() string {
+func (x *User) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ConfigGetResponse struct {
+func (x *User) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+func (x *User) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *User) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *User) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *User) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatusUnknown
+}
+
+func (x *User) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *User) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *User) GetProxied() bool {
+	if x != nil {
+		return x.Proxied
+	}
+	return false
+}
+
+func (x *User) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+// UserStatusEvent is a user status change, from the key server checks.
+type UserStatusEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	User      string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Status    UserStatus `protobuf:"varint,2,opt,name=status,proto3,enum=service.UserStatus" json:"status,omitempty"`
+	Err       string     `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Timestamp int64      `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ConfigGetResponse) Reset() {
-	*x = ConfigGetResponse{}
+func (x *UserStatusEvent) Reset() {
+	*x = UserStatusEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[153]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9154,13 +9977,13 @@ func (x *ConfigGetResponse) Reset() {
 	}
 }
 
-func (x *ConfigGetResponse) String() string {
+func (x *UserStatusEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigGetResponse) ProtoMessage() {}
+func (*UserStatusEvent) ProtoMessage() {}
 
-func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
+func (x *UserStatusEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[153]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9172,29 +9995,52 @@ func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigGetResponse.ProtoReflect.Descriptor instead.
-func (*ConfigGetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserStatusEvent.ProtoReflect.Descriptor instead.
+func (*UserStatusEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *ConfigGetResponse) GetConfig() *Config {
+func (x *UserStatusEvent) GetUser() string {
 	if x != nil {
-		return x.Config
+		return x.User
 	}
-	return nil
+	return ""
 }
 
-type ConfigSetRequest struct {
+func (x *UserStatusEvent) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatusUnknown
+}
+
+func (x *UserStatusEvent) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *UserStatusEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type UserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Config *Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	KID   string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	// History of user status changes (from the key server).
+	History bool `protobuf:"varint,5,opt,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *ConfigSetRequest) Reset() {
-	*x = ConfigSetRequest{}
+func (x *UserRequest) Reset() {
+	*x = UserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9202,13 +10048,13 @@ func (x *ConfigSetRequest) Reset() {
 	}
 }
 
-func (x *ConfigSetRequest) String() string {
+func (x *UserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSetRequest) ProtoMessage() {}
+func (*UserRequest) ProtoMessage() {}
 
-func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
+func (x *UserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9220,33 +10066,43 @@ func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSetRequest.ProtoReflect.Descriptor instead.
-func (*ConfigSetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRequest.ProtoReflect.Descriptor instead.
+func (*UserRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *ConfigSetRequest) GetName() string {
+func (x *UserRequest) GetKID() string {
 	if x != nil {
-		return x.Name
+		return x.KID
 	}
 	return ""
 }
 
-func (x *ConfigSetRequest) GetConfig() *Config {
+func (x *UserRequest) GetLocal() bool {
 	if x != nil {
-		return x.Config
+		return x.Local
 	}
-	return nil
+	return false
 }
 
-type ConfigSetResponse struct {
+func (x *UserRequest) GetHistory() bool {
+	if x != nil {
+		return x.History
+	}
+	return false
+}
+
+type UserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	User    *User              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	History []*UserStatusEvent `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *ConfigSetResponse) Reset() {
-	*x = ConfigSetResponse{}
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9254,13 +10110,13 @@ func (x *ConfigSetResponse) Reset() {
 	}
 }
 
-func (x *ConfigSetResponse) String() string {
+func (x *UserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSetResponse) ProtoMessage() {}
+func (*UserResponse) ProtoMessage() {}
 
-func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9272,25 +10128,37 @@ func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSetResponse.ProtoReflect.Descriptor instead.
-func (*ConfigSetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
-type WormholeInput struct {
+func (x *UserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserResponse) GetHistory() []*UserStatusEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type UserSearchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Invite    string `protobuf:"bytes,3,opt,name=invite,proto3" json:"invite,omitempty"`
-	ID        string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Text      string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Local bool   `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
 }
 
-func (x *WormholeInput) Reset() {
-	*x = WormholeInput{}
+func (x *UserSearchRequest) Reset() {
+	*x = UserSearchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9298,13 +10166,13 @@ func (x *WormholeInput) Reset() {
 	}
 }
 
-func (x *WormholeInput) String() string {
+func (x *UserSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeInput) ProtoMessage() {}
+func (*UserSearchRequest) ProtoMessage() {}
 
-func (x *WormholeInput) ProtoReflect() protoreflect.Message {
+func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9316,61 +10184,42 @@ func (x *WormholeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeInput.ProtoReflect.Descriptor instead.
-func (*WormholeInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
+func (*UserSearchRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *WormholeInput) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetRecipient() string {
-	if x != nil {
-		return x.Recipient
-	}
-	return ""
-}
-
-func (x *WormholeInput) GetInvite() string {
+func (x *UserSearchRequest) GetQuery() string {
 	if x != nil {
-		return x.Invite
+		return x.Query
 	}
 	return ""
 }
 
-func (x *WormholeInput) GetID() string {
+func (x *UserSearchRequest) GetLimit() int32 {
 	if x != nil {
-		return x.ID
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *WormholeInput) GetText() string {
+func (x *UserSearchRequest) GetLocal() bool {
 	if x != nil {
-		return x.Text
+		return x.Local
 	}
-	return ""
+	return false
 }
 
-type WormholeMessage struct {
+type UserSearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sender    *Key                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	Recipient *Key                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Status    WormholeMessageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=service.WormholeMessageStatus" json:"status,omitempty"`
-	Text      string                `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
-	CreatedAt int64                 `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *WormholeMessage) Reset() {
-	*x = WormholeMessage{}
+func (x *UserSearchResponse) Reset() {
+	*x = UserSearchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9378,13 +10227,13 @@ func (x *WormholeMessage) Reset() {
 	}
 }
 
-func (x *WormholeMessage) String() string {
+func (x *UserSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeMessage) ProtoMessage() {}
+func (*UserSearchResponse) ProtoMessage() {}
 
-func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
+func (x *UserSearchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9396,79 +10245,90 @@ func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeMessage.ProtoReflect.Descriptor instead.
-func (*WormholeMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSearchResponse.ProtoReflect.Descriptor instead.
+func (*UserSearchResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *WormholeMessage) GetID() string {
+func (x *UserSearchResponse) GetUsers() []*User {
 	if x != nil {
-		return x.ID
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *WormholeMessage) GetSender() *Key {
-	if x != nil {
-		return x.Sender
-	}
-	return nil
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *WormholeMessage) GetRecipient() *Key {
-	if x != nil {
-		return x.Recipient
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *WormholeMessage) GetStatus() WormholeMessageStatus {
-	if x != nil {
-		return x.Status
-	}
-	return WormholeMessageSent
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WormholeMessage) GetText() string {
-	if x != nil {
-		return x.Text
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WormholeMessage) GetCreatedAt() int64 {
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SearchRequest) GetQuery() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-type WormholeOutput struct {
+type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *WormholeMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Status  WormholeStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=service.WormholeStatus" json:"status,omitempty"`
+	Keys []*Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *WormholeOutput) Reset() {
-	*x = WormholeOutput{}
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[158]
+		mi := &file_rpc_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WormholeOutput) String() string {
+func (x *SearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WormholeOutput) ProtoMessage() {}
+func (*SearchResponse) ProtoMessage() {}
 
-func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9479,52 +10339,41 @@ func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WormholeOutput.ProtoReflect.Descriptor instead.
-func (*WormholeOutput) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *WormholeOutput) GetMessage() *WormholeMessage {
+func (x *SearchResponse) GetKeys() []*Key {
 	if x != nil {
-		return x.Message
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *WormholeOutput) GetStatus() WormholeStatus {
-	if x != nil {
-		return x.Status
-	}
-	return WormholeDefault
-}
-
-type Config_App struct {
+type VaultSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Location     string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
-	History      []string `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
-	NavMinimized bool     `protobuf:"varint,100,opt,name=navMinimized,proto3" json:"navMinimized,omitempty"`
 }
 
-func (x *Config_App) Reset() {
-	*x = Config_App{}
+func (x *VaultSyncRequest) Reset() {
+	*x = VaultSyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[159]
+		mi := &file_rpc_proto_msgTypes[160]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_App) String() string {
+func (x *VaultSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_App) ProtoMessage() {}
+func (*VaultSyncRequest) ProtoMessage() {}
 
-func (x *Config_App) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *VaultSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[160]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9535,60 +10384,72 @@ func (x *Config_App) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_App.ProtoReflect.Descriptor instead.
-func (*Config_App) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151, 0}
+// Deprecated: Use VaultSyncRequest.ProtoReflect.Descriptor instead.
+func (*VaultSyncRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *Config_App) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+type VaultSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Config_App) GetHistory() []string {
-	if x != nil {
-		return x.History
+func (x *VaultSyncResponse) Reset() {
+	*x = VaultSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Config_App) GetNavMinimized() bool {
-	if x != nil {
-		return x.NavMinimized
+func (x *VaultSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultSyncResponse) ProtoMessage() {}
+
+func (x *VaultSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type Config_Encrypt struct {
+// Deprecated: Use VaultSyncResponse.ProtoReflect.Descriptor instead.
+func (*VaultSyncResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{161}
+}
+
+type VaultUnsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Recipients        []string `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
-	Sender            string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	NoSenderRecipient bool     `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
-	NoSign            bool     `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
 }
 
-func (x *Config_Encrypt) Reset() {
-	*x = Config_Encrypt{}
+func (x *VaultUnsyncRequest) Reset() {
+	*x = VaultUnsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[160]
+		mi := &file_rpc_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_Encrypt) String() string {
+func (x *VaultUnsyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_Encrypt) ProtoMessage() {}
+func (*VaultUnsyncRequest) ProtoMessage() {}
 
-func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *VaultUnsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9599,64 +10460,72 @@ func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_Encrypt.ProtoReflect.Descriptor instead.
-func (*Config_Encrypt) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151, 1}
+// Deprecated: Use VaultUnsyncRequest.ProtoReflect.Descriptor instead.
+func (*VaultUnsyncRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *Config_Encrypt) GetRecipients() []string {
-	if x != nil {
-		return x.Recipients
-	}
-	return nil
+type VaultUnsyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Config_Encrypt) GetSender() string {
-	if x != nil {
-		return x.Sender
+func (x *VaultUnsyncResponse) Reset() {
+	*x = VaultUnsyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Config_Encrypt) GetNoSenderRecipient() bool {
-	if x != nil {
-		return x.NoSenderRecipient
-	}
-	return false
+func (x *VaultUnsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Config_Encrypt) GetNoSign() bool {
-	if x != nil {
-		return x.NoSign
+func (*VaultUnsyncResponse) ProtoMessage() {}
+
+func (x *VaultUnsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type Config_Sign struct {
+// Deprecated: Use VaultUnsyncResponse.ProtoReflect.Descriptor instead.
+func (*VaultUnsyncResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
+}
+
+type VaultAuthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *Config_Sign) Reset() {
-	*x = Config_Sign{}
+func (x *VaultAuthRequest) Reset() {
+	*x = VaultAuthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_proto_msgTypes[161]
+		mi := &file_rpc_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config_Sign) String() string {
+func (x *VaultAuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config_Sign) ProtoMessage() {}
+func (*VaultAuthRequest) ProtoMessage() {}
 
-func (x *Config_Sign) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *VaultAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9667,215 +10536,1502 @@ func (x *Config_Sign) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config_Sign.ProtoReflect.Descriptor instead.
-func (*Config_Sign) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151, 2}
+// Deprecated: Use VaultAuthRequest.ProtoReflect.Descriptor instead.
+func (*VaultAuthRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *Config_Sign) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
-}
+type VaultAuthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var File_rpc_proto protoreflect.FileDescriptor
+	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+}
 
-var file_rpc_proto_rawDesc = []byte{
+func (x *VaultAuthResponse) Reset() {
+	*x = VaultAuthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultAuthResponse) ProtoMessage() {}
+
+func (x *VaultAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultAuthResponse.ProtoReflect.Descriptor instead.
+func (*VaultAuthResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *VaultAuthResponse) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+type VaultStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultStatusRequest) Reset() {
+	*x = VaultStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultStatusRequest) ProtoMessage() {}
+
+func (x *VaultStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultStatusRequest.ProtoReflect.Descriptor instead.
+func (*VaultStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
+}
+
+type VaultStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KID      string      `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	SyncedAt int64       `protobuf:"varint,2,opt,name=syncedAt,proto3" json:"syncedAt,omitempty"`
+	Usage    *VaultUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *VaultStatusResponse) Reset() {
+	*x = VaultStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultStatusResponse) ProtoMessage() {}
+
+func (x *VaultStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultStatusResponse.ProtoReflect.Descriptor instead.
+func (*VaultStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *VaultStatusResponse) GetKID() string {
+	if x != nil {
+		return x.KID
+	}
+	return ""
+}
+
+func (x *VaultStatusResponse) GetSyncedAt() int64 {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return 0
+}
+
+func (x *VaultStatusResponse) GetUsage() *VaultUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type VaultUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bytes  int64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Events int64 `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+	// Max values of 0 are unlimited.
+	MaxBytes  int64 `protobuf:"varint,3,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	MaxEvents int64 `protobuf:"varint,4,opt,name=maxEvents,proto3" json:"maxEvents,omitempty"`
+}
+
+func (x *VaultUsage) Reset() {
+	*x = VaultUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultUsage) ProtoMessage() {}
+
+func (x *VaultUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultUsage.ProtoReflect.Descriptor instead.
+func (*VaultUsage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *VaultUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *VaultUsage) GetEvents() int64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *VaultUsage) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *VaultUsage) GetMaxEvents() int64 {
+	if x != nil {
+		return x.MaxEvents
+	}
+	return 0
+}
+
+type VaultUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultUpdateRequest) Reset() {
+	*x = VaultUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultUpdateRequest) ProtoMessage() {}
+
+func (x *VaultUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultUpdateRequest.ProtoReflect.Descriptor instead.
+func (*VaultUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
+}
+
+type VaultUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VaultUpdateResponse) Reset() {
+	*x = VaultUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultUpdateResponse) ProtoMessage() {}
+
+func (x *VaultUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultUpdateResponse.ProtoReflect.Descriptor instead.
+func (*VaultUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
+}
+
+type AdminSignURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	URL    string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *AdminSignURLRequest) Reset() {
+	*x = AdminSignURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignURLRequest) ProtoMessage() {}
+
+func (x *AdminSignURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignURLRequest.ProtoReflect.Descriptor instead.
+func (*AdminSignURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *AdminSignURLRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *AdminSignURLRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AdminSignURLRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type AdminSignURLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth string `protobuf:"bytes,1,opt,name=auth,proto3" json:"auth,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	CURL string `protobuf:"bytes,3,opt,name=curl,proto3" json:"curl,omitempty"`
+}
+
+func (x *AdminSignURLResponse) Reset() {
+	*x = AdminSignURLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignURLResponse) ProtoMessage() {}
+
+func (x *AdminSignURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignURLResponse.ProtoReflect.Descriptor instead.
+func (*AdminSignURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *AdminSignURLResponse) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *AdminSignURLResponse) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *AdminSignURLResponse) GetCURL() string {
+	if x != nil {
+		return x.CURL
+	}
+	return ""
+}
+
+type AdminCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Check  string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+}
+
+func (x *AdminCheckRequest) Reset() {
+	*x = AdminCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCheckRequest) ProtoMessage() {}
+
+func (x *AdminCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCheckRequest.ProtoReflect.Descriptor instead.
+func (*AdminCheckRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AdminCheckRequest) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *AdminCheckRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+type AdminCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminCheckResponse) Reset() {
+	*x = AdminCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCheckResponse) ProtoMessage() {}
+
+func (x *AdminCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCheckResponse.ProtoReflect.Descriptor instead.
+func (*AdminCheckResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{174}
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App     *Config_App     `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Encrypt *Config_Encrypt `protobuf:"bytes,10,opt,name=encrypt,proto3" json:"encrypt,omitempty"`
+	Sign    *Config_Sign    `protobuf:"bytes,11,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *Config) GetApp() *Config_App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *Config) GetEncrypt() *Config_Encrypt {
+	if x != nil {
+		return x.Encrypt
+	}
+	return nil
+}
+
+func (x *Config) GetSign() *Config_Sign {
+	if x != nil {
+		return x.Sign
+	}
+	return nil
+}
+
+type ConfigGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ConfigGetRequest) Reset() {
+	*x = ConfigGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGetRequest) ProtoMessage() {}
+
+func (x *ConfigGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGetRequest.ProtoReflect.Descriptor instead.
+func (*ConfigGetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ConfigGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConfigGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigGetResponse) Reset() {
+	*x = ConfigGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGetResponse) ProtoMessage() {}
+
+func (x *ConfigGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGetResponse.ProtoReflect.Descriptor instead.
+func (*ConfigGetResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ConfigGetResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigSetRequest) Reset() {
+	*x = ConfigSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSetRequest) ProtoMessage() {}
+
+func (x *ConfigSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSetRequest.ProtoReflect.Descriptor instead.
+func (*ConfigSetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ConfigSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigSetRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigSetResponse) Reset() {
+	*x = ConfigSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSetResponse) ProtoMessage() {}
+
+func (x *ConfigSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSetResponse.ProtoReflect.Descriptor instead.
+func (*ConfigSetResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
+}
+
+type WormholeInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Invite    string `protobuf:"bytes,3,opt,name=invite,proto3" json:"invite,omitempty"`
+	ID        string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string `protobuf:"bytes,11,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WormholeInput) Reset() {
+	*x = WormholeInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeInput) ProtoMessage() {}
+
+func (x *WormholeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeInput.ProtoReflect.Descriptor instead.
+func (*WormholeInput) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *WormholeInput) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetInvite() string {
+	if x != nil {
+		return x.Invite
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WormholeInput) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type WormholeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender    *Key                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient *Key                  `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Status    WormholeMessageStatus `protobuf:"varint,5,opt,name=status,proto3,enum=service.WormholeMessageStatus" json:"status,omitempty"`
+	Text      string                `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt int64                 `protobuf:"varint,21,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *WormholeMessage) Reset() {
+	*x = WormholeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeMessage) ProtoMessage() {}
+
+func (x *WormholeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeMessage.ProtoReflect.Descriptor instead.
+func (*WormholeMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *WormholeMessage) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *WormholeMessage) GetSender() *Key {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *WormholeMessage) GetRecipient() *Key {
+	if x != nil {
+		return x.Recipient
+	}
+	return nil
+}
+
+func (x *WormholeMessage) GetStatus() WormholeMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WormholeMessageSent
+}
+
+func (x *WormholeMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WormholeMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WormholeOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *WormholeMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Status  WormholeStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=service.WormholeStatus" json:"status,omitempty"`
+}
+
+func (x *WormholeOutput) Reset() {
+	*x = WormholeOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WormholeOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormholeOutput) ProtoMessage() {}
+
+func (x *WormholeOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormholeOutput.ProtoReflect.Descriptor instead.
+func (*WormholeOutput) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *WormholeOutput) GetMessage() *WormholeMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *WormholeOutput) GetStatus() WormholeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WormholeDefault
+}
+
+type Config_App struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location     string   `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	History      []string `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	NavMinimized bool     `protobuf:"varint,100,opt,name=navMinimized,proto3" json:"navMinimized,omitempty"`
+}
+
+func (x *Config_App) Reset() {
+	*x = Config_App{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_App) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_App) ProtoMessage() {}
+
+func (x *Config_App) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_App.ProtoReflect.Descriptor instead.
+func (*Config_App) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175, 0}
+}
+
+func (x *Config_App) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Config_App) GetHistory() []string {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Config_App) GetNavMinimized() bool {
+	if x != nil {
+		return x.NavMinimized
+	}
+	return false
+}
+
+type Config_Encrypt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipients        []string `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Sender            string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	NoSenderRecipient bool     `protobuf:"varint,3,opt,name=noSenderRecipient,proto3" json:"noSenderRecipient,omitempty"`
+	NoSign            bool     `protobuf:"varint,4,opt,name=noSign,proto3" json:"noSign,omitempty"`
+}
+
+func (x *Config_Encrypt) Reset() {
+	*x = Config_Encrypt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Encrypt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Encrypt) ProtoMessage() {}
+
+func (x *Config_Encrypt) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Encrypt.ProtoReflect.Descriptor instead.
+func (*Config_Encrypt) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175, 1}
+}
+
+func (x *Config_Encrypt) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Config_Encrypt) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Config_Encrypt) GetNoSenderRecipient() bool {
+	if x != nil {
+		return x.NoSenderRecipient
+	}
+	return false
+}
+
+func (x *Config_Encrypt) GetNoSign() bool {
+	if x != nil {
+		return x.NoSign
+	}
+	return false
+}
+
+type Config_Sign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *Config_Sign) Reset() {
+	*x = Config_Sign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Sign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Sign) ProtoMessage() {}
+
+func (x *Config_Sign) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Sign.ProtoReflect.Descriptor instead.
+func (*Config_Sign) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175, 2}
+}
+
+func (x *Config_Sign) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+var File_rpc_proto protoreflect.FileDescriptor
+
+var file_rpc_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x1a, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
-	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69,
-	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
-	0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x6b, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x46,
-	0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
-	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x23, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x0e, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3f,
+	0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22,
+	0xcf, 0x01, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0x6b, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x3d,
+	0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x82, 0x01,
+	0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x24, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44,
-	0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69,
+	0x67, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x2c,
+	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x49, 0x0a, 0x0f,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x73, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x3e, 0x0a, 0x14,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x21, 0x0a, 0x0b,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x48, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x0f, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x4a,
+	0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x3b, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x73, 0x69, 0x67, 0x22, 0x3e, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x22, 0x21, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x22, 0x33, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f,
-	0x75, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22,
-	0x3b, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xbe, 0x01, 0x0a,
-	0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
-	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70,
-	0x72, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a,
-	0x0f, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
-	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x66, 0x0a,
-	0x10, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
-	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x45, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x5f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
-	0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
-	0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x22, 0x4b, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a,
-	0x16, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49,
-	0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
+	0x03, 0x73, 0x69, 0x67, 0x22, 0x8e, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x73, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44,
+	0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x76, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x72, 0x65, 0x76, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2e, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44,
+	0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x22, 0x45, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x17,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
 	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f,
-	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x22, 0x3d, 0x0a, 0x0a, 0x53,
-	0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
-	0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
-	0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f,
-	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
-	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24,
-	0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x16, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03,
+	0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a,
+	0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x16, 0x53, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x53,
+	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x8e, 0x01, 0x0a,
+	0x16, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x01,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
+	0x3d, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x9a,
+	0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x0e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a,
+	0x0f, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x75, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x24, 0x0a, 0x0e,
+	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x75, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa8, 0x02, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x10, 0x44,
-	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75,
-	0x74, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x13, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x12,
+	0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x22, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c,
 	0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a,
@@ -9896,7 +12052,7 @@ var file_rpc_proto_rawDesc = []byte{
 	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63,
 	0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x16,
 	0x0a, 0x14, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x74, 0x69,
 	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70,
@@ -9909,152 +12065,220 @@ var file_rpc_proto_rawDesc = []byte{
 	0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12,
 	0x21, 0x0a, 0x05, 0x66, 0x69, 0x64, 0x6f, 0x32, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b,
 	0xca, 0xb5, 0x03, 0x07, 0x0a, 0x05, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x52, 0x05, 0x66, 0x69, 0x64,
-	0x6f, 0x32, 0x22, 0x69, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52,
+	0x6f, 0x32, 0x12, 0x34, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x0b, 0x6b, 0x65, 0x79,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x65, 0x73, 0x22, 0x76, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0x69, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2a, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11,
+	0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x25,
 	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x13, 0x0a,
-	0x11, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x13,
-	0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
-	0x32, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x22,
-	0x4d, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32,
-	0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a,
-	0x19, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x1c,
-	0x0a, 0x1a, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a,
-	0x0d, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04,
-	0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a,
-	0x06, 0x61, 0x61, 0x67, 0x75, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca,
-	0xb5, 0x03, 0x08, 0x0a, 0x06, 0x41, 0x41, 0x47, 0x55, 0x49, 0x44, 0x52, 0x06, 0x61, 0x61, 0x67,
-	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x4c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52,
-	0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x32, 0x0a, 0x13, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44,
-	0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
-	0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x22, 0x5c, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x40, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x22, 0x66, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac, 0x01, 0x0a,
-	0x10, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a,
+	0x12, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x22, 0x4d, 0x0a,
+	0x15, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x16,
+	0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x19, 0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x19, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x1c, 0x0a, 0x1a,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x61,
+	0x61, 0x67, 0x75, 0x69, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xca, 0xb5, 0x03,
+	0x08, 0x0a, 0x06, 0x41, 0x41, 0x47, 0x55, 0x49, 0x44, 0x52, 0x06, 0x61, 0x61, 0x67, 0x75, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6e, 0x6f, 0x50, 0x69, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x50, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x12,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x33, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x13, 0x4b, 0x65, 0x79, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03,
+	0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x4b, 0x0a, 0x12, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
-	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1a,
+	0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x0f, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03,
+	0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a,
+	0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x22, 0x40, 0x0a,
+	0x10, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xa4, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x22, 0x66, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac,
+	0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2b, 0x0a,
+	0x11, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x4b, 0x65,
+	0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x1a,
 	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
-	0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x6e, 0x6f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2b, 0x0a, 0x11, 0x4b,
-	0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0x30, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
-	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x48, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x2f, 0x0a, 0x10,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
+	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x22, 0x13, 0x0a,
+	0x11, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x63, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x6b, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x71, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52,
+	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x22, 0x58, 0x0a, 0x11, 0x4b, 0x65,
+	0x79, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x22, 0x46, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a,
+	0x0b, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x03,
+	0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a,
+	0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x05, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x05, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x6b,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
+	0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x73, 0x68, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x52, 0x08, 0x73, 0x73, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xec, 0x04, 0x0a, 0x03, 0x4b, 0x65, 0x79,
 	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5,
 	0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
 	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
@@ -10063,485 +12287,586 @@ var file_rpc_proto_rawDesc = []byte{
 	0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
 	0x14, 0x0a, 0x05, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x73, 0x61, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
-	0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a,
-	0x11, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0a, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x0b, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x73, 0x61, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x73, 0x68, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x42, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x73, 0x73, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x29,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4e, 0x0a, 0x0a, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c,
+	0x01, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x02,
+	0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x39, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x11,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3d, 0x0a, 0x12, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5,
+	0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69,
 	0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46,
 	0x69, 0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52,
-	0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x86, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x02,
+	0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02,
 	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03,
-	0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x0d, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x22, 0x3c, 0x0a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3d,
-	0x0a, 0x12, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x2f, 0x0a,
-	0x13, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16,
-	0x0a, 0x14, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x65, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x22, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x40, 0x0a, 0x10, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a,
+	0x11, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x0e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5,
+	0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5,
+	0x03, 0x06, 0x0a, 0x04, 0x4b, 0x49, 0x44, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x24,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a,
+	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x03, 0x50, 0x69, 0x6e,
+	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
+	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x22, 0x32, 0x0a, 0x10, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x52,
+	0x03, 0x70, 0x69, 0x6e, 0x22, 0x25, 0x0a, 0x11, 0x54, 0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x30, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x04, 0x70, 0x69,
+	0x6e, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a,
+	0x13, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x32, 0x0a, 0x14,
+	0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x1f, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x2e, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x4b, 0x49, 0x44, 0x73, 0x52, 0x04, 0x6b, 0x69, 0x64,
+	0x73, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x22, 0x4b, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x04, 0x75, 0x72, 0x6c,
+	0x73, 0x22, 0x20, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x4c, 0x0a, 0x13, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x08, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x10, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64,
+	0x62, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x18,
+	0x0a, 0x16, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca,
+	0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
+	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72,
+	0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x82, 0x01, 0x0a,
+	0x0f, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x5a, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca,
+	0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x65, 0x0a,
+	0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x55, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
 	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a,
-	0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f,
-	0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x0f,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x29, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f,
-	0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x22, 0x22, 0x0a,
-	0x0c, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x2d, 0x0a, 0x13, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x22, 0x32, 0x0a, 0x14, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x1f, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x4b, 0x49, 0x44, 0x73, 0x52,
-	0x04, 0x6b, 0x69, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x4b, 0x0a, 0x0c, 0x50, 0x75, 0x73, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44,
-	0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x52,
-	0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x46, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02, 0x64, 0x62, 0x22,
-	0x4c, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a,
-	0x08, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x44, 0x0a, 0x10, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x02, 0x64,
-	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44,
-	0x42, 0x52, 0x02, 0x64, 0x62, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x44, 0x42, 0x52, 0x02,
-	0x64, 0x62, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb1, 0x02, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64,
-	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73,
-	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a,
-	0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x64, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72,
-	0x22, 0x40, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
-	0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x22, 0x31, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x39, 0x0a, 0x12, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x39, 0x0a, 0x12,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x32,
-	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65,
-	0x79, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x11,
-	0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4e, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03,
-	0x6b, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x32, 0x0a,
+	0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x15, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x11, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79,
+	0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x4b, 0x49, 0x44, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x74, 0x0a, 0x0a, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
 	0x14, 0x0a, 0x12, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xab, 0x01, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x13,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0x67, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a,
+	0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x75, 0x72,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06, 0x0a, 0x04, 0x43,
+	0x55, 0x52, 0x4c, 0x52, 0x04, 0x63, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x11, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x14, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x97, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a,
+	0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x52,
+	0x03, 0x61, 0x70, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x07,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x1a, 0x5f, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a,
+	0x65, 0x64, 0x1a, 0x87, 0x01, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x1a, 0x1e, 0x0a, 0x04,
+	0x53, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x10,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x4f, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79,
-	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5d, 0x0a, 0x15, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x44, 0x0a, 0x16, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x76, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x0f,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x22, 0x40, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x0b, 0x52,
-	0x65, 0x6c, 0x61, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa3,
-	0x01, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70,
-	0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70,
-	0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x10, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x3e, 0x0a,
-	0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x43, 0x0a,
-	0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x22, 0x2f, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x22, 0x43, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x39,
-	0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x27, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x46, 0x0a, 0x0e, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x22, 0x3c, 0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x22,
-	0x62, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0x67, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
-	0x1b, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xca, 0xb5,
-	0x03, 0x05, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x04,
-	0x63, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xca, 0xb5, 0x03, 0x06,
-	0x0a, 0x04, 0x43, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x63, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x11,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x22,
-	0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x03, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x25, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
-	0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x69,
-	0x67, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x04,
-	0x73, 0x69, 0x67, 0x6e, 0x1a, 0x5f, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x69, 0x7a, 0x65,
-	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x61, 0x76, 0x4d, 0x69, 0x6e, 0x69,
-	0x6d, 0x69, 0x7a, 0x65, 0x64, 0x1a, 0x87, 0x01, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x6f, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6e, 0x6f, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x1a,
-	0x1e, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22,
-	0x26, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4f, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d,
-	0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a, 0x02, 0x49,
-	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x0f, 0x57, 0x6f,
-	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xca, 0xb5, 0x03, 0x04, 0x0a,
-	0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a,
-	0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x09,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xa9, 0x01, 0x0a, 0x0b, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x44, 0x45,
-	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x00, 0x1a,
-	0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x10, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43,
-	0x4b, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03,
-	0x11, 0x0a, 0x0f, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x53,
-	0x49, 0x47, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x03, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13,
-	0x0a, 0x11, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x2a, 0xbb, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x41,
-	0x75, 0x74, 0x68, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x55,
-	0x54, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x5f, 0x4e, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
-	0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74,
-	0x75, 0x70, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x41, 0x55, 0x54, 0x48,
-	0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x12, 0xca, 0xb5, 0x03,
-	0x0e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
-	0x21, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x03,
-	0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2a, 0xc5, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x55, 0x54,
-	0x48, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0d, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x0a, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a,
-	0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x0a,
-	0x0e, 0x50, 0x41, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10,
-	0x0b, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x70, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x16, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x5f, 0x48,
-	0x4d, 0x41, 0x43, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10,
-	0x14, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a, 0x13, 0x46, 0x49, 0x44, 0x4f, 0x32, 0x48, 0x4d,
-	0x41, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x0e, 0xca, 0xb5,
-	0x03, 0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x72, 0x0a, 0x0a,
-	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x44, 0x45,
-	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x10, 0x00, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x5f,
-	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x1a, 0x0f, 0xca,
-	0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x53, 0x53, 0x48, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x10,
-	0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x2a, 0x56, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09,
-	0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x04, 0x44, 0x45, 0x53,
-	0x43, 0x10, 0x01, 0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44,
-	0x65, 0x73, 0x63, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0xe6, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00,
-	0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0a, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10,
-	0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x27, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x52,
-	0x45, 0x54, 0x10, 0x0b, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x43, 0x41, 0x52,
-	0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0c, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c,
-	0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b,
-	0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0d, 0x1a, 0x10, 0xca,
-	0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a,
-	0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x2a, 0xe6, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x75, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f,
+	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xc7, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x19, 0x53,
+	0x41, 0x4c, 0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13,
+	0x0a, 0x11, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x18, 0x4f, 0x50, 0x45, 0x4e, 0x50, 0x47, 0x50, 0x5f, 0x53,
+	0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10,
+	0x01, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x47, 0x50,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x17, 0x53, 0x53, 0x48,
+	0x53, 0x49, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x10, 0x02, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x53,
+	0x48, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11,
+	0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x2a, 0x8a, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x18,
+	0xca, 0xb5, 0x03, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a,
+	0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xa9,
+	0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x0f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50,
+	0x54, 0x10, 0x00, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x10, 0x53, 0x41, 0x4c,
+	0x54, 0x50, 0x41, 0x43, 0x4b, 0x5f, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x01, 0x1a,
+	0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x2f, 0x0a, 0x12, 0x53, 0x41, 0x4c, 0x54, 0x50, 0x41,
+	0x43, 0x4b, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x10, 0x03, 0x1a, 0x17,
+	0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x53, 0x61, 0x6c, 0x74, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x69,
+	0x67, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x2a, 0xbb, 0x01, 0x0a, 0x0a, 0x41,
+	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03,
+	0x0d, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x2c,
+	0x0a, 0x11, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x5f, 0x4e, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x4e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0d,
+	0x41, 0x55, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x1a,
+	0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4c, 0x4f, 0x43, 0x4b,
+	0x45, 0x44, 0x10, 0x03, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68,
+	0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xc5, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x00, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x55,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0d, 0x50, 0x41,
+	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x0a, 0x1a, 0x12, 0xca,
+	0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x41, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x10, 0x0b, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x50, 0x61, 0x70,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x16, 0x46, 0x49, 0x44,
+	0x4f, 0x32, 0x5f, 0x48, 0x4d, 0x41, 0x43, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x10, 0x14, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a, 0x13, 0x46, 0x49, 0x44,
+	0x4f, 0x32, 0x48, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x2a, 0xbc, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2c, 0x0a, 0x13, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a,
+	0x0f, 0x53, 0x53, 0x48, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x01, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x53, 0x53, 0x48, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x11, 0x50, 0x41, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d,
+	0x0a, 0x0b, 0x50, 0x61, 0x70, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a,
+	0x0f, 0x4a, 0x57, 0x4b, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x03, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x4a, 0x57, 0x4b, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0f, 0x50, 0x45, 0x4d, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x1a, 0x0f, 0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09,
+	0x50, 0x45, 0x4d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0f, 0x41, 0x47, 0x45,
+	0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x05, 0x1a, 0x0f,
+	0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2c, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x4e, 0x50, 0x47, 0x50, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x06, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d,
+	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x47, 0x50, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x10, 0xca,
+	0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a,
+	0xa6, 0x01, 0x0a, 0x0e, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x53,
+	0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x2c, 0x0a, 0x11,
+	0x53, 0x53, 0x48, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
+	0x4d, 0x10, 0x01, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x53, 0x53, 0x48, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x53,
+	0x48, 0x5f, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x1a, 0x12,
+	0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x6e, 0x79, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x53, 0x53, 0x48, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2a, 0x56, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x03, 0x41, 0x53, 0x43,
+	0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x53, 0x6f, 0x72, 0x74, 0x41, 0x73,
+	0x63, 0x12, 0x18, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x1a, 0x0e, 0xca, 0xb5, 0x03,
+	0x0a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x73, 0x63, 0x1a, 0x13, 0xca, 0xb5, 0x03,
+	0x0f, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2a, 0xe6, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2c, 0x0a, 0x13, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x29, 0x0a,
+	0x0f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x10, 0x0a, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x0b, 0x1a, 0x13, 0xca, 0xb5,
+	0x03, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x21, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54,
+	0x10, 0x0c, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x43,
+	0x52, 0x45, 0x54, 0x10, 0x0d, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x4e, 0x6f, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x09, 0x50, 0x69,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x50, 0x49, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x50, 0x69, 0x6e,
+	0x4e, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x08, 0x50, 0x49, 0x4e, 0x5f, 0x54, 0x4f, 0x46, 0x55,
+	0x10, 0x01, 0x1a, 0x0d, 0xca, 0xb5, 0x03, 0x09, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x54, 0x4f, 0x46,
+	0x55, 0x12, 0x1d, 0x0a, 0x09, 0x50, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x02,
+	0x1a, 0x0e, 0xca, 0xb5, 0x03, 0x0a, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x23, 0x0a, 0x0c, 0x50, 0x49, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45,
+	0x10, 0x03, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x5f,
+	0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x10, 0x04, 0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b,
+	0x50, 0x69, 0x6e, 0x49, 0x6e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x1a, 0x0f, 0xca, 0xb5, 0x03,
+	0x0b, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2a, 0xb2, 0x01, 0x0a,
+	0x0a, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0d, 0x54,
+	0x52, 0x55, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x12,
+	0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x54, 0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x12, 0x21, 0x0a, 0x0b, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x4e, 0x45, 0x56, 0x45,
+	0x52, 0x10, 0x01, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x4e, 0x65, 0x76, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0e, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x4d,
+	0x41, 0x52, 0x47, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a,
+	0x0d, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0a, 0x54, 0x52, 0x55, 0x53, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x1a, 0x0f,
+	0xca, 0xb5, 0x03, 0x0b, 0x0a, 0x09, 0x54, 0x72, 0x75, 0x73, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x1a,
+	0x10, 0xca, 0xb5, 0x03, 0x0c, 0x0a, 0x0a, 0x54, 0x72, 0x75, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x2a, 0xe6, 0x01, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12,
 	0x0a, 0x03, 0x48, 0x45, 0x58, 0x10, 0x00, 0x1a, 0x09, 0xca, 0xb5, 0x03, 0x05, 0x0a, 0x03, 0x48,
 	0x65, 0x78, 0x12, 0x18, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36, 0x32, 0x10, 0x01, 0x1a, 0x0c,
 	0xca, 0xb5, 0x03, 0x08, 0x0a, 0x06, 0x42, 0x61, 0x73, 0x65, 0x36, 0x32, 0x12, 0x18, 0x0a, 0x06,
@@ -10579,387 +12904,418 @@ var file_rpc_proto_rawDesc = []byte{
 	0x29, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
 	0x32, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0x10, 0xca, 0xb5, 0x03, 0x0c,
-	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x9b, 0x01, 0x0a,
-	0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23,
-	0x0a, 0x0c, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x00,
-	0x1a, 0x11, 0xca, 0xb5, 0x03, 0x0d, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
-	0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25,
-	0x0a, 0x0d, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x02, 0x1a, 0x12, 0xca, 0xb5, 0x03, 0x0e, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x13, 0xca, 0xb5, 0x03, 0x0f, 0x0a, 0x0d, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xf0, 0x02, 0x0a, 0x0e, 0x57,
-	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a,
-	0x10, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
-	0x54, 0x10, 0x00, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6d, 0x68,
-	0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x11, 0x57, 0x4f,
-	0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x0a, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x11, 0x57, 0x4f, 0x52,
-	0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x14,
-	0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65,
-	0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52, 0x4d,
-	0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x15,
-	0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f, 0x52,
-	0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x10,
-	0x28, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f,
-	0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44,
-	0x10, 0x64, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0f, 0x57,
-	0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0xc8,
-	0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c,
-	0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x57,
-	0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xda, 0x01,
-	0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x57, 0x4f, 0x52, 0x4d, 0x48,
-	0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x54,
-	0x10, 0x00, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
-	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a,
-	0x18, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
-	0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x1c, 0xca, 0xb5, 0x03,
-	0x18, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x57, 0x4f, 0x52,
-	0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x43,
-	0x4b, 0x10, 0x02, 0x1a, 0x18, 0xca, 0xb5, 0x03, 0x14, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6d, 0x68,
-	0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x1a, 0x1b, 0xca,
-	0xb5, 0x03, 0x17, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xca, 0x29, 0x0a, 0x03, 0x52,
-	0x50, 0x43, 0x12, 0x4a, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35,
-	0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x13, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x53,
-	0x69, 0x67, 0x6e, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22,
-	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x47, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69,
-	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0xf0, 0x02, 0x0a,
+	0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x10, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41,
+	0x55, 0x4c, 0x54, 0x10, 0x00, 0x1a, 0x15, 0xca, 0xb5, 0x03, 0x11, 0x0a, 0x0f, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x11,
+	0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x0a, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x11, 0x57,
+	0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x14, 0x1a, 0x16, 0xca, 0xb5, 0x03, 0x12, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
+	0x6c, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x12, 0x57, 0x4f,
+	0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x4e, 0x53, 0x57, 0x45, 0x52, 0x49, 0x4e, 0x47,
+	0x10, 0x15, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f,
+	0x6c, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x12, 0x57,
+	0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b,
+	0x45, 0x10, 0x28, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x12,
+	0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x64, 0x1a, 0x17, 0xca, 0xb5, 0x03, 0x13, 0x0a, 0x11, 0x57, 0x6f, 0x72, 0x6d,
+	0x68, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x0f, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x10, 0xc8, 0x01, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x1a, 0x14, 0xca, 0xb5, 0x03, 0x10, 0x0a,
+	0x0e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a,
+	0xda, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x57, 0x4f, 0x52,
+	0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x00, 0x1a, 0x19, 0xca, 0xb5, 0x03, 0x15, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6d,
+	0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x1a, 0x1c, 0xca,
+	0xb5, 0x03, 0x18, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x57,
+	0x4f, 0x52, 0x4d, 0x48, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x41, 0x43, 0x4b, 0x10, 0x02, 0x1a, 0x18, 0xca, 0xb5, 0x03, 0x14, 0x0a, 0x12, 0x57, 0x6f, 0x72,
+	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x1a,
+	0x1b, 0xca, 0xb5, 0x03, 0x17, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xd8, 0x2e, 0x0a,
+	0x03, 0x52, 0x50, 0x43, 0x12, 0x4a, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x35, 0x0a, 0x04, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b,
+	0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x09, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4b, 0x65, 0x79,
+	0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4b, 0x65,
+	0x79, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x53, 0x69, 0x67,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0a,
+	0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x13,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x19,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x41, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5b, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x59, 0x0a,
-	0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x3e, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4a,
-	0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x07, 0x44, 0x65,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x28, 0x01, 0x12, 0x59, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12,
+	0x47, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4a, 0x0a,
+	0x0b, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x07, 0x44, 0x65, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x08,
-	0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x44,
+	0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a,
-	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x41, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x18, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x69, 0x67,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x69,
+	0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x12,
-	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x16,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
-	0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x12, 0x1a, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x73, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x14,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
-	0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a,
-	0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65,
-	0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68,
-	0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x53,
-	0x65, 0x74, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65,
-	0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
-	0x09, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08,
-	0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x61,
-	0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x41,
-	0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x44, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e,
-	0x73, 0x79, 0x6e, 0x63, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c,
-	0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x61,
+	0x76, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x05,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x61, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x12, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x53, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12,
+	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50,
+	0x69, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x50, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x72,
+	0x75, 0x73, 0x74, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x75, 0x6c,
+	0x6c, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x35, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6d, 0x68,
+	0x6f, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f,
+	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x75,
+	0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x64, 0x12, 0x14,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x0c, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x44, 0x65, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x55, 0x6e, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x44, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x12, 0x19, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52,
-	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x17,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x38, 0x0a,
-	0x05, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x3b, 0x73, 0x65, 0x72,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74,
+	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x69, 0x67, 0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x3b, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -10975,405 +13331,483 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 162)
+var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 186)
 var file_rpc_proto_goTypes = []interface{}{
-	(EncryptMode)(0),                   // 0: service.EncryptMode
-	(AuthStatus)(0),                    // 1: service.AuthStatus
-	(AuthType)(0),                      // 2: service.AuthType
-	(ExportType)(0),                    // 3: service.ExportType
-	(SortDirection)(0),                 // 4: service.SortDirection
-	(SecretType)(0),                    // 5: service.SecretType
-	(Encoding)(0),                      // 6: service.Encoding
-	(UserStatus)(0),                    // 7: service.UserStatus
-	(MessageStatus)(0),                 // 8: service.MessageStatus
-	(WormholeStatus)(0),                // 9: service.WormholeStatus
-	(WormholeMessageStatus)(0),         // 10: service.WormholeMessageStatus
-	(*SignRequest)(nil),                // 11: service.SignRequest
-	(*SignResponse)(nil),               // 12: service.SignResponse
-	(*SignFileInput)(nil),              // 13: service.SignFileInput
-	(*SignFileOutput)(nil),             // 14: service.SignFileOutput
-	(*VerifyRequest)(nil),              // 15: service.VerifyRequest
-	(*VerifyResponse)(nil),             // 16: service.VerifyResponse
-	(*VerifyDetachedRequest)(nil),      // 17: service.VerifyDetachedRequest
-	(*VerifyDetachedResponse)(nil),     // 18: service.VerifyDetachedResponse
-	(*VerifyInput)(nil),                // 19: service.VerifyInput
-	(*VerifyOutput)(nil),               // 20: service.VerifyOutput
-	(*VerifyFileInput)(nil),            // 21: service.VerifyFileInput
-	(*VerifyFileOutput)(nil),           // 22: service.VerifyFileOutput
-	(*VerifyDetachedFileInput)(nil),    // 23: service.VerifyDetachedFileInput
-	(*VerifyDetachedInput)(nil),        // 24: service.VerifyDetachedInput
-	(*Statement)(nil),                  // 25: service.Statement
-	(*SigchainRequest)(nil),            // 26: service.SigchainRequest
-	(*SigchainResponse)(nil),           // 27: service.SigchainResponse
-	(*StatementRequest)(nil),           // 28: service.StatementRequest
-	(*StatementResponse)(nil),          // 29: service.StatementResponse
-	(*StatementCreateRequest)(nil),     // 30: service.StatementCreateRequest
-	(*StatementCreateResponse)(nil),    // 31: service.StatementCreateResponse
-	(*StatementRevokeRequest)(nil),     // 32: service.StatementRevokeRequest
-	(*StatementRevokeResponse)(nil),    // 33: service.StatementRevokeResponse
-	(*SignInput)(nil),                  // 34: service.SignInput
-	(*SignOutput)(nil),                 // 35: service.SignOutput
-	(*EncryptOptions)(nil),             // 36: service.EncryptOptions
-	(*EncryptRequest)(nil),             // 37: service.EncryptRequest
-	(*EncryptResponse)(nil),            // 38: service.EncryptResponse
-	(*EncryptFileInput)(nil),           // 39: service.EncryptFileInput
-	(*EncryptFileOutput)(nil),          // 40: service.EncryptFileOutput
-	(*EncryptInput)(nil),               // 41: service.EncryptInput
-	(*EncryptOutput)(nil),              // 42: service.EncryptOutput
-	(*DecryptRequest)(nil),             // 43: service.DecryptRequest
-	(*DecryptResponse)(nil),            // 44: service.DecryptResponse
-	(*DecryptFileInput)(nil),           // 45: service.DecryptFileInput
-	(*DecryptFileOutput)(nil),          // 46: service.DecryptFileOutput
-	(*DecryptInput)(nil),               // 47: service.DecryptInput
-	(*DecryptOutput)(nil),              // 48: service.DecryptOutput
-	(*RuntimeStatusRequest)(nil),       // 49: service.RuntimeStatusRequest
-	(*RuntimeStatusResponse)(nil),      // 50: service.RuntimeStatusResponse
-	(*AuthSetupRequest)(nil),           // 51: service.AuthSetupRequest
-	(*AuthSetupResponse)(nil),          // 52: service.AuthSetupResponse
-	(*AuthVaultRequest)(nil),           // 53: service.AuthVaultRequest
-	(*AuthVaultResponse)(nil),          // 54: service.AuthVaultResponse
-	(*AuthUnlockRequest)(nil),          // 55: service.AuthUnlockRequest
-	(*AuthUnlockResponse)(nil),         // 56: service.AuthUnlockResponse
-	(*AuthProvisionRequest)(nil),       // 57: service.AuthProvisionRequest
-	(*AuthProvisionResponse)(nil),      // 58: service.AuthProvisionResponse
-	(*AuthDeprovisionRequest)(nil),     // 59: service.AuthDeprovisionRequest
-	(*AuthDeprovisionResponse)(nil),    // 60: service.AuthDeprovisionResponse
-	(*AuthPasswordChangeRequest)(nil),  // 61: service.AuthPasswordChangeRequest
-	(*AuthPasswordChangeResponse)(nil), // 62: service.AuthPasswordChangeResponse
-	(*AuthProvision)(nil),              // 63: service.AuthProvision
-	(*AuthProvisionsRequest)(nil),      // 64: service.AuthProvisionsRequest
-	(*AuthProvisionsResponse)(nil),     // 65: service.AuthProvisionsResponse
-	(*AuthLockRequest)(nil),            // 66: service.AuthLockRequest
-	(*AuthLockResponse)(nil),           // 67: service.AuthLockResponse
-	(*AuthResetRequest)(nil),           // 68: service.AuthResetRequest
-	(*AuthResetResponse)(nil),          // 69: service.AuthResetResponse
-	(*AuthRecoverRequest)(nil),         // 70: service.AuthRecoverRequest
-	(*AuthRecoverResponse)(nil),        // 71: service.AuthRecoverResponse
-	(*KeyGenerateRequest)(nil),         // 72: service.KeyGenerateRequest
-	(*KeyGenerateResponse)(nil),        // 73: service.KeyGenerateResponse
-	(*UserServiceRequest)(nil),         // 74: service.UserServiceRequest
-	(*UserServiceResponse)(nil),        // 75: service.UserServiceResponse
-	(*UserSignRequest)(nil),            // 76: service.UserSignRequest
-	(*UserSignResponse)(nil),           // 77: service.UserSignResponse
-	(*UserAddRequest)(nil),             // 78: service.UserAddRequest
-	(*UserAddResponse)(nil),            // 79: service.UserAddResponse
-	(*KeyExportRequest)(nil),           // 80: service.KeyExportRequest
-	(*KeyExportResponse)(nil),          // 81: service.KeyExportResponse
-	(*KeyImportRequest)(nil),           // 82: service.KeyImportRequest
-	(*KeyImportResponse)(nil),          // 83: service.KeyImportResponse
-	(*KeyRemoveRequest)(nil),           // 84: service.KeyRemoveRequest
-	(*KeyRemoveResponse)(nil),          // 85: service.KeyRemoveResponse
-	(*Key)(nil),                        // 86: service.Key
-	(*KeyRequest)(nil),                 // 87: service.KeyRequest
-	(*KeyResponse)(nil),                // 88: service.KeyResponse
-	(*KeysRequest)(nil),                // 89: service.KeysRequest
-	(*KeysResponse)(nil),               // 90: service.KeysResponse
-	(*Secret)(nil),                     // 91: service.Secret
-	(*SecretRequest)(nil),              // 92: service.SecretRequest
-	(*SecretResponse)(nil),             // 93: service.SecretResponse
-	(*SecretSaveRequest)(nil),          // 94: service.SecretSaveRequest
-	(*SecretSaveResponse)(nil),         // 95: service.SecretSaveResponse
-	(*SecretRemoveRequest)(nil),        // 96: service.SecretRemoveRequest
-	(*SecretRemoveResponse)(nil),       // 97: service.SecretRemoveResponse
-	(*SecretsRequest)(nil),             // 98: service.SecretsRequest
-	(*SecretsResponse)(nil),            // 99: service.SecretsResponse
-	(*RandRequest)(nil),                // 100: service.RandRequest
-	(*RandResponse)(nil),               // 101: service.RandResponse
-	(*RandPasswordRequest)(nil),        // 102: service.RandPasswordRequest
-	(*RandPasswordResponse)(nil),       // 103: service.RandPasswordResponse
-	(*PullRequest)(nil),                // 104: service.PullRequest
-	(*PullResponse)(nil),               // 105: service.PullResponse
-	(*PushRequest)(nil),                // 106: service.PushRequest
-	(*PushResponse)(nil),               // 107: service.PushResponse
-	(*Collection)(nil),                 // 108: service.Collection
-	(*CollectionsRequest)(nil),         // 109: service.CollectionsRequest
-	(*CollectionsResponse)(nil),        // 110: service.CollectionsResponse
-	(*Document)(nil),                   // 111: service.Document
-	(*DocumentsRequest)(nil),           // 112: service.DocumentsRequest
-	(*DocumentsResponse)(nil),          // 113: service.DocumentsResponse
-	(*DocumentDeleteRequest)(nil),      // 114: service.DocumentDeleteRequest
-	(*DocumentDeleteResponse)(nil),     // 115: service.DocumentDeleteResponse
-	(*User)(nil),                       // 116: service.User
-	(*UserRequest)(nil),                // 117: service.UserRequest
-	(*UserResponse)(nil),               // 118: service.UserResponse
-	(*UserSearchRequest)(nil),          // 119: service.UserSearchRequest
-	(*UserSearchResponse)(nil),         // 120: service.UserSearchResponse
-	(*SearchRequest)(nil),              // 121: service.SearchRequest
-	(*SearchResponse)(nil),             // 122: service.SearchResponse
-	(*VaultSyncRequest)(nil),           // 123: service.VaultSyncRequest
-	(*VaultSyncResponse)(nil),          // 124: service.VaultSyncResponse
-	(*VaultUnsyncRequest)(nil),         // 125: service.VaultUnsyncRequest
-	(*VaultUnsyncResponse)(nil),        // 126: service.VaultUnsyncResponse
-	(*VaultAuthRequest)(nil),           // 127: service.VaultAuthRequest
-	(*VaultAuthResponse)(nil),          // 128: service.VaultAuthResponse
-	(*VaultStatusRequest)(nil),         // 129: service.VaultStatusRequest
-	(*VaultStatusResponse)(nil),        // 130: service.VaultStatusResponse
-	(*VaultUpdateRequest)(nil),         // 131: service.VaultUpdateRequest
-	(*VaultUpdateResponse)(nil),        // 132: service.VaultUpdateResponse
-	(*Message)(nil),                    // 133: service.Message
-	(*MessagePrepareRequest)(nil),      // 134: service.MessagePrepareRequest
-	(*MessagePrepareResponse)(nil),     // 135: service.MessagePrepareResponse
-	(*MessageCreateRequest)(nil),       // 136: service.MessageCreateRequest
-	(*MessageCreateResponse)(nil),      // 137: service.MessageCreateResponse
-	(*MessagesRequest)(nil),            // 138: service.MessagesRequest
-	(*MessagesResponse)(nil),           // 139: service.MessagesResponse
-	(*RelayRequest)(nil),               // 140: service.RelayRequest
-	(*RelayOutput)(nil),                // 141: service.RelayOutput
-	(*Channel)(nil),                    // 142: service.Channel
-	(*ChannelsRequest)(nil),            // 143: service.ChannelsRequest
-	(*ChannelsResponse)(nil),           // 144: service.ChannelsResponse
-	(*ChannelCreateRequest)(nil),       // 145: service.ChannelCreateRequest
-	(*ChannelCreateResponse)(nil),      // 146: service.ChannelCreateResponse
-	(*ChannelLeaveRequest)(nil),        // 147: service.ChannelLeaveRequest
-	(*ChannelLeaveResponse)(nil),       // 148: service.ChannelLeaveResponse
-	(*ChannelReadRequest)(nil),         // 149: service.ChannelReadRequest
-	(*ChannelReadResponse)(nil),        // 150: service.ChannelReadResponse
-	(*ChannelInviteRequest)(nil),       // 151: service.ChannelInviteRequest
-	(*ChannelInviteResponse)(nil),      // 152: service.ChannelInviteResponse
-	(*Follow)(nil),                     // 153: service.Follow
-	(*FollowRequest)(nil),              // 154: service.FollowRequest
-	(*FollowResponse)(nil),             // 155: service.FollowResponse
-	(*FollowsRequest)(nil),             // 156: service.FollowsRequest
-	(*FollowsResponse)(nil),            // 157: service.FollowsResponse
-	(*AdminSignURLRequest)(nil),        // 158: service.AdminSignURLRequest
-	(*AdminSignURLResponse)(nil),       // 159: service.AdminSignURLResponse
-	(*AdminCheckRequest)(nil),          // 160: service.AdminCheckRequest
-	(*AdminCheckResponse)(nil),         // 161: service.AdminCheckResponse
-	(*Config)(nil),                     // 162: service.Config
-	(*ConfigGetRequest)(nil),           // 163: service.ConfigGetRequest
-	(*ConfigGetResponse)(nil),          // 164: service.ConfigGetResponse
-	(*ConfigSetRequest)(nil),           // 165: service.ConfigSetRequest
-	(*ConfigSetResponse)(nil),          // 166: service.ConfigSetResponse
-	(*WormholeInput)(nil),              // 167: service.WormholeInput
-	(*WormholeMessage)(nil),            // 168: service.WormholeMessage
-	(*WormholeOutput)(nil),             // 169: service.WormholeOutput
-	(*Config_App)(nil),                 // 170: service.Config.App
-	(*Config_Encrypt)(nil),             // 171: service.Config.Encrypt
-	(*Config_Sign)(nil),                // 172: service.Config.Sign
+	(SignatureFormat)(0),               // 0: service.SignatureFormat
+	(StatementStatus)(0),               // 1: service.StatementStatus
+	(EncryptMode)(0),                   // 2: service.EncryptMode
+	(AuthStatus)(0),                    // 3: service.AuthStatus
+	(AuthType)(0),                      // 4: service.AuthType
+	(ExportType)(0),                    // 5: service.ExportType
+	(SSHAgentPolicy)(0),                // 6: service.SSHAgentPolicy
+	(SortDirection)(0),                 // 7: service.SortDirection
+	(SecretType)(0),                    // 8: service.SecretType
+	(PinMethod)(0),                     // 9: service.PinMethod
+	(TrustLevel)(0),                    // 10: service.TrustLevel
+	(Encoding)(0),                      // 11: service.Encoding
+	(UserStatus)(0),                    // 12: service.UserStatus
+	(WormholeStatus)(0),                // 13: service.WormholeStatus
+	(WormholeMessageStatus)(0),         // 14: service.WormholeMessageStatus
+	(*SignRequest)(nil),                // 15: service.SignRequest
+	(*SignResponse)(nil),               // 16: service.SignResponse
+	(*SignFileInput)(nil),              // 17: service.SignFileInput
+	(*SignFileOutput)(nil),             // 18: service.SignFileOutput
+	(*VerifyRequest)(nil),              // 19: service.VerifyRequest
+	(*VerifyResponse)(nil),             // 20: service.VerifyResponse
+	(*SignatureEnvelope)(nil),          // 21: service.SignatureEnvelope
+	(*VerifyDetachedRequest)(nil),      // 22: service.VerifyDetachedRequest
+	(*VerifyDetachedResponse)(nil),     // 23: service.VerifyDetachedResponse
+	(*SignBundleRequest)(nil),          // 24: service.SignBundleRequest
+	(*SignBundleResponse)(nil),         // 25: service.SignBundleResponse
+	(*SignaturePolicy)(nil),            // 26: service.SignaturePolicy
+	(*VerifyBundleRequest)(nil),        // 27: service.VerifyBundleRequest
+	(*VerifyBundleResponse)(nil),       // 28: service.VerifyBundleResponse
+	(*VerifyInput)(nil),                // 29: service.VerifyInput
+	(*VerifyOutput)(nil),               // 30: service.VerifyOutput
+	(*VerifyFileInput)(nil),            // 31: service.VerifyFileInput
+	(*VerifyFileOutput)(nil),           // 32: service.VerifyFileOutput
+	(*VerifyDetachedFileInput)(nil),    // 33: service.VerifyDetachedFileInput
+	(*VerifyDetachedInput)(nil),        // 34: service.VerifyDetachedInput
+	(*Statement)(nil),                  // 35: service.Statement
+	(*SigchainRequest)(nil),            // 36: service.SigchainRequest
+	(*SigchainResponse)(nil),           // 37: service.SigchainResponse
+	(*StatementRequest)(nil),           // 38: service.StatementRequest
+	(*StatementResponse)(nil),          // 39: service.StatementResponse
+	(*StatementCreateRequest)(nil),     // 40: service.StatementCreateRequest
+	(*StatementCreateResponse)(nil),    // 41: service.StatementCreateResponse
+	(*StatementRevokeRequest)(nil),     // 42: service.StatementRevokeRequest
+	(*StatementRevokeResponse)(nil),    // 43: service.StatementRevokeResponse
+	(*SigchainPublishRequest)(nil),     // 44: service.SigchainPublishRequest
+	(*SigchainPublishResponse)(nil),    // 45: service.SigchainPublishResponse
+	(*SigchainExportRequest)(nil),      // 46: service.SigchainExportRequest
+	(*SigchainExportResponse)(nil),     // 47: service.SigchainExportResponse
+	(*SigchainVerifyRequest)(nil),      // 48: service.SigchainVerifyRequest
+	(*SigchainVerifyResponse)(nil),     // 49: service.SigchainVerifyResponse
+	(*SignInput)(nil),                  // 50: service.SignInput
+	(*SignOutput)(nil),                 // 51: service.SignOutput
+	(*EncryptOptions)(nil),             // 52: service.EncryptOptions
+	(*EncryptRequest)(nil),             // 53: service.EncryptRequest
+	(*EncryptResponse)(nil),            // 54: service.EncryptResponse
+	(*EncryptFileInput)(nil),           // 55: service.EncryptFileInput
+	(*EncryptFileOutput)(nil),          // 56: service.EncryptFileOutput
+	(*EncryptInput)(nil),               // 57: service.EncryptInput
+	(*EncryptOutput)(nil),              // 58: service.EncryptOutput
+	(*DecryptRequest)(nil),             // 59: service.DecryptRequest
+	(*DecryptResponse)(nil),            // 60: service.DecryptResponse
+	(*DecryptInspectRequest)(nil),      // 61: service.DecryptInspectRequest
+	(*DecryptInspectResponse)(nil),     // 62: service.DecryptInspectResponse
+	(*DecryptFileInput)(nil),           // 63: service.DecryptFileInput
+	(*DecryptFileOutput)(nil),          // 64: service.DecryptFileOutput
+	(*DecryptInput)(nil),               // 65: service.DecryptInput
+	(*DecryptOutput)(nil),              // 66: service.DecryptOutput
+	(*RuntimeStatusRequest)(nil),       // 67: service.RuntimeStatusRequest
+	(*RuntimeStatusResponse)(nil),      // 68: service.RuntimeStatusResponse
+	(*KeyExpiry)(nil),                  // 69: service.KeyExpiry
+	(*AuthSetupRequest)(nil),           // 70: service.AuthSetupRequest
+	(*AuthSetupResponse)(nil),          // 71: service.AuthSetupResponse
+	(*AuthVaultRequest)(nil),           // 72: service.AuthVaultRequest
+	(*AuthVaultResponse)(nil),          // 73: service.AuthVaultResponse
+	(*AuthUnlockRequest)(nil),          // 74: service.AuthUnlockRequest
+	(*AuthUnlockResponse)(nil),         // 75: service.AuthUnlockResponse
+	(*AuthProvisionRequest)(nil),       // 76: service.AuthProvisionRequest
+	(*AuthProvisionResponse)(nil),      // 77: service.AuthProvisionResponse
+	(*AuthDeprovisionRequest)(nil),     // 78: service.AuthDeprovisionRequest
+	(*AuthDeprovisionResponse)(nil),    // 79: service.AuthDeprovisionResponse
+	(*AuthPasswordChangeRequest)(nil),  // 80: service.AuthPasswordChangeRequest
+	(*AuthPasswordChangeResponse)(nil), // 81: service.AuthPasswordChangeResponse
+	(*AuthProvision)(nil),              // 82: service.AuthProvision
+	(*AuthProvisionsRequest)(nil),      // 83: service.AuthProvisionsRequest
+	(*AuthProvisionsResponse)(nil),     // 84: service.AuthProvisionsResponse
+	(*AuthLockRequest)(nil),            // 85: service.AuthLockRequest
+	(*AuthLockResponse)(nil),           // 86: service.AuthLockResponse
+	(*AuthResetRequest)(nil),           // 87: service.AuthResetRequest
+	(*AuthResetResponse)(nil),          // 88: service.AuthResetResponse
+	(*AuthRecoverRequest)(nil),         // 89: service.AuthRecoverRequest
+	(*AuthRecoverResponse)(nil),        // 90: service.AuthRecoverResponse
+	(*KeyGenerateRequest)(nil),         // 91: service.KeyGenerateRequest
+	(*KeyGenerateResponse)(nil),        // 92: service.KeyGenerateResponse
+	(*UserServiceRequest)(nil),         // 93: service.UserServiceRequest
+	(*UserServiceResponse)(nil),        // 94: service.UserServiceResponse
+	(*UserSignRequest)(nil),            // 95: service.UserSignRequest
+	(*UserSignResponse)(nil),           // 96: service.UserSignResponse
+	(*UserAddRequest)(nil),             // 97: service.UserAddRequest
+	(*UserAddResponse)(nil),            // 98: service.UserAddResponse
+	(*KeyExportRequest)(nil),           // 99: service.KeyExportRequest
+	(*KeyExportResponse)(nil),          // 100: service.KeyExportResponse
+	(*KeyImportRequest)(nil),           // 101: service.KeyImportRequest
+	(*KeyImportResponse)(nil),          // 102: service.KeyImportResponse
+	(*KeyRemoveRequest)(nil),           // 103: service.KeyRemoveRequest
+	(*KeyRemoveResponse)(nil),          // 104: service.KeyRemoveResponse
+	(*KeyRotateRequest)(nil),           // 105: service.KeyRotateRequest
+	(*KeyRotateResponse)(nil),          // 106: service.KeyRotateResponse
+	(*KeyEndorseRequest)(nil),          // 107: service.KeyEndorseRequest
+	(*KeyEndorseResponse)(nil),         // 108: service.KeyEndorseResponse
+	(*Endorsement)(nil),                // 109: service.Endorsement
+	(*KeyUpdateRequest)(nil),           // 110: service.KeyUpdateRequest
+	(*KeyUpdateResponse)(nil),          // 111: service.KeyUpdateResponse
+	(*Key)(nil),                        // 112: service.Key
+	(*KeyRequest)(nil),                 // 113: service.KeyRequest
+	(*KeyResponse)(nil),                // 114: service.KeyResponse
+	(*KeysRequest)(nil),                // 115: service.KeysRequest
+	(*KeysResponse)(nil),               // 116: service.KeysResponse
+	(*Secret)(nil),                     // 117: service.Secret
+	(*SecretRequest)(nil),              // 118: service.SecretRequest
+	(*SecretResponse)(nil),             // 119: service.SecretResponse
+	(*SecretSaveRequest)(nil),          // 120: service.SecretSaveRequest
+	(*SecretSaveResponse)(nil),         // 121: service.SecretSaveResponse
+	(*SecretRemoveRequest)(nil),        // 122: service.SecretRemoveRequest
+	(*SecretRemoveResponse)(nil),       // 123: service.SecretRemoveResponse
+	(*SecretsRequest)(nil),             // 124: service.SecretsRequest
+	(*SecretsResponse)(nil),            // 125: service.SecretsResponse
+	(*Group)(nil),                      // 126: service.Group
+	(*GroupRequest)(nil),               // 127: service.GroupRequest
+	(*GroupResponse)(nil),              // 128: service.GroupResponse
+	(*GroupSaveRequest)(nil),           // 129: service.GroupSaveRequest
+	(*GroupSaveResponse)(nil),          // 130: service.GroupSaveResponse
+	(*GroupRemoveRequest)(nil),         // 131: service.GroupRemoveRequest
+	(*GroupRemoveResponse)(nil),        // 132: service.GroupRemoveResponse
+	(*GroupsRequest)(nil),              // 133: service.GroupsRequest
+	(*GroupsResponse)(nil),             // 134: service.GroupsResponse
+	(*Contact)(nil),                    // 135: service.Contact
+	(*ContactRequest)(nil),             // 136: service.ContactRequest
+	(*ContactResponse)(nil),            // 137: service.ContactResponse
+	(*ContactSaveRequest)(nil),         // 138: service.ContactSaveRequest
+	(*ContactSaveResponse)(nil),        // 139: service.ContactSaveResponse
+	(*ContactRemoveRequest)(nil),       // 140: service.ContactRemoveRequest
+	(*ContactRemoveResponse)(nil),      // 141: service.ContactRemoveResponse
+	(*ContactsRequest)(nil),            // 142: service.ContactsRequest
+	(*ContactsResponse)(nil),           // 143: service.ContactsResponse
+	(*Pin)(nil),                        // 144: service.Pin
+	(*TrustPinRequest)(nil),            // 145: service.TrustPinRequest
+	(*TrustPinResponse)(nil),           // 146: service.TrustPinResponse
+	(*TrustUnpinRequest)(nil),          // 147: service.TrustUnpinRequest
+	(*TrustUnpinResponse)(nil),         // 148: service.TrustUnpinResponse
+	(*PinsRequest)(nil),                // 149: service.PinsRequest
+	(*PinsResponse)(nil),               // 150: service.PinsResponse
+	(*RandRequest)(nil),                // 151: service.RandRequest
+	(*RandResponse)(nil),               // 152: service.RandResponse
+	(*RandPasswordRequest)(nil),        // 153: service.RandPasswordRequest
+	(*RandPasswordResponse)(nil),       // 154: service.RandPasswordResponse
+	(*PullRequest)(nil),                // 155: service.PullRequest
+	(*PullResponse)(nil),               // 156: service.PullResponse
+	(*PushRequest)(nil),                // 157: service.PushRequest
+	(*PushResponse)(nil),               // 158: service.PushResponse
+	(*Collection)(nil),                 // 159: service.Collection
+	(*CollectionsRequest)(nil),         // 160: service.CollectionsRequest
+	(*CollectionsResponse)(nil),        // 161: service.CollectionsResponse
+	(*Document)(nil),                   // 162: service.Document
+	(*DocumentsRequest)(nil),           // 163: service.DocumentsRequest
+	(*DocumentsResponse)(nil),          // 164: service.DocumentsResponse
+	(*DocumentDeleteRequest)(nil),      // 165: service.DocumentDeleteRequest
+	(*DocumentDeleteResponse)(nil),     // 166: service.DocumentDeleteResponse
+	(*User)(nil),                       // 167: service.User
+	(*UserStatusEvent)(nil),            // 168: service.UserStatusEvent
+	(*UserRequest)(nil),                // 169: service.UserRequest
+	(*UserResponse)(nil),               // 170: service.UserResponse
+	(*UserSearchRequest)(nil),          // 171: service.UserSearchRequest
+	(*UserSearchResponse)(nil),         // 172: service.UserSearchResponse
+	(*SearchRequest)(nil),              // 173: service.SearchRequest
+	(*SearchResponse)(nil),             // 174: service.SearchResponse
+	(*VaultSyncRequest)(nil),           // 175: service.VaultSyncRequest
+	(*VaultSyncResponse)(nil),          // 176: service.VaultSyncResponse
+	(*VaultUnsyncRequest)(nil),         // 177: service.VaultUnsyncRequest
+	(*VaultUnsyncResponse)(nil),        // 178: service.VaultUnsyncResponse
+	(*VaultAuthRequest)(nil),           // 179: service.VaultAuthRequest
+	(*VaultAuthResponse)(nil),          // 180: service.VaultAuthResponse
+	(*VaultStatusRequest)(nil),         // 181: service.VaultStatusRequest
+	(*VaultStatusResponse)(nil),        // 182: service.VaultStatusResponse
+	(*VaultUsage)(nil),                 // 183: service.VaultUsage
+	(*VaultUpdateRequest)(nil),         // 184: service.VaultUpdateRequest
+	(*VaultUpdateResponse)(nil),        // 185: service.VaultUpdateResponse
+	(*AdminSignURLRequest)(nil),        // 186: service.AdminSignURLRequest
+	(*AdminSignURLResponse)(nil),       // 187: service.AdminSignURLResponse
+	(*AdminCheckRequest)(nil),          // 188: service.AdminCheckRequest
+	(*AdminCheckResponse)(nil),         // 189: service.AdminCheckResponse
+	(*Config)(nil),                     // 190: service.Config
+	(*ConfigGetRequest)(nil),           // 191: service.ConfigGetRequest
+	(*ConfigGetResponse)(nil),          // 192: service.ConfigGetResponse
+	(*ConfigSetRequest)(nil),           // 193: service.ConfigSetRequest
+	(*ConfigSetResponse)(nil),          // 194: service.ConfigSetResponse
+	(*WormholeInput)(nil),              // 195: service.WormholeInput
+	(*WormholeMessage)(nil),            // 196: service.WormholeMessage
+	(*WormholeOutput)(nil),             // 197: service.WormholeOutput
+	(*Config_App)(nil),                 // 198: service.Config.App
+	(*Config_Encrypt)(nil),             // 199: service.Config.Encrypt
+	(*Config_Sign)(nil),                // 200: service.Config.Sign
 }
 var file_rpc_proto_depIdxs = []int32{
-	86,  // 0: service.VerifyResponse.signer:type_name -> service.Key
-	86,  // 1: service.VerifyDetachedResponse.signer:type_name -> service.Key
-	86,  // 2: service.VerifyOutput.signer:type_name -> service.Key
-	86,  // 3: service.VerifyFileOutput.signer:type_name -> service.Key
-	86,  // 4: service.SigchainResponse.key:type_name -> service.Key
-	25,  // 5: service.SigchainResponse.statements:type_name -> service.Statement
-	25,  // 6: service.StatementResponse.statement:type_name -> service.Statement
-	25,  // 7: service.StatementCreateResponse.statement:type_name -> service.Statement
-	25,  // 8: service.StatementRevokeResponse.statement:type_name -> service.Statement
-	0,   // 9: service.EncryptOptions.mode:type_name -> service.EncryptMode
-	36,  // 10: service.EncryptRequest.options:type_name -> service.EncryptOptions
-	36,  // 11: service.EncryptFileInput.options:type_name -> service.EncryptOptions
-	36,  // 12: service.EncryptInput.options:type_name -> service.EncryptOptions
-	86,  // 13: service.DecryptResponse.sender:type_name -> service.Key
-	0,   // 14: service.DecryptResponse.mode:type_name -> service.EncryptMode
-	86,  // 15: service.DecryptFileOutput.sender:type_name -> service.Key
-	0,   // 16: service.DecryptFileOutput.mode:type_name -> service.EncryptMode
-	86,  // 17: service.DecryptOutput.sender:type_name -> service.Key
-	0,   // 18: service.DecryptOutput.mode:type_name -> service.EncryptMode
-	1,   // 19: service.RuntimeStatusResponse.authStatus:type_name -> service.AuthStatus
-	2,   // 20: service.AuthSetupRequest.type:type_name -> service.AuthType
-	2,   // 21: service.AuthUnlockRequest.type:type_name -> service.AuthType
-	2,   // 22: service.AuthProvisionRequest.type:type_name -> service.AuthType
-	63,  // 23: service.AuthProvisionResponse.provision:type_name -> service.AuthProvision
-	2,   // 24: service.AuthProvision.type:type_name -> service.AuthType
-	63,  // 25: service.AuthProvisionsResponse.provisions:type_name -> service.AuthProvision
-	116, // 26: service.UserAddResponse.user:type_name -> service.User
-	25,  // 27: service.UserAddResponse.statement:type_name -> service.Statement
-	3,   // 28: service.KeyExportRequest.type:type_name -> service.ExportType
-	116, // 29: service.Key.user:type_name -> service.User
-	86,  // 30: service.KeyResponse.key:type_name -> service.Key
-	4,   // 31: service.KeysRequest.sortDirection:type_name -> service.SortDirection
-	86,  // 32: service.KeysResponse.keys:type_name -> service.Key
-	4,   // 33: service.KeysResponse.sortDirection:type_name -> service.SortDirection
-	5,   // 34: service.Secret.type:type_name -> service.SecretType
-	91,  // 35: service.SecretResponse.secret:type_name -> service.Secret
-	91,  // 36: service.SecretSaveRequest.secret:type_name -> service.Secret
-	91,  // 37: service.SecretSaveResponse.secret:type_name -> service.Secret
-	4,   // 38: service.SecretsRequest.sortDirection:type_name -> service.SortDirection
-	91,  // 39: service.SecretsResponse.secrets:type_name -> service.Secret
-	4,   // 40: service.SecretsResponse.sortDirection:type_name -> service.SortDirection
-	6,   // 41: service.RandRequest.encoding:type_name -> service.Encoding
-	108, // 42: service.CollectionsResponse.collections:type_name -> service.Collection
-	111, // 43: service.DocumentsResponse.documents:type_name -> service.Document
-	7,   // 44: service.User.status:type_name -> service.UserStatus
-	116, // 45: service.UserResponse.user:type_name -> service.User
-	116, // 46: service.UserSearchResponse.users:type_name -> service.User
-	86,  // 47: service.SearchResponse.keys:type_name -> service.Key
-	86,  // 48: service.Message.sender:type_name -> service.Key
-	8,   // 49: service.Message.status:type_name -> service.MessageStatus
-	133, // 50: service.MessagePrepareResponse.message:type_name -> service.Message
-	133, // 51: service.MessageCreateResponse.message:type_name -> service.Message
-	133, // 52: service.MessagesResponse.messages:type_name -> service.Message
-	142, // 53: service.ChannelsResponse.channels:type_name -> service.Channel
-	142, // 54: service.ChannelCreateResponse.channel:type_name -> service.Channel
-	133, // 55: service.ChannelInviteResponse.message:type_name -> service.Message
-	153, // 56: service.FollowResponse.follow:type_name -> service.Follow
-	153, // 57: service.FollowsResponse.follows:type_name -> service.Follow
-	170, // 58: service.Config.app:type_name -> service.Config.App
-	171, // 59: service.Config.encrypt:type_name -> service.Config.Encrypt
-	172, // 60: service.Config.sign:type_name -> service.Config.Sign
-	162, // 61: service.ConfigGetResponse.config:type_name -> service.Config
-	162, // 62: service.ConfigSetRequest.config:type_name -> service.Config
-	86,  // 63: service.WormholeMessage.sender:type_name -> service.Key
-	86,  // 64: service.WormholeMessage.recipient:type_name -> service.Key
-	10,  // 65: service.WormholeMessage.status:type_name -> service.WormholeMessageStatus
-	168, // 66: service.WormholeOutput.message:type_name -> service.WormholeMessage
-	9,   // 67: service.WormholeOutput.status:type_name -> service.WormholeStatus
-	72,  // 68: service.RPC.KeyGenerate:input_type -> service.KeyGenerateRequest
-	89,  // 69: service.RPC.Keys:input_type -> service.KeysRequest
-	87,  // 70: service.RPC.Key:input_type -> service.KeyRequest
-	82,  // 71: service.RPC.KeyImport:input_type -> service.KeyImportRequest
-	80,  // 72: service.RPC.KeyExport:input_type -> service.KeyExportRequest
-	84,  // 73: service.RPC.KeyRemove:input_type -> service.KeyRemoveRequest
-	11,  // 74: service.RPC.Sign:input_type -> service.SignRequest
-	13,  // 75: service.RPC.SignFile:input_type -> service.SignFileInput
-	34,  // 76: service.RPC.SignStream:input_type -> service.SignInput
-	15,  // 77: service.RPC.Verify:input_type -> service.VerifyRequest
-	21,  // 78: service.RPC.VerifyFile:input_type -> service.VerifyFileInput
-	19,  // 79: service.RPC.VerifyStream:input_type -> service.VerifyInput
-	17,  // 80: service.RPC.VerifyDetached:input_type -> service.VerifyDetachedRequest
-	23,  // 81: service.RPC.VerifyDetachedFile:input_type -> service.VerifyDetachedFileInput
-	24,  // 82: service.RPC.VerifyDetachedStream:input_type -> service.VerifyDetachedInput
-	37,  // 83: service.RPC.Encrypt:input_type -> service.EncryptRequest
-	41,  // 84: service.RPC.EncryptStream:input_type -> service.EncryptInput
-	39,  // 85: service.RPC.EncryptFile:input_type -> service.EncryptFileInput
-	43,  // 86: service.RPC.Decrypt:input_type -> service.DecryptRequest
-	47,  // 87: service.RPC.DecryptStream:input_type -> service.DecryptInput
-	45,  // 88: service.RPC.DecryptFile:input_type -> service.DecryptFileInput
-	26,  // 89: service.RPC.Sigchain:input_type -> service.SigchainRequest
-	28,  // 90: service.RPC.Statement:input_type -> service.StatementRequest
-	30,  // 91: service.RPC.StatementCreate:input_type -> service.StatementCreateRequest
-	32,  // 92: service.RPC.StatementRevoke:input_type -> service.StatementRevokeRequest
-	117, // 93: service.RPC.User:input_type -> service.UserRequest
-	119, // 94: service.RPC.UserSearch:input_type -> service.UserSearchRequest
-	74,  // 95: service.RPC.UserService:input_type -> service.UserServiceRequest
-	76,  // 96: service.RPC.UserSign:input_type -> service.UserSignRequest
-	78,  // 97: service.RPC.UserAdd:input_type -> service.UserAddRequest
-	121, // 98: service.RPC.Search:input_type -> service.SearchRequest
-	92,  // 99: service.RPC.Secret:input_type -> service.SecretRequest
-	94,  // 100: service.RPC.SecretSave:input_type -> service.SecretSaveRequest
-	96,  // 101: service.RPC.SecretRemove:input_type -> service.SecretRemoveRequest
-	98,  // 102: service.RPC.Secrets:input_type -> service.SecretsRequest
-	104, // 103: service.RPC.Pull:input_type -> service.PullRequest
-	106, // 104: service.RPC.Push:input_type -> service.PushRequest
-	167, // 105: service.RPC.Wormhole:input_type -> service.WormholeInput
-	51,  // 106: service.RPC.AuthSetup:input_type -> service.AuthSetupRequest
-	53,  // 107: service.RPC.AuthVault:input_type -> service.AuthVaultRequest
-	55,  // 108: service.RPC.AuthUnlock:input_type -> service.AuthUnlockRequest
-	66,  // 109: service.RPC.AuthLock:input_type -> service.AuthLockRequest
-	68,  // 110: service.RPC.AuthReset:input_type -> service.AuthResetRequest
-	70,  // 111: service.RPC.AuthRecover:input_type -> service.AuthRecoverRequest
-	49,  // 112: service.RPC.RuntimeStatus:input_type -> service.RuntimeStatusRequest
-	100, // 113: service.RPC.Rand:input_type -> service.RandRequest
-	102, // 114: service.RPC.RandPassword:input_type -> service.RandPasswordRequest
-	57,  // 115: service.RPC.AuthProvision:input_type -> service.AuthProvisionRequest
-	59,  // 116: service.RPC.AuthDeprovision:input_type -> service.AuthDeprovisionRequest
-	64,  // 117: service.RPC.AuthProvisions:input_type -> service.AuthProvisionsRequest
-	61,  // 118: service.RPC.AuthPasswordChange:input_type -> service.AuthPasswordChangeRequest
-	127, // 119: service.RPC.VaultAuth:input_type -> service.VaultAuthRequest
-	123, // 120: service.RPC.VaultSync:input_type -> service.VaultSyncRequest
-	125, // 121: service.RPC.VaultUnsync:input_type -> service.VaultUnsyncRequest
-	129, // 122: service.RPC.VaultStatus:input_type -> service.VaultStatusRequest
-	131, // 123: service.RPC.VaultUpdate:input_type -> service.VaultUpdateRequest
-	109, // 124: service.RPC.Collections:input_type -> service.CollectionsRequest
-	112, // 125: service.RPC.Documents:input_type -> service.DocumentsRequest
-	114, // 126: service.RPC.DocumentDelete:input_type -> service.DocumentDeleteRequest
-	163, // 127: service.RPC.ConfigGet:input_type -> service.ConfigGetRequest
-	165, // 128: service.RPC.ConfigSet:input_type -> service.ConfigSetRequest
-	158, // 129: service.RPC.AdminSignURL:input_type -> service.AdminSignURLRequest
-	160, // 130: service.RPC.AdminCheck:input_type -> service.AdminCheckRequest
-	143, // 131: service.RPC.Channels:input_type -> service.ChannelsRequest
-	145, // 132: service.RPC.ChannelCreate:input_type -> service.ChannelCreateRequest
-	151, // 133: service.RPC.ChannelInvite:input_type -> service.ChannelInviteRequest
-	147, // 134: service.RPC.ChannelLeave:input_type -> service.ChannelLeaveRequest
-	149, // 135: service.RPC.ChannelRead:input_type -> service.ChannelReadRequest
-	154, // 136: service.RPC.Follow:input_type -> service.FollowRequest
-	156, // 137: service.RPC.Follows:input_type -> service.FollowsRequest
-	134, // 138: service.RPC.MessagePrepare:input_type -> service.MessagePrepareRequest
-	136, // 139: service.RPC.MessageCreate:input_type -> service.MessageCreateRequest
-	138, // 140: service.RPC.Messages:input_type -> service.MessagesRequest
-	140, // 141: service.RPC.Relay:input_type -> service.RelayRequest
-	73,  // 142: service.RPC.KeyGenerate:output_type -> service.KeyGenerateResponse
-	90,  // 143: service.RPC.Keys:output_type -> service.KeysResponse
-	88,  // 144: service.RPC.Key:output_type -> service.KeyResponse
-	83,  // 145: service.RPC.KeyImport:output_type -> service.KeyImportResponse
-	81,  // 146: service.RPC.KeyExport:output_type -> service.KeyExportResponse
-	85,  // 147: service.RPC.KeyRemove:output_type -> service.KeyRemoveResponse
-	12,  // 148: service.RPC.Sign:output_type -> service.SignResponse
-	14,  // 149: service.RPC.SignFile:output_type -> service.SignFileOutput
-	35,  // 150: service.RPC.SignStream:output_type -> service.SignOutput
-	16,  // 151: service.RPC.Verify:output_type -> service.VerifyResponse
-	22,  // 152: service.RPC.VerifyFile:output_type -> service.VerifyFileOutput
-	20,  // 153: service.RPC.VerifyStream:output_type -> service.VerifyOutput
-	18,  // 154: service.RPC.VerifyDetached:output_type -> service.VerifyDetachedResponse
-	18,  // 155: service.RPC.VerifyDetachedFile:output_type -> service.VerifyDetachedResponse
-	18,  // 156: service.RPC.VerifyDetachedStream:output_type -> service.VerifyDetachedResponse
-	38,  // 157: service.RPC.Encrypt:output_type -> service.EncryptResponse
-	42,  // 158: service.RPC.EncryptStream:output_type -> service.EncryptOutput
-	40,  // 159: service.RPC.EncryptFile:output_type -> service.EncryptFileOutput
-	44,  // 160: service.RPC.Decrypt:output_type -> service.DecryptResponse
-	48,  // 161: service.RPC.DecryptStream:output_type -> service.DecryptOutput
-	46,  // 162: service.RPC.DecryptFile:output_type -> service.DecryptFileOutput
-	27,  // 163: service.RPC.Sigchain:output_type -> service.SigchainResponse
-	29,  // 164: service.RPC.Statement:output_type -> service.StatementResponse
-	31,  // 165: service.RPC.StatementCreate:output_type -> service.StatementCreateResponse
-	33,  // 166: service.RPC.StatementRevoke:output_type -> service.StatementRevokeResponse
-	118, // 167: service.RPC.User:output_type -> service.UserResponse
-	120, // 168: service.RPC.UserSearch:output_type -> service.UserSearchResponse
-	75,  // 169: service.RPC.UserService:output_type -> service.UserServiceResponse
-	77,  // 170: service.RPC.UserSign:output_type -> service.UserSignResponse
-	79,  // 171: service.RPC.UserAdd:output_type -> service.UserAddResponse
-	122, // 172: service.RPC.Search:output_type -> service.SearchResponse
-	93,  // 173: service.RPC.Secret:output_type -> service.SecretResponse
-	95,  // 174: service.RPC.SecretSave:output_type -> service.SecretSaveResponse
-	97,  // 175: service.RPC.SecretRemove:output_type -> service.SecretRemoveResponse
-	99,  // 176: service.RPC.Secrets:output_type -> service.SecretsResponse
-	105, // 177: service.RPC.Pull:output_type -> service.PullResponse
-	107, // 178: service.RPC.Push:output_type -> service.PushResponse
-	169, // 179: service.RPC.Wormhole:output_type -> service.WormholeOutput
-	52,  // 180: service.RPC.AuthSetup:output_type -> service.AuthSetupResponse
-	54,  // 181: service.RPC.AuthVault:output_type -> service.AuthVaultResponse
-	56,  // 182: service.RPC.AuthUnlock:output_type -> service.AuthUnlockResponse
-	67,  // 183: service.RPC.AuthLock:output_type -> service.AuthLockResponse
-	69,  // 184: service.RPC.AuthReset:output_type -> service.AuthResetResponse
-	71,  // 185: service.RPC.AuthRecover:output_type -> service.AuthRecoverResponse
-	50,  // 186: service.RPC.RuntimeStatus:output_type -> service.RuntimeStatusResponse
-	101, // 187: service.RPC.Rand:output_type -> service.RandResponse
-	103, // 188: service.RPC.RandPassword:output_type -> service.RandPasswordResponse
-	58,  // 189: service.RPC.AuthProvision:output_type -> service.AuthProvisionResponse
-	60,  // 190: service.RPC.AuthDeprovision:output_type -> service.AuthDeprovisionResponse
-	65,  // 191: service.RPC.AuthProvisions:output_type -> service.AuthProvisionsResponse
-	62,  // 192: service.RPC.AuthPasswordChange:output_type -> service.AuthPasswordChangeResponse
-	128, // 193: service.RPC.VaultAuth:output_type -> service.VaultAuthResponse
-	124, // 194: service.RPC.VaultSync:output_type -> service.VaultSyncResponse
-	126, // 195: service.RPC.VaultUnsync:output_type -> service.VaultUnsyncResponse
-	130, // 196: service.RPC.VaultStatus:output_type -> service.VaultStatusResponse
-	132, // 197: service.RPC.VaultUpdate:output_type -> service.VaultUpdateResponse
-	110, // 198: service.RPC.Collections:output_type -> service.CollectionsResponse
-	113, // 199: service.RPC.Documents:output_type -> service.DocumentsResponse
-	115, // 200: service.RPC.DocumentDelete:output_type -> service.DocumentDeleteResponse
-	164, // 201: service.RPC.ConfigGet:output_type -> service.ConfigGetResponse
-	166, // 202: service.RPC.ConfigSet:output_type -> service.ConfigSetResponse
-	159, // 203: service.RPC.AdminSignURL:output_type -> service.AdminSignURLResponse
-	161, // 204: service.RPC.AdminCheck:output_type -> service.AdminCheckResponse
-	144, // 205: service.RPC.Channels:output_type -> service.ChannelsResponse
-	146, // 206: service.RPC.ChannelCreate:output_type -> service.ChannelCreateResponse
-	152, // 207: service.RPC.ChannelInvite:output_type -> service.ChannelInviteResponse
-	148, // 208: service.RPC.ChannelLeave:output_type -> service.ChannelLeaveResponse
-	150, // 209: service.RPC.ChannelRead:output_type -> service.ChannelReadResponse
-	155, // 210: service.RPC.Follow:output_type -> service.FollowResponse
-	157, // 211: service.RPC.Follows:output_type -> service.FollowsResponse
-	135, // 212: service.RPC.MessagePrepare:output_type -> service.MessagePrepareResponse
-	137, // 213: service.RPC.MessageCreate:output_type -> service.MessageCreateResponse
-	139, // 214: service.RPC.Messages:output_type -> service.MessagesResponse
-	141, // 215: service.RPC.Relay:output_type -> service.RelayOutput
-	142, // [142:216] is the sub-list for method output_type
-	68,  // [68:142] is the sub-list for method input_type
-	68,  // [68:68] is the sub-list for extension type_name
-	68,  // [68:68] is the sub-list for extension extendee
-	0,   // [0:68] is the sub-list for field type_name
+	0,   // 0: service.SignRequest.format:type_name -> service.SignatureFormat
+	0,   // 1: service.SignFileInput.format:type_name -> service.SignatureFormat
+	112, // 2: service.VerifyResponse.signer:type_name -> service.Key
+	21,  // 3: service.VerifyResponse.envelope:type_name -> service.SignatureEnvelope
+	0,   // 4: service.VerifyDetachedRequest.format:type_name -> service.SignatureFormat
+	112, // 5: service.VerifyDetachedResponse.signer:type_name -> service.Key
+	21,  // 6: service.VerifyDetachedResponse.envelope:type_name -> service.SignatureEnvelope
+	26,  // 7: service.VerifyBundleRequest.policy:type_name -> service.SignaturePolicy
+	112, // 8: service.VerifyBundleResponse.signers:type_name -> service.Key
+	112, // 9: service.VerifyOutput.signer:type_name -> service.Key
+	112, // 10: service.VerifyFileOutput.signer:type_name -> service.Key
+	1,   // 11: service.Statement.status:type_name -> service.StatementStatus
+	112, // 12: service.SigchainResponse.key:type_name -> service.Key
+	35,  // 13: service.SigchainResponse.statements:type_name -> service.Statement
+	35,  // 14: service.StatementResponse.statement:type_name -> service.Statement
+	35,  // 15: service.StatementCreateResponse.statement:type_name -> service.Statement
+	35,  // 16: service.StatementRevokeResponse.statement:type_name -> service.Statement
+	35,  // 17: service.SigchainPublishResponse.statements:type_name -> service.Statement
+	35,  // 18: service.SigchainVerifyResponse.statements:type_name -> service.Statement
+	167, // 19: service.SigchainVerifyResponse.users:type_name -> service.User
+	0,   // 20: service.SignInput.format:type_name -> service.SignatureFormat
+	2,   // 21: service.EncryptOptions.mode:type_name -> service.EncryptMode
+	52,  // 22: service.EncryptRequest.options:type_name -> service.EncryptOptions
+	52,  // 23: service.EncryptFileInput.options:type_name -> service.EncryptOptions
+	52,  // 24: service.EncryptInput.options:type_name -> service.EncryptOptions
+	112, // 25: service.DecryptResponse.sender:type_name -> service.Key
+	2,   // 26: service.DecryptResponse.mode:type_name -> service.EncryptMode
+	2,   // 27: service.DecryptInspectResponse.mode:type_name -> service.EncryptMode
+	112, // 28: service.DecryptInspectResponse.recipients:type_name -> service.Key
+	112, // 29: service.DecryptInspectResponse.sender:type_name -> service.Key
+	112, // 30: service.DecryptInspectResponse.recipient:type_name -> service.Key
+	112, // 31: service.DecryptFileOutput.sender:type_name -> service.Key
+	2,   // 32: service.DecryptFileOutput.mode:type_name -> service.EncryptMode
+	112, // 33: service.DecryptOutput.sender:type_name -> service.Key
+	2,   // 34: service.DecryptOutput.mode:type_name -> service.EncryptMode
+	3,   // 35: service.RuntimeStatusResponse.authStatus:type_name -> service.AuthStatus
+	69,  // 36: service.RuntimeStatusResponse.keyExpiries:type_name -> service.KeyExpiry
+	4,   // 37: service.AuthSetupRequest.type:type_name -> service.AuthType
+	4,   // 38: service.AuthUnlockRequest.type:type_name -> service.AuthType
+	4,   // 39: service.AuthProvisionRequest.type:type_name -> service.AuthType
+	82,  // 40: service.AuthProvisionResponse.provision:type_name -> service.AuthProvision
+	4,   // 41: service.AuthProvision.type:type_name -> service.AuthType
+	82,  // 42: service.AuthProvisionsResponse.provisions:type_name -> service.AuthProvision
+	167, // 43: service.UserAddResponse.user:type_name -> service.User
+	35,  // 44: service.UserAddResponse.statement:type_name -> service.Statement
+	5,   // 45: service.KeyExportRequest.type:type_name -> service.ExportType
+	35,  // 46: service.KeyRotateResponse.statement:type_name -> service.Statement
+	112, // 47: service.KeyRotateResponse.successor:type_name -> service.Key
+	35,  // 48: service.KeyEndorseResponse.statement:type_name -> service.Statement
+	10,  // 49: service.Endorsement.trust:type_name -> service.TrustLevel
+	6,   // 50: service.KeyUpdateRequest.sshAgent:type_name -> service.SSHAgentPolicy
+	112, // 51: service.KeyUpdateResponse.key:type_name -> service.Key
+	167, // 52: service.Key.user:type_name -> service.User
+	6,   // 53: service.Key.sshAgent:type_name -> service.SSHAgentPolicy
+	10,  // 54: service.Key.trust:type_name -> service.TrustLevel
+	109, // 55: service.Key.endorsements:type_name -> service.Endorsement
+	112, // 56: service.KeyResponse.key:type_name -> service.Key
+	7,   // 57: service.KeysRequest.sortDirection:type_name -> service.SortDirection
+	112, // 58: service.KeysResponse.keys:type_name -> service.Key
+	7,   // 59: service.KeysResponse.sortDirection:type_name -> service.SortDirection
+	8,   // 60: service.Secret.type:type_name -> service.SecretType
+	117, // 61: service.SecretResponse.secret:type_name -> service.Secret
+	117, // 62: service.SecretSaveRequest.secret:type_name -> service.Secret
+	117, // 63: service.SecretSaveResponse.secret:type_name -> service.Secret
+	7,   // 64: service.SecretsRequest.sortDirection:type_name -> service.SortDirection
+	117, // 65: service.SecretsResponse.secrets:type_name -> service.Secret
+	7,   // 66: service.SecretsResponse.sortDirection:type_name -> service.SortDirection
+	126, // 67: service.GroupResponse.group:type_name -> service.Group
+	126, // 68: service.GroupSaveResponse.group:type_name -> service.Group
+	126, // 69: service.GroupsResponse.groups:type_name -> service.Group
+	135, // 70: service.ContactResponse.contact:type_name -> service.Contact
+	135, // 71: service.ContactSaveRequest.contact:type_name -> service.Contact
+	135, // 72: service.ContactSaveResponse.contact:type_name -> service.Contact
+	135, // 73: service.ContactsResponse.contacts:type_name -> service.Contact
+	9,   // 74: service.Pin.method:type_name -> service.PinMethod
+	10,  // 75: service.Pin.level:type_name -> service.TrustLevel
+	9,   // 76: service.TrustPinRequest.method:type_name -> service.PinMethod
+	10,  // 77: service.TrustPinRequest.level:type_name -> service.TrustLevel
+	144, // 78: service.TrustPinResponse.pin:type_name -> service.Pin
+	144, // 79: service.PinsResponse.pins:type_name -> service.Pin
+	11,  // 80: service.RandRequest.encoding:type_name -> service.Encoding
+	159, // 81: service.CollectionsResponse.collections:type_name -> service.Collection
+	162, // 82: service.DocumentsResponse.documents:type_name -> service.Document
+	12,  // 83: service.User.status:type_name -> service.UserStatus
+	12,  // 84: service.UserStatusEvent.status:type_name -> service.UserStatus
+	167, // 85: service.UserResponse.user:type_name -> service.User
+	168, // 86: service.UserResponse.history:type_name -> service.UserStatusEvent
+	167, // 87: service.UserSearchResponse.users:type_name -> service.User
+	112, // 88: service.SearchResponse.keys:type_name -> service.Key
+	183, // 89: service.VaultStatusResponse.usage:type_name -> service.VaultUsage
+	198, // 90: service.Config.app:type_name -> service.Config.App
+	199, // 91: service.Config.encrypt:type_name -> service.Config.Encrypt
+	200, // 92: service.Config.sign:type_name -> service.Config.Sign
+	190, // 93: service.ConfigGetResponse.config:type_name -> service.Config
+	190, // 94: service.ConfigSetRequest.config:type_name -> service.Config
+	112, // 95: service.WormholeMessage.sender:type_name -> service.Key
+	112, // 96: service.WormholeMessage.recipient:type_name -> service.Key
+	14,  // 97: service.WormholeMessage.status:type_name -> service.WormholeMessageStatus
+	196, // 98: service.WormholeOutput.message:type_name -> service.WormholeMessage
+	13,  // 99: service.WormholeOutput.status:type_name -> service.WormholeStatus
+	91,  // 100: service.RPC.KeyGenerate:input_type -> service.KeyGenerateRequest
+	115, // 101: service.RPC.Keys:input_type -> service.KeysRequest
+	113, // 102: service.RPC.Key:input_type -> service.KeyRequest
+	101, // 103: service.RPC.KeyImport:input_type -> service.KeyImportRequest
+	99,  // 104: service.RPC.KeyExport:input_type -> service.KeyExportRequest
+	103, // 105: service.RPC.KeyRemove:input_type -> service.KeyRemoveRequest
+	110, // 106: service.RPC.KeyUpdate:input_type -> service.KeyUpdateRequest
+	105, // 107: service.RPC.KeyRotate:input_type -> service.KeyRotateRequest
+	107, // 108: service.RPC.KeyEndorse:input_type -> service.KeyEndorseRequest
+	15,  // 109: service.RPC.Sign:input_type -> service.SignRequest
+	17,  // 110: service.RPC.SignFile:input_type -> service.SignFileInput
+	50,  // 111: service.RPC.SignStream:input_type -> service.SignInput
+	19,  // 112: service.RPC.Verify:input_type -> service.VerifyRequest
+	31,  // 113: service.RPC.VerifyFile:input_type -> service.VerifyFileInput
+	29,  // 114: service.RPC.VerifyStream:input_type -> service.VerifyInput
+	22,  // 115: service.RPC.VerifyDetached:input_type -> service.VerifyDetachedRequest
+	33,  // 116: service.RPC.VerifyDetachedFile:input_type -> service.VerifyDetachedFileInput
+	34,  // 117: service.RPC.VerifyDetachedStream:input_type -> service.VerifyDetachedInput
+	24,  // 118: service.RPC.SignBundle:input_type -> service.SignBundleRequest
+	27,  // 119: service.RPC.VerifyBundle:input_type -> service.VerifyBundleRequest
+	53,  // 120: service.RPC.Encrypt:input_type -> service.EncryptRequest
+	57,  // 121: service.RPC.EncryptStream:input_type -> service.EncryptInput
+	55,  // 122: service.RPC.EncryptFile:input_type -> service.EncryptFileInput
+	59,  // 123: service.RPC.Decrypt:input_type -> service.DecryptRequest
+	65,  // 124: service.RPC.DecryptStream:input_type -> service.DecryptInput
+	63,  // 125: service.RPC.DecryptFile:input_type -> service.DecryptFileInput
+	61,  // 126: service.RPC.DecryptInspect:input_type -> service.DecryptInspectRequest
+	36,  // 127: service.RPC.Sigchain:input_type -> service.SigchainRequest
+	38,  // 128: service.RPC.Statement:input_type -> service.StatementRequest
+	40,  // 129: service.RPC.StatementCreate:input_type -> service.StatementCreateRequest
+	42,  // 130: service.RPC.StatementRevoke:input_type -> service.StatementRevokeRequest
+	44,  // 131: service.RPC.SigchainPublish:input_type -> service.SigchainPublishRequest
+	46,  // 132: service.RPC.SigchainExport:input_type -> service.SigchainExportRequest
+	48,  // 133: service.RPC.SigchainVerify:input_type -> service.SigchainVerifyRequest
+	169, // 134: service.RPC.User:input_type -> service.UserRequest
+	171, // 135: service.RPC.UserSearch:input_type -> service.UserSearchRequest
+	93,  // 136: service.RPC.UserService:input_type -> service.UserServiceRequest
+	95,  // 137: service.RPC.UserSign:input_type -> service.UserSignRequest
+	97,  // 138: service.RPC.UserAdd:input_type -> service.UserAddRequest
+	173, // 139: service.RPC.Search:input_type -> service.SearchRequest
+	118, // 140: service.RPC.Secret:input_type -> service.SecretRequest
+	120, // 141: service.RPC.SecretSave:input_type -> service.SecretSaveRequest
+	122, // 142: service.RPC.SecretRemove:input_type -> service.SecretRemoveRequest
+	124, // 143: service.RPC.Secrets:input_type -> service.SecretsRequest
+	127, // 144: service.RPC.Group:input_type -> service.GroupRequest
+	129, // 145: service.RPC.GroupSave:input_type -> service.GroupSaveRequest
+	131, // 146: service.RPC.GroupRemove:input_type -> service.GroupRemoveRequest
+	133, // 147: service.RPC.Groups:input_type -> service.GroupsRequest
+	136, // 148: service.RPC.Contact:input_type -> service.ContactRequest
+	138, // 149: service.RPC.ContactSave:input_type -> service.ContactSaveRequest
+	140, // 150: service.RPC.ContactRemove:input_type -> service.ContactRemoveRequest
+	142, // 151: service.RPC.Contacts:input_type -> service.ContactsRequest
+	145, // 152: service.RPC.TrustPin:input_type -> service.TrustPinRequest
+	147, // 153: service.RPC.TrustUnpin:input_type -> service.TrustUnpinRequest
+	149, // 154: service.RPC.Pins:input_type -> service.PinsRequest
+	155, // 155: service.RPC.Pull:input_type -> service.PullRequest
+	157, // 156: service.RPC.Push:input_type -> service.PushRequest
+	195, // 157: service.RPC.Wormhole:input_type -> service.WormholeInput
+	70,  // 158: service.RPC.AuthSetup:input_type -> service.AuthSetupRequest
+	72,  // 159: service.RPC.AuthVault:input_type -> service.AuthVaultRequest
+	74,  // 160: service.RPC.AuthUnlock:input_type -> service.AuthUnlockRequest
+	85,  // 161: service.RPC.AuthLock:input_type -> service.AuthLockRequest
+	87,  // 162: service.RPC.AuthReset:input_type -> service.AuthResetRequest
+	89,  // 163: service.RPC.AuthRecover:input_type -> service.AuthRecoverRequest
+	67,  // 164: service.RPC.RuntimeStatus:input_type -> service.RuntimeStatusRequest
+	151, // 165: service.RPC.Rand:input_type -> service.RandRequest
+	153, // 166: service.RPC.RandPassword:input_type -> service.RandPasswordRequest
+	76,  // 167: service.RPC.AuthProvision:input_type -> service.AuthProvisionRequest
+	78,  // 168: service.RPC.AuthDeprovision:input_type -> service.AuthDeprovisionRequest
+	83,  // 169: service.RPC.AuthProvisions:input_type -> service.AuthProvisionsRequest
+	80,  // 170: service.RPC.AuthPasswordChange:input_type -> service.AuthPasswordChangeRequest
+	179, // 171: service.RPC.VaultAuth:input_type -> service.VaultAuthRequest
+	175, // 172: service.RPC.VaultSync:input_type -> service.VaultSyncRequest
+	177, // 173: service.RPC.VaultUnsync:input_type -> service.VaultUnsyncRequest
+	181, // 174: service.RPC.VaultStatus:input_type -> service.VaultStatusRequest
+	184, // 175: service.RPC.VaultUpdate:input_type -> service.VaultUpdateRequest
+	160, // 176: service.RPC.Collections:input_type -> service.CollectionsRequest
+	163, // 177: service.RPC.Documents:input_type -> service.DocumentsRequest
+	165, // 178: service.RPC.DocumentDelete:input_type -> service.DocumentDeleteRequest
+	191, // 179: service.RPC.ConfigGet:input_type -> service.ConfigGetRequest
+	193, // 180: service.RPC.ConfigSet:input_type -> service.ConfigSetRequest
+	186, // 181: service.RPC.AdminSignURL:input_type -> service.AdminSignURLRequest
+	188, // 182: service.RPC.AdminCheck:input_type -> service.AdminCheckRequest
+	92,  // 183: service.RPC.KeyGenerate:output_type -> service.KeyGenerateResponse
+	116, // 184: service.RPC.Keys:output_type -> service.KeysResponse
+	114, // 185: service.RPC.Key:output_type -> service.KeyResponse
+	102, // 186: service.RPC.KeyImport:output_type -> service.KeyImportResponse
+	100, // 187: service.RPC.KeyExport:output_type -> service.KeyExportResponse
+	104, // 188: service.RPC.KeyRemove:output_type -> service.KeyRemoveResponse
+	111, // 189: service.RPC.KeyUpdate:output_type -> service.KeyUpdateResponse
+	106, // 190: service.RPC.KeyRotate:output_type -> service.KeyRotateResponse
+	108, // 191: service.RPC.KeyEndorse:output_type -> service.KeyEndorseResponse
+	16,  // 192: service.RPC.Sign:output_type -> service.SignResponse
+	18,  // 193: service.RPC.SignFile:output_type -> service.SignFileOutput
+	51,  // 194: service.RPC.SignStream:output_type -> service.SignOutput
+	20,  // 195: service.RPC.Verify:output_type -> service.VerifyResponse
+	32,  // 196: service.RPC.VerifyFile:output_type -> service.VerifyFileOutput
+	30,  // 197: service.RPC.VerifyStream:output_type -> service.VerifyOutput
+	23,  // 198: service.RPC.VerifyDetached:output_type -> service.VerifyDetachedResponse
+	23,  // 199: service.RPC.VerifyDetachedFile:output_type -> service.VerifyDetachedResponse
+	23,  // 200: service.RPC.VerifyDetachedStream:output_type -> service.VerifyDetachedResponse
+	25,  // 201: service.RPC.SignBundle:output_type -> service.SignBundleResponse
+	28,  // 202: service.RPC.VerifyBundle:output_type -> service.VerifyBundleResponse
+	54,  // 203: service.RPC.Encrypt:output_type -> service.EncryptResponse
+	58,  // 204: service.RPC.EncryptStream:output_type -> service.EncryptOutput
+	56,  // 205: service.RPC.EncryptFile:output_type -> service.EncryptFileOutput
+	60,  // 206: service.RPC.Decrypt:output_type -> service.DecryptResponse
+	66,  // 207: service.RPC.DecryptStream:output_type -> service.DecryptOutput
+	64,  // 208: service.RPC.DecryptFile:output_type -> service.DecryptFileOutput
+	62,  // 209: service.RPC.DecryptInspect:output_type -> service.DecryptInspectResponse
+	37,  // 210: service.RPC.Sigchain:output_type -> service.SigchainResponse
+	39,  // 211: service.RPC.Statement:output_type -> service.StatementResponse
+	41,  // 212: service.RPC.StatementCreate:output_type -> service.StatementCreateResponse
+	43,  // 213: service.RPC.StatementRevoke:output_type -> service.StatementRevokeResponse
+	45,  // 214: service.RPC.SigchainPublish:output_type -> service.SigchainPublishResponse
+	47,  // 215: service.RPC.SigchainExport:output_type -> service.SigchainExportResponse
+	49,  // 216: service.RPC.SigchainVerify:output_type -> service.SigchainVerifyResponse
+	170, // 217: service.RPC.User:output_type -> service.UserResponse
+	172, // 218: service.RPC.UserSearch:output_type -> service.UserSearchResponse
+	94,  // 219: service.RPC.UserService:output_type -> service.UserServiceResponse
+	96,  // 220: service.RPC.UserSign:output_type -> service.UserSignResponse
+	98,  // 221: service.RPC.UserAdd:output_type -> service.UserAddResponse
+	174, // 222: service.RPC.Search:output_type -> service.SearchResponse
+	119, // 223: service.RPC.Secret:output_type -> service.SecretResponse
+	121, // 224: service.RPC.SecretSave:output_type -> service.SecretSaveResponse
+	123, // 225: service.RPC.SecretRemove:output_type -> service.SecretRemoveResponse
+	125, // 226: service.RPC.Secrets:output_type -> service.SecretsResponse
+	128, // 227: service.RPC.Group:output_type -> service.GroupResponse
+	130, // 228: service.RPC.GroupSave:output_type -> service.GroupSaveResponse
+	132, // 229: service.RPC.GroupRemove:output_type -> service.GroupRemoveResponse
+	134, // 230: service.RPC.Groups:output_type -> service.GroupsResponse
+	137, // 231: service.RPC.Contact:output_type -> service.ContactResponse
+	139, // 232: service.RPC.ContactSave:output_type -> service.ContactSaveResponse
+	141, // 233: service.RPC.ContactRemove:output_type -> service.ContactRemoveResponse
+	143, // 234: service.RPC.Contacts:output_type -> service.ContactsResponse
+	146, // 235: service.RPC.TrustPin:output_type -> service.TrustPinResponse
+	148, // 236: service.RPC.TrustUnpin:output_type -> service.TrustUnpinResponse
+	150, // 237: service.RPC.Pins:output_type -> service.PinsResponse
+	156, // 238: service.RPC.Pull:output_type -> service.PullResponse
+	158, // 239: service.RPC.Push:output_type -> service.PushResponse
+	197, // 240: service.RPC.Wormhole:output_type -> service.WormholeOutput
+	71,  // 241: service.RPC.AuthSetup:output_type -> service.AuthSetupResponse
+	73,  // 242: service.RPC.AuthVault:output_type -> service.AuthVaultResponse
+	75,  // 243: service.RPC.AuthUnlock:output_type -> service.AuthUnlockResponse
+	86,  // 244: service.RPC.AuthLock:output_type -> service.AuthLockResponse
+	88,  // 245: service.RPC.AuthReset:output_type -> service.AuthResetResponse
+	90,  // 246: service.RPC.AuthRecover:output_type -> service.AuthRecoverResponse
+	68,  // 247: service.RPC.RuntimeStatus:output_type -> service.RuntimeStatusResponse
+	152, // 248: service.RPC.Rand:output_type -> service.RandResponse
+	154, // 249: service.RPC.RandPassword:output_type -> service.RandPasswordResponse
+	77,  // 250: service.RPC.AuthProvision:output_type -> service.AuthProvisionResponse
+	79,  // 251: service.RPC.AuthDeprovision:output_type -> service.AuthDeprovisionResponse
+	84,  // 252: service.RPC.AuthProvisions:output_type -> service.AuthProvisionsResponse
+	81,  // 253: service.RPC.AuthPasswordChange:output_type -> service.AuthPasswordChangeResponse
+	180, // 254: service.RPC.VaultAuth:output_type -> service.VaultAuthResponse
+	176, // 255: service.RPC.VaultSync:output_type -> service.VaultSyncResponse
+	178, // 256: service.RPC.VaultUnsync:output_type -> service.VaultUnsyncResponse
+	182, // 257: service.RPC.VaultStatus:output_type -> service.VaultStatusResponse
+	185, // 258: service.RPC.VaultUpdate:output_type -> service.VaultUpdateResponse
+	161, // 259: service.RPC.Collections:output_type -> service.CollectionsResponse
+	164, // 260: service.RPC.Documents:output_type -> service.DocumentsResponse
+	166, // 261: service.RPC.DocumentDelete:output_type -> service.DocumentDeleteResponse
+	192, // 262: service.RPC.ConfigGet:output_type -> service.ConfigGetResponse
+	194, // 263: service.RPC.ConfigSet:output_type -> service.ConfigSetResponse
+	187, // 264: service.RPC.AdminSignURL:output_type -> service.AdminSignURLResponse
+	189, // 265: service.RPC.AdminCheck:output_type -> service.AdminCheckResponse
+	183, // [183:266] is the sub-list for method output_type
+	100, // [100:183] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -11418,8 +13852,272 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignFileOutput); i {
+		file_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignatureEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignaturePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFileOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedFileInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDetachedInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11430,8 +14128,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyRequest); i {
+		file_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11442,8 +14140,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyResponse); i {
+		file_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRevokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11454,8 +14152,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedRequest); i {
+		file_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementRevokeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11466,8 +14164,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedResponse); i {
+		file_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainPublishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11478,8 +14176,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyInput); i {
+		file_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainPublishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11490,8 +14188,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyOutput); i {
+		file_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11502,8 +14200,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyFileInput); i {
+		file_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11514,8 +14212,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyFileOutput); i {
+		file_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainVerifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11526,8 +14224,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedFileInput); i {
+		file_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigchainVerifyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11538,8 +14236,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyDetachedInput); i {
+		file_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11550,8 +14248,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Statement); i {
+		file_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11562,8 +14260,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigchainRequest); i {
+		file_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11574,8 +14272,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SigchainResponse); i {
+		file_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11586,8 +14284,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRequest); i {
+		file_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11598,8 +14296,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementResponse); i {
+		file_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptFileInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11610,8 +14308,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementCreateRequest); i {
+		file_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptFileOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11622,8 +14320,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementCreateResponse); i {
+		file_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11634,8 +14332,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRevokeRequest); i {
+		file_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11646,8 +14344,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatementRevokeResponse); i {
+		file_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11658,8 +14356,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInput); i {
+		file_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11670,8 +14368,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutput); i {
+		file_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptInspectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11682,8 +14380,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptOptions); i {
+		file_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptInspectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11694,8 +14392,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptRequest); i {
+		file_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFileInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11706,8 +14404,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptResponse); i {
+		file_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptFileOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11718,8 +14416,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptFileInput); i {
+		file_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11730,8 +14428,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptFileOutput); i {
+		file_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecryptOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11742,8 +14440,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptInput); i {
+		file_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11754,8 +14452,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptOutput); i {
+		file_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11766,8 +14464,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptRequest); i {
+		file_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyExpiry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11778,8 +14476,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptResponse); i {
+		file_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthSetupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11790,8 +14488,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptFileInput); i {
+		file_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthSetupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11802,8 +14500,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptFileOutput); i {
+		file_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthVaultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11814,8 +14512,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptInput); i {
+		file_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthVaultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11826,8 +14524,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecryptOutput); i {
+		file_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthUnlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11838,8 +14536,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeStatusRequest); i {
+		file_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthUnlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11850,8 +14548,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RuntimeStatusResponse); i {
+		file_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11862,8 +14560,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthSetupRequest); i {
+		file_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11874,8 +14572,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthSetupResponse); i {
+		file_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthDeprovisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11886,8 +14584,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthVaultRequest); i {
+		file_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthDeprovisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11898,8 +14596,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthVaultResponse); i {
+		file_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthPasswordChangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11910,8 +14608,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthUnlockRequest); i {
+		file_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthPasswordChangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11922,8 +14620,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthUnlockResponse); i {
+		file_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11934,8 +14632,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionRequest); i {
+		file_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11946,8 +14644,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionResponse); i {
+		file_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthProvisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11958,8 +14656,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthDeprovisionRequest); i {
+		file_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11970,8 +14668,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthDeprovisionResponse); i {
+		file_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthLockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11982,8 +14680,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthPasswordChangeRequest); i {
+		file_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11994,8 +14692,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthPasswordChangeResponse); i {
+		file_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthResetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12006,8 +14704,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvision); i {
+		file_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRecoverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12018,8 +14716,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionsRequest); i {
+		file_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRecoverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12030,8 +14728,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthProvisionsResponse); i {
+		file_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyGenerateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12042,8 +14740,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthLockRequest); i {
+		file_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyGenerateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12054,8 +14752,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthLockResponse); i {
+		file_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserServiceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12066,8 +14764,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResetRequest); i {
+		file_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12078,8 +14776,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthResetResponse); i {
+		file_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12090,8 +14788,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthRecoverRequest); i {
+		file_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12102,8 +14800,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthRecoverResponse); i {
+		file_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAddRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12114,8 +14812,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyGenerateRequest); i {
+		file_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12126,8 +14824,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyGenerateResponse); i {
+		file_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12138,8 +14836,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserServiceRequest); i {
+		file_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12150,8 +14848,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserServiceResponse); i {
+		file_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12162,8 +14860,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSignRequest); i {
+		file_rpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12174,8 +14872,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSignResponse); i {
+		file_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12186,8 +14884,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAddRequest); i {
+		file_rpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12198,8 +14896,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAddResponse); i {
+		file_rpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12210,8 +14908,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyExportRequest); i {
+		file_rpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12222,8 +14920,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyExportResponse); i {
+		file_rpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyEndorseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12234,8 +14932,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyImportRequest); i {
+		file_rpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyEndorseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12246,8 +14944,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyImportResponse); i {
+		file_rpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Endorsement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12258,8 +14956,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyRemoveRequest); i {
+		file_rpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12270,8 +14968,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyRemoveResponse); i {
+		file_rpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12282,7 +14980,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Key); i {
 			case 0:
 				return &v.state
@@ -12294,7 +14992,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyRequest); i {
 			case 0:
 				return &v.state
@@ -12306,7 +15004,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeyResponse); i {
 			case 0:
 				return &v.state
@@ -12318,7 +15016,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeysRequest); i {
 			case 0:
 				return &v.state
@@ -12330,7 +15028,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*KeysResponse); i {
 			case 0:
 				return &v.state
@@ -12342,7 +15040,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Secret); i {
 			case 0:
 				return &v.state
@@ -12354,7 +15052,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretRequest); i {
 			case 0:
 				return &v.state
@@ -12366,7 +15064,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretResponse); i {
 			case 0:
 				return &v.state
@@ -12378,7 +15076,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretSaveRequest); i {
 			case 0:
 				return &v.state
@@ -12390,7 +15088,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretSaveResponse); i {
 			case 0:
 				return &v.state
@@ -12402,7 +15100,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretRemoveRequest); i {
 			case 0:
 				return &v.state
@@ -12414,7 +15112,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretRemoveResponse); i {
 			case 0:
 				return &v.state
@@ -12426,7 +15124,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretsRequest); i {
 			case 0:
 				return &v.state
@@ -12438,7 +15136,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SecretsResponse); i {
 			case 0:
 				return &v.state
@@ -12450,8 +15148,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandRequest); i {
+		file_rpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12462,8 +15160,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandResponse); i {
+		file_rpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12474,8 +15172,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandPasswordRequest); i {
+		file_rpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12486,8 +15184,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RandPasswordResponse); i {
+		file_rpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12498,8 +15196,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullRequest); i {
+		file_rpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupSaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12510,8 +15208,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullResponse); i {
+		file_rpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12522,8 +15220,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushRequest); i {
+		file_rpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12534,8 +15232,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushResponse); i {
+		file_rpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12546,8 +15244,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Collection); i {
+		file_rpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12558,8 +15256,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectionsRequest); i {
+		file_rpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12570,8 +15268,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollectionsResponse); i {
+		file_rpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12582,8 +15280,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Document); i {
+		file_rpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12594,8 +15292,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentsRequest); i {
+		file_rpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactSaveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12606,8 +15304,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentsResponse); i {
+		file_rpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactSaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12618,8 +15316,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentDeleteRequest); i {
+		file_rpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12630,8 +15328,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DocumentDeleteResponse); i {
+		file_rpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactRemoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12642,8 +15340,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_rpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12654,8 +15352,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRequest); i {
+		file_rpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12666,8 +15364,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResponse); i {
+		file_rpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12678,8 +15376,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSearchRequest); i {
+		file_rpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustPinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12690,8 +15388,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSearchResponse); i {
+		file_rpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustPinResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12702,8 +15400,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_rpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustUnpinRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12714,8 +15412,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_rpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustUnpinResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12726,8 +15424,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultSyncRequest); i {
+		file_rpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12738,8 +15436,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultSyncResponse); i {
+		file_rpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12750,8 +15448,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUnsyncRequest); i {
+		file_rpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12762,8 +15460,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUnsyncResponse); i {
+		file_rpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12774,8 +15472,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultAuthRequest); i {
+		file_rpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12786,8 +15484,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultAuthResponse); i {
+		file_rpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RandPasswordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12798,8 +15496,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultStatusRequest); i {
+		file_rpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12810,8 +15508,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultStatusResponse); i {
+		file_rpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12822,8 +15520,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUpdateRequest); i {
+		file_rpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12834,8 +15532,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VaultUpdateResponse); i {
+		file_rpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12846,8 +15544,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_rpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12858,8 +15556,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePrepareRequest); i {
+		file_rpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12870,8 +15568,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagePrepareResponse); i {
+		file_rpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12882,8 +15580,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCreateRequest); i {
+		file_rpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Document); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12894,8 +15592,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageCreateResponse); i {
+		file_rpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12906,8 +15604,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagesRequest); i {
+		file_rpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12918,8 +15616,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessagesResponse); i {
+		file_rpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12930,8 +15628,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayRequest); i {
+		file_rpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocumentDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12942,8 +15640,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelayOutput); i {
+		file_rpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12954,8 +15652,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Channel); i {
+		file_rpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12966,8 +15664,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelsRequest); i {
+		file_rpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12978,8 +15676,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelsResponse); i {
+		file_rpc_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12990,8 +15688,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreateRequest); i {
+		file_rpc_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13002,8 +15700,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreateResponse); i {
+		file_rpc_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13014,8 +15712,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaveRequest); i {
+		file_rpc_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13026,8 +15724,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaveResponse); i {
+		file_rpc_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13038,8 +15736,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelReadRequest); i {
+		file_rpc_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13050,8 +15748,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelReadResponse); i {
+		file_rpc_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13062,8 +15760,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInviteRequest); i {
+		file_rpc_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUnsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13074,8 +15772,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelInviteResponse); i {
+		file_rpc_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUnsyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13086,8 +15784,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Follow); i {
+		file_rpc_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultAuthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13098,8 +15796,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowRequest); i {
+		file_rpc_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultAuthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13110,8 +15808,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowResponse); i {
+		file_rpc_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13122,8 +15820,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowsRequest); i {
+		file_rpc_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13134,8 +15832,8 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FollowsResponse); i {
+		file_rpc_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13146,7 +15844,31 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminSignURLRequest); i {
 			case 0:
 				return &v.state
@@ -13158,7 +15880,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminSignURLResponse); i {
 			case 0:
 				return &v.state
@@ -13170,7 +15892,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminCheckRequest); i {
 			case 0:
 				return &v.state
@@ -13182,7 +15904,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminCheckResponse); i {
 			case 0:
 				return &v.state
@@ -13194,7 +15916,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -13206,7 +15928,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigGetRequest); i {
 			case 0:
 				return &v.state
@@ -13218,7 +15940,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigGetResponse); i {
 			case 0:
 				return &v.state
@@ -13230,7 +15952,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigSetRequest); i {
 			case 0:
 				return &v.state
@@ -13242,7 +15964,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigSetResponse); i {
 			case 0:
 				return &v.state
@@ -13254,7 +15976,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WormholeInput); i {
 			case 0:
 				return &v.state
@@ -13266,7 +15988,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WormholeMessage); i {
 			case 0:
 				return &v.state
@@ -13278,7 +16000,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WormholeOutput); i {
 			case 0:
 				return &v.state
@@ -13290,7 +16012,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_App); i {
 			case 0:
 				return &v.state
@@ -13302,7 +16024,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_Encrypt); i {
 			case 0:
 				return &v.state
@@ -13314,7 +16036,7 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_Sign); i {
 			case 0:
 				return &v.state
@@ -13332,8 +16054,8 @@ func file_rpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_proto_rawDesc,
-			NumEnums:      11,
-			NumMessages:   162,
+			NumEnums:      15,
+			NumMessages:   186,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,9 @@ type RPCClient interface {
 	KeyImport(ctx context.Context, in *KeyImportRequest, opts ...grpc.CallOption) (*KeyImportResponse, error)
 	KeyExport(ctx context.Context, in *KeyExportRequest, opts ...grpc.CallOption) (*KeyExportResponse, error)
 	KeyRemove(ctx context.Context, in *KeyRemoveRequest, opts ...grpc.CallOption) (*KeyRemoveResponse, error)
+	KeyUpdate(ctx context.Context, in *KeyUpdateRequest, opts ...grpc.CallOption) (*KeyUpdateResponse, error)
+	KeyRotate(ctx context.Context, in *KeyRotateRequest, opts ...grpc.CallOption) (*KeyRotateResponse, error)
+	KeyEndorse(ctx context.Context, in *KeyEndorseRequest, opts ...grpc.CallOption) (*KeyEndorseResponse, error)
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	SignFile(ctx context.Context, opts ...grpc.CallOption) (RPC_SignFileClient, error)
 	SignStream(ctx context.Context, opts ...grpc.CallOption) (RPC_SignStreamClient, error)
@@ -32,16 +35,22 @@ type RPCClient interface {
 	VerifyDetached(ctx context.Context, in *VerifyDetachedRequest, opts ...grpc.CallOption) (*VerifyDetachedResponse, error)
 	VerifyDetachedFile(ctx context.Context, opts ...grpc.CallOption) (RPC_VerifyDetachedFileClient, error)
 	VerifyDetachedStream(ctx context.Context, opts ...grpc.CallOption) (RPC_VerifyDetachedStreamClient, error)
+	SignBundle(ctx context.Context, in *SignBundleRequest, opts ...grpc.CallOption) (*SignBundleResponse, error)
+	VerifyBundle(ctx context.Context, in *VerifyBundleRequest, opts ...grpc.CallOption) (*VerifyBundleResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	EncryptStream(ctx context.Context, opts ...grpc.CallOption) (RPC_EncryptStreamClient, error)
 	EncryptFile(ctx context.Context, opts ...grpc.CallOption) (RPC_EncryptFileClient, error)
 	Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*DecryptResponse, error)
 	DecryptStream(ctx context.Context, opts ...grpc.CallOption) (RPC_DecryptStreamClient, error)
 	DecryptFile(ctx context.Context, opts ...grpc.CallOption) (RPC_DecryptFileClient, error)
+	DecryptInspect(ctx context.Context, in *DecryptInspectRequest, opts ...grpc.CallOption) (*DecryptInspectResponse, error)
 	Sigchain(ctx context.Context, in *SigchainRequest, opts ...grpc.CallOption) (*SigchainResponse, error)
 	Statement(ctx context.Context, in *StatementRequest, opts ...grpc.CallOption) (*StatementResponse, error)
 	StatementCreate(ctx context.Context, in *StatementCreateRequest, opts ...grpc.CallOption) (*StatementCreateResponse, error)
 	StatementRevoke(ctx context.Context, in *StatementRevokeRequest, opts ...grpc.CallOption) (*StatementRevokeResponse, error)
+	SigchainPublish(ctx context.Context, in *SigchainPublishRequest, opts ...grpc.CallOption) (*SigchainPublishResponse, error)
+	SigchainExport(ctx context.Context, in *SigchainExportRequest, opts ...grpc.CallOption) (*SigchainExportResponse, error)
+	SigchainVerify(ctx context.Context, in *SigchainVerifyRequest, opts ...grpc.CallOption) (*SigchainVerifyResponse, error)
 	User(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	UserSearch(ctx context.Context, in *UserSearchRequest, opts ...grpc.CallOption) (*UserSearchResponse, error)
 	UserService(ctx context.Context, in *UserServiceRequest, opts ...grpc.CallOption) (*UserServiceResponse, error)
@@ -52,6 +61,17 @@ type RPCClient interface {
 	SecretSave(ctx context.Context, in *SecretSaveRequest, opts ...grpc.CallOption) (*SecretSaveResponse, error)
 	SecretRemove(ctx context.Context, in *SecretRemoveRequest, opts ...grpc.CallOption) (*SecretRemoveResponse, error)
 	Secrets(ctx context.Context, in *SecretsRequest, opts ...grpc.CallOption) (*SecretsResponse, error)
+	Group(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error)
+	GroupSave(ctx context.Context, in *GroupSaveRequest, opts ...grpc.CallOption) (*GroupSaveResponse, error)
+	GroupRemove(ctx context.Context, in *GroupRemoveRequest, opts ...grpc.CallOption) (*GroupRemoveResponse, error)
+	Groups(ctx context.Context, in *GroupsRequest, opts ...grpc.CallOption) (*GroupsResponse, error)
+	Contact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*ContactResponse, error)
+	ContactSave(ctx context.Context, in *ContactSaveRequest, opts ...grpc.CallOption) (*ContactSaveResponse, error)
+	ContactRemove(ctx context.Context, in *ContactRemoveRequest, opts ...grpc.CallOption) (*ContactRemoveResponse, error)
+	Contacts(ctx context.Context, in *ContactsRequest, opts ...grpc.CallOption) (*ContactsResponse, error)
+	TrustPin(ctx context.Context, in *TrustPinRequest, opts ...grpc.CallOption) (*TrustPinResponse, error)
+	TrustUnpin(ctx context.Context, in *TrustUnpinRequest, opts ...grpc.CallOption) (*TrustUnpinResponse, error)
+	Pins(ctx context.Context, in *PinsRequest, opts ...grpc.CallOption) (*PinsResponse, error)
 	Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*PullResponse, error)
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*PushResponse, error)
 	Wormhole(ctx context.Context, opts ...grpc.CallOption) (RPC_WormholeClient, error)
@@ -87,21 +107,6 @@ type RPCClient interface {
 	// Admin
 	AdminSignURL(ctx context.Context, in *AdminSignURLRequest, opts ...grpc.CallOption) (*AdminSignURLResponse, error)
 	AdminCheck(ctx context.Context, in *AdminCheckRequest, opts ...grpc.CallOption) (*AdminCheckResponse, error)
-	// Channels
-	Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error)
-	ChannelCreate(ctx context.Context, in *ChannelCreateRequest, opts ...grpc.CallOption) (*ChannelCreateResponse, error)
-	ChannelInvite(ctx context.Context, in *ChannelInviteRequest, opts ...grpc.CallOption) (*ChannelInviteResponse, error)
-	ChannelLeave(ctx context.Context, in *ChannelLeaveRequest, opts ...grpc.CallOption) (*ChannelLeaveResponse, error)
-	ChannelRead(ctx context.Context, in *ChannelReadRequest, opts ...grpc.CallOption) (*ChannelReadResponse, error)
-	// Follow
-	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
-	Follows(ctx context.Context, in *FollowsRequest, opts ...grpc.CallOption) (*FollowsResponse, error)
-	// Messages
-	MessagePrepare(ctx context.Context, in *MessagePrepareRequest, opts ...grpc.CallOption) (*MessagePrepareResponse, error)
-	MessageCreate(ctx context.Context, in *MessageCreateRequest, opts ...grpc.CallOption) (*MessageCreateResponse, error)
-	Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error)
-	// Relay
-	Relay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (RPC_RelayClient, error)
 }
 
 type rPCClient struct {
@@ -166,6 +171,33 @@ func (c *rPCClient) KeyRemove(ctx context.Context, in *KeyRemoveRequest, opts ..
 	return out, nil
 }
 
+func (c *rPCClient) KeyUpdate(ctx context.Context, in *KeyUpdateRequest, opts ...grpc.CallOption) (*KeyUpdateResponse, error) {
+	out := new(KeyUpdateResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/KeyUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) KeyRotate(ctx context.Context, in *KeyRotateRequest, opts ...grpc.CallOption) (*KeyRotateResponse, error) {
+	out := new(KeyRotateResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/KeyRotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) KeyEndorse(ctx context.Context, in *KeyEndorseRequest, opts ...grpc.CallOption) (*KeyEndorseResponse, error) {
+	out := new(KeyEndorseResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/KeyEndorse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
 	out := new(SignResponse)
 	err := c.cc.Invoke(ctx, "/service.RPC/Sign", in, out, opts...)
@@ -385,6 +417,24 @@ func (x *rPCVerifyDetachedStreamClient) CloseAndRecv() (*VerifyDetachedResponse,
 	return m, nil
 }
 
+func (c *rPCClient) SignBundle(ctx context.Context, in *SignBundleRequest, opts ...grpc.CallOption) (*SignBundleResponse, error) {
+	out := new(SignBundleResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/SignBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) VerifyBundle(ctx context.Context, in *VerifyBundleRequest, opts ...grpc.CallOption) (*VerifyBundleResponse, error) {
+	out := new(VerifyBundleResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/VerifyBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCClient) Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error) {
 	out := new(EncryptResponse)
 	err := c.cc.Invoke(ctx, "/service.RPC/Encrypt", in, out, opts...)
@@ -527,6 +577,15 @@ func (x *rPCDecryptFileClient) Recv() (*DecryptFileOutput, error) {
 	return m, nil
 }
 
+func (c *rPCClient) DecryptInspect(ctx context.Context, in *DecryptInspectRequest, opts ...grpc.CallOption) (*DecryptInspectResponse, error) {
+	out := new(DecryptInspectResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/DecryptInspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCClient) Sigchain(ctx context.Context, in *SigchainRequest, opts ...grpc.CallOption) (*SigchainResponse, error) {
 	out := new(SigchainResponse)
 	err := c.cc.Invoke(ctx, "/service.RPC/Sigchain", in, out, opts...)
@@ -563,6 +622,33 @@ func (c *rPCClient) StatementRevoke(ctx context.Context, in *StatementRevokeRequ
 	return out, nil
 }
 
+func (c *rPCClient) SigchainPublish(ctx context.Context, in *SigchainPublishRequest, opts ...grpc.CallOption) (*SigchainPublishResponse, error) {
+	out := new(SigchainPublishResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/SigchainPublish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) SigchainExport(ctx context.Context, in *SigchainExportRequest, opts ...grpc.CallOption) (*SigchainExportResponse, error) {
+	out := new(SigchainExportResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/SigchainExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) SigchainVerify(ctx context.Context, in *SigchainVerifyRequest, opts ...grpc.CallOption) (*SigchainVerifyResponse, error) {
+	out := new(SigchainVerifyResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/SigchainVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCClient) User(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserResponse, error) {
 	out := new(UserResponse)
 	err := c.cc.Invoke(ctx, "/service.RPC/User", in, out, opts...)
@@ -653,6 +739,105 @@ func (c *rPCClient) Secrets(ctx context.Context, in *SecretsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *rPCClient) Group(ctx context.Context, in *GroupRequest, opts ...grpc.CallOption) (*GroupResponse, error) {
+	out := new(GroupResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/Group", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) GroupSave(ctx context.Context, in *GroupSaveRequest, opts ...grpc.CallOption) (*GroupSaveResponse, error) {
+	out := new(GroupSaveResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/GroupSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) GroupRemove(ctx context.Context, in *GroupRemoveRequest, opts ...grpc.CallOption) (*GroupRemoveResponse, error) {
+	out := new(GroupRemoveResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/GroupRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) Groups(ctx context.Context, in *GroupsRequest, opts ...grpc.CallOption) (*GroupsResponse, error) {
+	out := new(GroupsResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/Groups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) Contact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*ContactResponse, error) {
+	out := new(ContactResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/Contact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) ContactSave(ctx context.Context, in *ContactSaveRequest, opts ...grpc.CallOption) (*ContactSaveResponse, error) {
+	out := new(ContactSaveResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/ContactSave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) ContactRemove(ctx context.Context, in *ContactRemoveRequest, opts ...grpc.CallOption) (*ContactRemoveResponse, error) {
+	out := new(ContactRemoveResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/ContactRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) Contacts(ctx context.Context, in *ContactsRequest, opts ...grpc.CallOption) (*ContactsResponse, error) {
+	out := new(ContactsResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/Contacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) TrustPin(ctx context.Context, in *TrustPinRequest, opts ...grpc.CallOption) (*TrustPinResponse, error) {
+	out := new(TrustPinResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/TrustPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) TrustUnpin(ctx context.Context, in *TrustUnpinRequest, opts ...grpc.CallOption) (*TrustUnpinResponse, error) {
+	out := new(TrustUnpinResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/TrustUnpin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCClient) Pins(ctx context.Context, in *PinsRequest, opts ...grpc.CallOption) (*PinsResponse, error) {
+	out := new(PinsResponse)
+	err := c.cc.Invoke(ctx, "/service.RPC/Pins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rPCClient) Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*PullResponse, error) {
 	out := new(PullResponse)
 	err := c.cc.Invoke(ctx, "/service.RPC/Pull", in, out, opts...)
@@ -927,128 +1112,6 @@ func (c *rPCClient) AdminCheck(ctx context.Context, in *AdminCheckRequest, opts
 	return out, nil
 }
 
-func (c *rPCClient) Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error) {
-	out := new(ChannelsResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/Channels", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) ChannelCreate(ctx context.Context, in *ChannelCreateRequest, opts ...grpc.CallOption) (*ChannelCreateResponse, error) {
-	out := new(ChannelCreateResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/ChannelCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) ChannelInvite(ctx context.Context, in *ChannelInviteRequest, opts ...grpc.CallOption) (*ChannelInviteResponse, error) {
-	out := new(ChannelInviteResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/ChannelInvite", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) ChannelLeave(ctx context.Context, in *ChannelLeaveRequest, opts ...grpc.CallOption) (*ChannelLeaveResponse, error) {
-	out := new(ChannelLeaveResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/ChannelLeave", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) ChannelRead(ctx context.Context, in *ChannelReadRequest, opts ...grpc.CallOption) (*ChannelReadResponse, error) {
-	out := new(ChannelReadResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/ChannelRead", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
-	out := new(FollowResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/Follow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) Follows(ctx context.Context, in *FollowsRequest, opts ...grpc.CallOption) (*FollowsResponse, error) {
-	out := new(FollowsResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/Follows", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) MessagePrepare(ctx context.Context, in *MessagePrepareRequest, opts ...grpc.CallOption) (*MessagePrepareResponse, error) {
-	out := new(MessagePrepareResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/MessagePrepare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) MessageCreate(ctx context.Context, in *MessageCreateRequest, opts ...grpc.CallOption) (*MessageCreateResponse, error) {
-	out := new(MessageCreateResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/MessageCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) Messages(ctx context.Context, in *MessagesRequest, opts ...grpc.CallOption) (*MessagesResponse, error) {
-	out := new(MessagesResponse)
-	err := c.cc.Invoke(ctx, "/service.RPC/Messages", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *rPCClient) Relay(ctx context.Context, in *RelayRequest, opts ...grpc.CallOption) (RPC_RelayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RPC_serviceDesc.Streams[11], "/service.RPC/Relay", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &rPCRelayClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type RPC_RelayClient interface {
-	Recv() (*RelayOutput, error)
-	grpc.ClientStream
-}
-
-type rPCRelayClient struct {
-	grpc.ClientStream
-}
-
-func (x *rPCRelayClient) Recv() (*RelayOutput, error) {
-	m := new(RelayOutput)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
 // RPCServer is the server API for RPC service.
 // All implementations must embed UnimplementedRPCServer
 // for forward compatibility
@@ -1059,6 +1122,9 @@ type RPCServer interface {
 	KeyImport(context.Context, *KeyImportRequest) (*KeyImportResponse, error)
 	KeyExport(context.Context, *KeyExportRequest) (*KeyExportResponse, error)
 	KeyRemove(context.Context, *KeyRemoveRequest) (*KeyRemoveResponse, error)
+	KeyUpdate(context.Context, *KeyUpdateRequest) (*KeyUpdateResponse, error)
+	KeyRotate(context.Context, *KeyRotateRequest) (*KeyRotateResponse, error)
+	KeyEndorse(context.Context, *KeyEndorseRequest) (*KeyEndorseResponse, error)
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	SignFile(RPC_SignFileServer) error
 	SignStream(RPC_SignStreamServer) error
@@ -1068,16 +1134,22 @@ type RPCServer interface {
 	VerifyDetached(context.Context, *VerifyDetachedRequest) (*VerifyDetachedResponse, error)
 	VerifyDetachedFile(RPC_VerifyDetachedFileServer) error
 	VerifyDetachedStream(RPC_VerifyDetachedStreamServer) error
+	SignBundle(context.Context, *SignBundleRequest) (*SignBundleResponse, error)
+	VerifyBundle(context.Context, *VerifyBundleRequest) (*VerifyBundleResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	EncryptStream(RPC_EncryptStreamServer) error
 	EncryptFile(RPC_EncryptFileServer) error
 	Decrypt(context.Context, *DecryptRequest) (*DecryptResponse, error)
 	DecryptStream(RPC_DecryptStreamServer) error
 	DecryptFile(RPC_DecryptFileServer) error
+	DecryptInspect(context.Context, *DecryptInspectRequest) (*DecryptInspectResponse, error)
 	Sigchain(context.Context, *SigchainRequest) (*SigchainResponse, error)
 	Statement(context.Context, *StatementRequest) (*StatementResponse, error)
 	StatementCreate(context.Context, *StatementCreateRequest) (*StatementCreateResponse, error)
 	StatementRevoke(context.Context, *StatementRevokeRequest) (*StatementRevokeResponse, error)
+	SigchainPublish(context.Context, *SigchainPublishRequest) (*SigchainPublishResponse, error)
+	SigchainExport(context.Context, *SigchainExportRequest) (*SigchainExportResponse, error)
+	SigchainVerify(context.Context, *SigchainVerifyRequest) (*SigchainVerifyResponse, error)
 	User(context.Context, *UserRequest) (*UserResponse, error)
 	UserSearch(context.Context, *UserSearchRequest) (*UserSearchResponse, error)
 	UserService(context.Context, *UserServiceRequest) (*UserServiceResponse, error)
@@ -1088,6 +1160,17 @@ type RPCServer interface {
 	SecretSave(context.Context, *SecretSaveRequest) (*SecretSaveResponse, error)
 	SecretRemove(context.Context, *SecretRemoveRequest) (*SecretRemoveResponse, error)
 	Secrets(context.Context, *SecretsRequest) (*SecretsResponse, error)
+	Group(context.Context, *GroupRequest) (*GroupResponse, error)
+	GroupSave(context.Context, *GroupSaveRequest) (*GroupSaveResponse, error)
+	GroupRemove(context.Context, *GroupRemoveRequest) (*GroupRemoveResponse, error)
+	Groups(context.Context, *GroupsRequest) (*GroupsResponse, error)
+	Contact(context.Context, *ContactRequest) (*ContactResponse, error)
+	ContactSave(context.Context, *ContactSaveRequest) (*ContactSaveResponse, error)
+	ContactRemove(context.Context, *ContactRemoveRequest) (*ContactRemoveResponse, error)
+	Contacts(context.Context, *ContactsRequest) (*ContactsResponse, error)
+	TrustPin(context.Context, *TrustPinRequest) (*TrustPinResponse, error)
+	TrustUnpin(context.Context, *TrustUnpinRequest) (*TrustUnpinResponse, error)
+	Pins(context.Context, *PinsRequest) (*PinsResponse, error)
 	Pull(context.Context, *PullRequest) (*PullResponse, error)
 	Push(context.Context, *PushRequest) (*PushResponse, error)
 	Wormhole(RPC_WormholeServer) error
@@ -1123,21 +1206,6 @@ type RPCServer interface {
 	// Admin
 	AdminSignURL(context.Context, *AdminSignURLRequest) (*AdminSignURLResponse, error)
 	AdminCheck(context.Context, *AdminCheckRequest) (*AdminCheckResponse, error)
-	// Channels
-	Channels(context.Context, *ChannelsRequest) (*ChannelsResponse, error)
-	ChannelCreate(context.Context, *ChannelCreateRequest) (*ChannelCreateResponse, error)
-	ChannelInvite(context.Context, *ChannelInviteRequest) (*ChannelInviteResponse, error)
-	ChannelLeave(context.Context, *ChannelLeaveRequest) (*ChannelLeaveResponse, error)
-	ChannelRead(context.Context, *ChannelReadRequest) (*ChannelReadResponse, error)
-	// Follow
-	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
-	Follows(context.Context, *FollowsRequest) (*FollowsResponse, error)
-	// Messages
-	MessagePrepare(context.Context, *MessagePrepareRequest) (*MessagePrepareResponse, error)
-	MessageCreate(context.Context, *MessageCreateRequest) (*MessageCreateResponse, error)
-	Messages(context.Context, *MessagesRequest) (*MessagesResponse, error)
-	// Relay
-	Relay(*RelayRequest, RPC_RelayServer) error
 	mustEmbedUnimplementedRPCServer()
 }
 
@@ -1163,6 +1231,15 @@ func (*UnimplementedRPCServer) KeyExport(context.Context, *KeyExportRequest) (*K
 func (*UnimplementedRPCServer) KeyRemove(context.Context, *KeyRemoveRequest) (*KeyRemoveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KeyRemove not implemented")
 }
+func (*UnimplementedRPCServer) KeyUpdate(context.Context, *KeyUpdateRequest) (*KeyUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyUpdate not implemented")
+}
+func (*UnimplementedRPCServer) KeyRotate(context.Context, *KeyRotateRequest) (*KeyRotateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyRotate not implemented")
+}
+func (*UnimplementedRPCServer) KeyEndorse(context.Context, *KeyEndorseRequest) (*KeyEndorseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyEndorse not implemented")
+}
 func (*UnimplementedRPCServer) Sign(context.Context, *SignRequest) (*SignResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
 }
@@ -1190,6 +1267,12 @@ func (*UnimplementedRPCServer) VerifyDetachedFile(RPC_VerifyDetachedFileServer)
 func (*UnimplementedRPCServer) VerifyDetachedStream(RPC_VerifyDetachedStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VerifyDetachedStream not implemented")
 }
+func (*UnimplementedRPCServer) SignBundle(context.Context, *SignBundleRequest) (*SignBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignBundle not implemented")
+}
+func (*UnimplementedRPCServer) VerifyBundle(context.Context, *VerifyBundleRequest) (*VerifyBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBundle not implemented")
+}
 func (*UnimplementedRPCServer) Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
 }
@@ -1208,6 +1291,9 @@ func (*UnimplementedRPCServer) DecryptStream(RPC_DecryptStreamServer) error {
 func (*UnimplementedRPCServer) DecryptFile(RPC_DecryptFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method DecryptFile not implemented")
 }
+func (*UnimplementedRPCServer) DecryptInspect(context.Context, *DecryptInspectRequest) (*DecryptInspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptInspect not implemented")
+}
 func (*UnimplementedRPCServer) Sigchain(context.Context, *SigchainRequest) (*SigchainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sigchain not implemented")
 }
@@ -1220,6 +1306,15 @@ func (*UnimplementedRPCServer) StatementCreate(context.Context, *StatementCreate
 func (*UnimplementedRPCServer) StatementRevoke(context.Context, *StatementRevokeRequest) (*StatementRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatementRevoke not implemented")
 }
+func (*UnimplementedRPCServer) SigchainPublish(context.Context, *SigchainPublishRequest) (*SigchainPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigchainPublish not implemented")
+}
+func (*UnimplementedRPCServer) SigchainExport(context.Context, *SigchainExportRequest) (*SigchainExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigchainExport not implemented")
+}
+func (*UnimplementedRPCServer) SigchainVerify(context.Context, *SigchainVerifyRequest) (*SigchainVerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigchainVerify not implemented")
+}
 func (*UnimplementedRPCServer) User(context.Context, *UserRequest) (*UserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method User not implemented")
 }
@@ -1250,6 +1345,39 @@ func (*UnimplementedRPCServer) SecretRemove(context.Context, *SecretRemoveReques
 func (*UnimplementedRPCServer) Secrets(context.Context, *SecretsRequest) (*SecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Secrets not implemented")
 }
+func (*UnimplementedRPCServer) Group(context.Context, *GroupRequest) (*GroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Group not implemented")
+}
+func (*UnimplementedRPCServer) GroupSave(context.Context, *GroupSaveRequest) (*GroupSaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupSave not implemented")
+}
+func (*UnimplementedRPCServer) GroupRemove(context.Context, *GroupRemoveRequest) (*GroupRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupRemove not implemented")
+}
+func (*UnimplementedRPCServer) Groups(context.Context, *GroupsRequest) (*GroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
+}
+func (*UnimplementedRPCServer) Contact(context.Context, *ContactRequest) (*ContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contact not implemented")
+}
+func (*UnimplementedRPCServer) ContactSave(context.Context, *ContactSaveRequest) (*ContactSaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContactSave not implemented")
+}
+func (*UnimplementedRPCServer) ContactRemove(context.Context, *ContactRemoveRequest) (*ContactRemoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContactRemove not implemented")
+}
+func (*UnimplementedRPCServer) Contacts(context.Context, *ContactsRequest) (*ContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contacts not implemented")
+}
+func (*UnimplementedRPCServer) TrustPin(context.Context, *TrustPinRequest) (*TrustPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustPin not implemented")
+}
+func (*UnimplementedRPCServer) TrustUnpin(context.Context, *TrustUnpinRequest) (*TrustUnpinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustUnpin not implemented")
+}
+func (*UnimplementedRPCServer) Pins(context.Context, *PinsRequest) (*PinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pins not implemented")
+}
 func (*UnimplementedRPCServer) Pull(context.Context, *PullRequest) (*PullResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pull not implemented")
 }
@@ -1334,39 +1462,6 @@ func (*UnimplementedRPCServer) AdminSignURL(context.Context, *AdminSignURLReques
 func (*UnimplementedRPCServer) AdminCheck(context.Context, *AdminCheckRequest) (*AdminCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminCheck not implemented")
 }
-func (*UnimplementedRPCServer) Channels(context.Context, *ChannelsRequest) (*ChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Channels not implemented")
-}
-func (*UnimplementedRPCServer) ChannelCreate(context.Context, *ChannelCreateRequest) (*ChannelCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChannelCreate not implemented")
-}
-func (*UnimplementedRPCServer) ChannelInvite(context.Context, *ChannelInviteRequest) (*ChannelInviteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChannelInvite not implemented")
-}
-func (*UnimplementedRPCServer) ChannelLeave(context.Context, *ChannelLeaveRequest) (*ChannelLeaveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChannelLeave not implemented")
-}
-func (*UnimplementedRPCServer) ChannelRead(context.Context, *ChannelReadRequest) (*ChannelReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChannelRead not implemented")
-}
-func (*UnimplementedRPCServer) Follow(context.Context, *FollowRequest) (*FollowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
-}
-func (*UnimplementedRPCServer) Follows(context.Context, *FollowsRequest) (*FollowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Follows not implemented")
-}
-func (*UnimplementedRPCServer) MessagePrepare(context.Context, *MessagePrepareRequest) (*MessagePrepareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessagePrepare not implemented")
-}
-func (*UnimplementedRPCServer) MessageCreate(context.Context, *MessageCreateRequest) (*MessageCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MessageCreate not implemented")
-}
-func (*UnimplementedRPCServer) Messages(context.Context, *MessagesRequest) (*MessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Messages not implemented")
-}
-func (*UnimplementedRPCServer) Relay(*RelayRequest, RPC_RelayServer) error {
-	return status.Errorf(codes.Unimplemented, "method Relay not implemented")
-}
 func (*UnimplementedRPCServer) mustEmbedUnimplementedRPCServer() {}
 
 func RegisterRPCServer(s *grpc.Server, srv RPCServer) {
@@ -1481,32 +1576,86 @@ func _RPC_KeyRemove_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPC_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignRequest)
+func _RPC_KeyUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RPCServer).Sign(ctx, in)
+		return srv.(RPCServer).KeyUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/service.RPC/Sign",
+		FullMethod: "/service.RPC/KeyUpdate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).Sign(ctx, req.(*SignRequest))
+		return srv.(RPCServer).KeyUpdate(ctx, req.(*KeyUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPC_SignFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RPCServer).SignFile(&rPCSignFileServer{stream})
+func _RPC_KeyRotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyRotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).KeyRotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/KeyRotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).KeyRotate(ctx, req.(*KeyRotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type RPC_SignFileServer interface {
-	Send(*SignFileOutput) error
-	Recv() (*SignFileInput, error)
-	grpc.ServerStream
+func _RPC_KeyEndorse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyEndorseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).KeyEndorse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/KeyEndorse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).KeyEndorse(ctx, req.(*KeyEndorseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_SignFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RPCServer).SignFile(&rPCSignFileServer{stream})
+}
+
+type RPC_SignFileServer interface {
+	Send(*SignFileOutput) error
+	Recv() (*SignFileInput, error)
+	grpc.ServerStream
 }
 
 type rPCSignFileServer struct {
@@ -1691,6 +1840,42 @@ func (x *rPCVerifyDetachedStreamServer) Recv() (*VerifyDetachedInput, error) {
 	return m, nil
 }
 
+func _RPC_SignBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).SignBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/SignBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).SignBundle(ctx, req.(*SignBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_VerifyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).VerifyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/VerifyBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).VerifyBundle(ctx, req.(*VerifyBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RPC_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EncryptRequest)
 	if err := dec(in); err != nil {
@@ -1831,6 +2016,24 @@ func (x *rPCDecryptFileServer) Recv() (*DecryptFileInput, error) {
 	return m, nil
 }
 
+func _RPC_DecryptInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptInspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).DecryptInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/DecryptInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).DecryptInspect(ctx, req.(*DecryptInspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RPC_Sigchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SigchainRequest)
 	if err := dec(in); err != nil {
@@ -1903,6 +2106,60 @@ func _RPC_StatementRevoke_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPC_SigchainPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigchainPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).SigchainPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/SigchainPublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).SigchainPublish(ctx, req.(*SigchainPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_SigchainExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigchainExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).SigchainExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/SigchainExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).SigchainExport(ctx, req.(*SigchainExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_SigchainVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigchainVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).SigchainVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/SigchainVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).SigchainVerify(ctx, req.(*SigchainVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RPC_User_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserRequest)
 	if err := dec(in); err != nil {
@@ -2083,6 +2340,204 @@ func _RPC_Secrets_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPC_Group_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Group(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Group(ctx, req.(*GroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_GroupSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).GroupSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/GroupSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).GroupSave(ctx, req.(*GroupSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_GroupRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).GroupRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/GroupRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).GroupRemove(ctx, req.(*GroupRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Groups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Groups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Groups(ctx, req.(*GroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_Contact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Contact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Contact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Contact(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_ContactSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactSaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).ContactSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/ContactSave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).ContactSave(ctx, req.(*ContactSaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_ContactRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).ContactRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/ContactRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).ContactRemove(ctx, req.(*ContactRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_Contacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Contacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Contacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Contacts(ctx, req.(*ContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_TrustPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).TrustPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/TrustPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).TrustPin(ctx, req.(*TrustPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_TrustUnpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustUnpinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).TrustUnpin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/TrustUnpin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).TrustUnpin(ctx, req.(*TrustUnpinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPC_Pins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCServer).Pins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/service.RPC/Pins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCServer).Pins(ctx, req.(*PinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RPC_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PullRequest)
 	if err := dec(in); err != nil {
@@ -2595,207 +3050,6 @@ func _RPC_AdminCheck_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RPC_Channels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).Channels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/Channels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).Channels(ctx, req.(*ChannelsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_ChannelCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).ChannelCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/ChannelCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).ChannelCreate(ctx, req.(*ChannelCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_ChannelInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelInviteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).ChannelInvite(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/ChannelInvite",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).ChannelInvite(ctx, req.(*ChannelInviteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_ChannelLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelLeaveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).ChannelLeave(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/ChannelLeave",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).ChannelLeave(ctx, req.(*ChannelLeaveRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_ChannelRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChannelReadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).ChannelRead(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/ChannelRead",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).ChannelRead(ctx, req.(*ChannelReadRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).Follow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/Follow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).Follow(ctx, req.(*FollowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_Follows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).Follows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/Follows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).Follows(ctx, req.(*FollowsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_MessagePrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessagePrepareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).MessagePrepare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/MessagePrepare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).MessagePrepare(ctx, req.(*MessagePrepareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_MessageCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessageCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).MessageCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/MessageCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).MessageCreate(ctx, req.(*MessageCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_Messages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessagesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RPCServer).Messages(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/service.RPC/Messages",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RPCServer).Messages(ctx, req.(*MessagesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RPC_Relay_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RelayRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(RPCServer).Relay(m, &rPCRelayServer{stream})
-}
-
-type RPC_RelayServer interface {
-	Send(*RelayOutput) error
-	grpc.ServerStream
-}
-
-type rPCRelayServer struct {
-	grpc.ServerStream
-}
-
-func (x *rPCRelayServer) Send(m *RelayOutput) error {
-	return x.ServerStream.SendMsg(m)
-}
-
 var _RPC_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "service.RPC",
 	HandlerType: (*RPCServer)(nil),
@@ -2824,6 +3078,18 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KeyRemove",
 			Handler:    _RPC_KeyRemove_Handler,
 		},
+		{
+			MethodName: "KeyUpdate",
+			Handler:    _RPC_KeyUpdate_Handler,
+		},
+		{
+			MethodName: "KeyRotate",
+			Handler:    _RPC_KeyRotate_Handler,
+		},
+		{
+			MethodName: "KeyEndorse",
+			Handler:    _RPC_KeyEndorse_Handler,
+		},
 		{
 			MethodName: "Sign",
 			Handler:    _RPC_Sign_Handler,
@@ -2836,6 +3102,14 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyDetached",
 			Handler:    _RPC_VerifyDetached_Handler,
 		},
+		{
+			MethodName: "SignBundle",
+			Handler:    _RPC_SignBundle_Handler,
+		},
+		{
+			MethodName: "VerifyBundle",
+			Handler:    _RPC_VerifyBundle_Handler,
+		},
 		{
 			MethodName: "Encrypt",
 			Handler:    _RPC_Encrypt_Handler,
@@ -2844,6 +3118,10 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Decrypt",
 			Handler:    _RPC_Decrypt_Handler,
 		},
+		{
+			MethodName: "DecryptInspect",
+			Handler:    _RPC_DecryptInspect_Handler,
+		},
 		{
 			MethodName: "Sigchain",
 			Handler:    _RPC_Sigchain_Handler,
@@ -2860,6 +3138,18 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StatementRevoke",
 			Handler:    _RPC_StatementRevoke_Handler,
 		},
+		{
+			MethodName: "SigchainPublish",
+			Handler:    _RPC_SigchainPublish_Handler,
+		},
+		{
+			MethodName: "SigchainExport",
+			Handler:    _RPC_SigchainExport_Handler,
+		},
+		{
+			MethodName: "SigchainVerify",
+			Handler:    _RPC_SigchainVerify_Handler,
+		},
 		{
 			MethodName: "User",
 			Handler:    _RPC_User_Handler,
@@ -2900,6 +3190,50 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Secrets",
 			Handler:    _RPC_Secrets_Handler,
 		},
+		{
+			MethodName: "Group",
+			Handler:    _RPC_Group_Handler,
+		},
+		{
+			MethodName: "GroupSave",
+			Handler:    _RPC_GroupSave_Handler,
+		},
+		{
+			MethodName: "GroupRemove",
+			Handler:    _RPC_GroupRemove_Handler,
+		},
+		{
+			MethodName: "Groups",
+			Handler:    _RPC_Groups_Handler,
+		},
+		{
+			MethodName: "Contact",
+			Handler:    _RPC_Contact_Handler,
+		},
+		{
+			MethodName: "ContactSave",
+			Handler:    _RPC_ContactSave_Handler,
+		},
+		{
+			MethodName: "ContactRemove",
+			Handler:    _RPC_ContactRemove_Handler,
+		},
+		{
+			MethodName: "Contacts",
+			Handler:    _RPC_Contacts_Handler,
+		},
+		{
+			MethodName: "TrustPin",
+			Handler:    _RPC_TrustPin_Handler,
+		},
+		{
+			MethodName: "TrustUnpin",
+			Handler:    _RPC_TrustUnpin_Handler,
+		},
+		{
+			MethodName: "Pins",
+			Handler:    _RPC_Pins_Handler,
+		},
 		{
 			MethodName: "Pull",
 			Handler:    _RPC_Pull_Handler,
@@ -3008,46 +3342,6 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AdminCheck",
 			Handler:    _RPC_AdminCheck_Handler,
 		},
-		{
-			MethodName: "Channels",
-			Handler:    _RPC_Channels_Handler,
-		},
-		{
-			MethodName: "ChannelCreate",
-			Handler:    _RPC_ChannelCreate_Handler,
-		},
-		{
-			MethodName: "ChannelInvite",
-			Handler:    _RPC_ChannelInvite_Handler,
-		},
-		{
-			MethodName: "ChannelLeave",
-			Handler:    _RPC_ChannelLeave_Handler,
-		},
-		{
-			MethodName: "ChannelRead",
-			Handler:    _RPC_ChannelRead_Handler,
-		},
-		{
-			MethodName: "Follow",
-			Handler:    _RPC_Follow_Handler,
-		},
-		{
-			MethodName: "Follows",
-			Handler:    _RPC_Follows_Handler,
-		},
-		{
-			MethodName: "MessagePrepare",
-			Handler:    _RPC_MessagePrepare_Handler,
-		},
-		{
-			MethodName: "MessageCreate",
-			Handler:    _RPC_MessageCreate_Handler,
-		},
-		{
-			MethodName: "Messages",
-			Handler:    _RPC_Messages_Handler,
-		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3114,11 +3408,6 @@ var _RPC_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
-		{
-			StreamName:    "Relay",
-			Handler:       _RPC_Relay_Handler,
-			ServerStreams: true,
-		},
 	},
 	Metadata: "rpc.proto",
 }
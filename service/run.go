@@ -208,6 +208,21 @@ func NewServiceFn(env *Env, build Build, cert *keys.CertificateKey, lgi LogInter
 		auth.fas = fido2Plugin
 	}
 
+	// SSH agent
+	sshAgentCloseFn := func() {}
+	if env.SSHAgentEnabled() {
+		path, err := env.SSHAgentSocket()
+		if err != nil {
+			return nil, nil, err
+		}
+		logger.Infof("Listening for ssh-agent connections at %s", path)
+		closeFn, err := serveSSHAgent(path, newSSHAgent(service))
+		if err != nil {
+			return nil, nil, err
+		}
+		sshAgentCloseFn = closeFn
+	}
+
 	logger.Infof("Listening for connections on port %d", env.Port())
 	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", env.Port()))
 	if err != nil {
@@ -221,6 +236,7 @@ func NewServiceFn(env *Env, build Build, cert *keys.CertificateKey, lgi LogInter
 		return grpcServer.Serve(lis)
 	}
 	closeFn := func() {
+		sshAgentCloseFn()
 		grpcServer.Stop()
 		service.Close()
 	}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"net"
 	"sync"
 	"time"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys-ext/http/client"
 	"github.com/keys-pub/keys-ext/sdb"
 	"github.com/keys-pub/keys-ext/vault"
@@ -27,6 +29,9 @@ type service struct {
 	clock  tsutil.Clock
 	vault  *vault.Vault
 
+	// resolver for DNS TXT (domain user) proofs.
+	resolver api.TXTResolver
+
 	unlocked  bool
 	unlockMtx sync.Mutex
 
@@ -67,6 +72,7 @@ func newService(env *Env, build Build, auth *auth, hclient khttp.Client, clock t
 		client:        client,
 		vault:         vlt,
 		clock:         clock,
+		resolver:      net.DefaultResolver,
 		checkCancelFn: func() {},
 	}, nil
 }
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/keys-pub/keys"
+	httpapi "github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys-ext/http/server"
 	"github.com/keys-pub/keys/api"
 	"github.com/keys-pub/keys/dstore"
@@ -60,10 +61,13 @@ func testFire(t *testing.T, clock tsutil.Clock) server.Fire {
 }
 
 type testEnv struct {
-	clock  tsutil.Clock
-	fi     server.Fire
-	client http.Client
-	users  *users.Users
+	clock    tsutil.Clock
+	fi       server.Fire
+	client   http.Client
+	users    *users.Users
+	resolver httpapi.TXTRecords
+	// admins for the key server.
+	admins []keys.ID
 }
 
 func newTestEnv(t *testing.T) *testEnv {
@@ -72,10 +76,11 @@ func newTestEnv(t *testing.T) *testEnv {
 	client := http.NewClient()
 	usrs := users.New(fi, keys.NewSigchains(fi), users.Client(client), users.Clock(clock))
 	return &testEnv{
-		clock:  clock,
-		fi:     fi,
-		client: client,
-		users:  usrs,
+		clock:    clock,
+		fi:       fi,
+		client:   client,
+		users:    usrs,
+		resolver: httpapi.TXTRecords{},
 	}
 }
 
@@ -88,6 +93,7 @@ func newTestService(t *testing.T, tenv *testEnv) (*service, CloseFn) {
 
 	svc, err := newService(env, Build{Version: "1.2.3", Commit: "deadbeef"}, auth, tenv.client, tenv.clock)
 	require.NoError(t, err)
+	svc.resolver = tenv.resolver
 
 	err = svc.Open()
 	require.NoError(t, err)
@@ -275,6 +281,8 @@ func newTestServerEnv(t *testing.T, env *testEnv) *serverEnv {
 	tasks := server.NewTestTasks(srv)
 	srv.SetTasks(tasks)
 	srv.SetInternalAuth("testtoken")
+	srv.SetTXTResolver(env.resolver)
+	srv.SetAdmins(env.admins)
 	_ = srv.SetInternalKey("6a169a699f7683c04d127504a12ace3b326e8b56a61a9b315cf6b42e20d6a44a")
 	handler := server.NewHandler(srv)
 	testServer := httptest.NewServer(handler)
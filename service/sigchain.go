@@ -25,7 +25,10 @@ func (s *service) Sigchain(ctx context.Context, req *SigchainRequest) (*Sigchain
 		return nil, err
 	}
 
-	stsOut := statementsToRPC(sc.Statements())
+	stsOut, err := s.statementsToRPCWithStatus(ctx, kid, sc.Statements())
+	if err != nil {
+		return nil, err
+	}
 
 	return &SigchainResponse{
 		Key:        key,
@@ -112,7 +115,10 @@ func (s *service) Statement(ctx context.Context, req *StatementRequest) (*Statem
 	if sc == nil {
 		return nil, keys.NewErrNotFound(kid.String())
 	}
-	stsOut := statementsToRPC(sc.Statements())
+	stsOut, err := s.statementsToRPCWithStatus(ctx, kid, sc.Statements())
+	if err != nil {
+		return nil, err
+	}
 
 	if req.Seq < 0 {
 		return nil, errors.Errorf("invalid seq")
@@ -149,7 +155,21 @@ func (s *service) StatementCreate(ctx context.Context, req *StatementCreateReque
 	if err != nil {
 		return nil, err
 	}
-	st, err := keys.NewSigchainStatement(sc, req.Data, key, "", s.clock.Now())
+	if req.Type != "" {
+		if reservedStatementTypes[req.Type] {
+			return nil, errors.Errorf("statement type %s is reserved", req.Type)
+		}
+		data, err := decodeStatementData(key.ID(), req.Type, req.Data)
+		if err != nil {
+			return nil, err
+		}
+		if dns, ok := data.(*dnsStatement); ok {
+			if err := dns.verifyTXT(ctx, s.resolver, key.ID()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	st, err := keys.NewSigchainStatement(sc, req.Data, key, req.Type, s.clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +188,12 @@ func (s *service) StatementCreate(ctx context.Context, req *StatementCreateReque
 	}
 
 	stOut := statementToRPC(st)
+	if req.Local {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, err
+		}
+		stOut.Status = StatementPending
+	}
 
 	return &StatementCreateResponse{
 		Statement: stOut,
@@ -205,12 +231,18 @@ func (s *service) StatementRevoke(ctx context.Context, req *StatementRevokeReque
 		return nil, err
 	}
 
+	stOut := statementToRPC(st)
+	if req.Local {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, err
+		}
+		stOut.Status = StatementPending
+	}
+
 	if _, err = s.users.Update(ctx, key.ID()); err != nil {
 		return nil, err
 	}
 
-	stOut := statementToRPC(st)
-
 	return &StatementRevokeResponse{
 		Statement: stOut,
 	}, nil
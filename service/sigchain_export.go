@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/keys-pub/keys/user"
+	"github.com/pkg/errors"
+)
+
+// sigchainBundle is a self-contained export of a sigchain, which can be
+// verified offline.
+type sigchainBundle struct {
+	KID        keys.ID           `json:"kid"`
+	Statements []*keys.Statement `json:"statements"`
+	// Metadata from the key server, by statement URL. CreatedAt is the same as
+	// the CreatedAt header for the statement.
+	Metadata map[string]api.Metadata `json:"md,omitempty"`
+	// Users with the signed message found at the user URL.
+	Users []*user.Result `json:"users,omitempty"`
+}
+
+func decodeSigchainBundle(b []byte) (*sigchainBundle, error) {
+	var bundle sigchainBundle
+	if err := json.Unmarshal(b, &bundle); err != nil {
+		return nil, errors.Wrapf(err, "invalid sigchain bundle")
+	}
+	if bundle.KID == "" {
+		return nil, errors.Errorf("invalid sigchain bundle, no kid")
+	}
+	return &bundle, nil
+}
+
+// encode bundle as JSON.
+// Statements are verified from their exact bytes, so we can't indent.
+func (b *sigchainBundle) encode() ([]byte, error) {
+	return json.Marshal(b)
+}
+
+// SigchainExport (RPC) exports a sigchain (from the key server) as a bundle.
+func (s *service) SigchainExport(ctx context.Context, req *SigchainExportRequest) (*SigchainExportResponse, error) {
+	kid, err := s.parseKID(req.KID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Sigchain(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.Errorf("sigchain not found on key server")
+	}
+
+	bundle := &sigchainBundle{
+		KID:        kid,
+		Statements: resp.Statements,
+		Metadata:   resp.Metadata,
+	}
+
+	res, err := s.users.Get(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+	if res != nil && res.Statement != "" {
+		bundle.Users = append(bundle.Users, res)
+	}
+
+	b, err := bundle.encode()
+	if err != nil {
+		return nil, err
+	}
+	return &SigchainExportResponse{
+		Bundle: b,
+	}, nil
+}
+
+// SigchainVerify (RPC) verifies a sigchain bundle, without the key server.
+func (s *service) SigchainVerify(ctx context.Context, req *SigchainVerifyRequest) (*SigchainVerifyResponse, error) {
+	bundle, err := decodeSigchainBundle(req.Bundle)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := verifySigchainBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	sts := make([]*Statement, 0, sc.Length())
+	for _, st := range sc.Statements() {
+		out := statementToRPC(st)
+		out.CreatedAt = tsutil.Millis(bundle.Metadata[st.URL()].CreatedAt)
+		sts = append(sts, out)
+	}
+	users := make([]*User, 0, len(bundle.Users))
+	for _, res := range bundle.Users {
+		users = append(users, userResultToRPC(res))
+	}
+	return &SigchainVerifyResponse{
+		KID:        sc.KID().String(),
+		Statements: sts,
+		Users:      users,
+	}, nil
+}
+
+// verifySigchainBundle checks statement signatures and seq (prev) links, and
+// the signed messages for users.
+func verifySigchainBundle(bundle *sigchainBundle) (*keys.Sigchain, error) {
+	sc := keys.NewSigchain(bundle.KID)
+	for _, st := range bundle.Statements {
+		if err := sc.Add(st); err != nil {
+			return nil, errors.Wrapf(err, "invalid statement %d", st.Seq)
+		}
+	}
+	for url := range bundle.Metadata {
+		if !sigchainHasStatement(sc, url) {
+			return nil, errors.Errorf("metadata for unknown statement %s", url)
+		}
+	}
+	for _, res := range bundle.Users {
+		if err := verifyUserProof(sc, res); err != nil {
+			return nil, err
+		}
+	}
+	return sc, nil
+}
+
+func sigchainHasStatement(sc *keys.Sigchain, url string) bool {
+	for _, st := range sc.Statements() {
+		if st.URL() == url {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyUserProof checks the user is in the sigchain (and not revoked), and
+// the signed message (from the user URL) is valid.
+func verifyUserProof(sc *keys.Sigchain, res *user.Result) error {
+	if res.User == nil {
+		return errors.Errorf("invalid user proof, no user")
+	}
+	usr := res.User
+	if usr.KID != sc.KID() {
+		return errors.Errorf("invalid user proof %s, kid mismatch", usr.ID())
+	}
+	if usr.Seq < 1 || usr.Seq > sc.Length() {
+		return errors.Errorf("invalid user proof %s, statement %d not found", usr.ID(), usr.Seq)
+	}
+	st := sc.Statements()[usr.Seq-1]
+	if st.Type != "user" {
+		return errors.Errorf("invalid user proof %s, statement %d isn't a user statement", usr.ID(), usr.Seq)
+	}
+	if sc.IsRevoked(st.Seq) {
+		return errors.Errorf("invalid user proof %s, statement %d was revoked", usr.ID(), usr.Seq)
+	}
+	var scu user.User
+	if err := json.Unmarshal(st.Data, &scu); err != nil {
+		return errors.Wrapf(err, "invalid user proof %s", usr.ID())
+	}
+	if scu.KID != usr.KID || scu.Seq != usr.Seq || scu.Service != usr.Service || scu.Name != usr.Name || scu.URL != usr.URL {
+		return errors.Errorf("invalid user proof %s, user mismatch with statement %d", usr.ID(), usr.Seq)
+	}
+	if err := usr.Verify(res.Statement); err != nil {
+		return errors.Wrapf(err, "invalid user proof %s", usr.ID())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigchainExportVerify(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testUserSetupGithub(t, env, service, alice, "alice")
+	ctx := context.TODO()
+
+	_, err := service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Data: []byte("test"),
+	})
+	require.NoError(t, err)
+
+	exportResp, err := service.SigchainExport(ctx, &SigchainExportRequest{
+		KID: alice.ID().String(),
+	})
+	require.NoError(t, err)
+
+	// Verify (without key or key server)
+	service2, closeFn2 := newTestService(t, env)
+	defer closeFn2()
+	verifyResp, err := service2.SigchainVerify(ctx, &SigchainVerifyRequest{
+		Bundle: exportResp.Bundle,
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.KID)
+	require.Equal(t, 2, len(verifyResp.Statements))
+	require.Equal(t, "user", verifyResp.Statements[0].Type)
+	require.NotEqual(t, int64(0), verifyResp.Statements[0].CreatedAt)
+	require.Equal(t, []byte("test"), verifyResp.Statements[1].Data)
+	require.Equal(t, 1, len(verifyResp.Users))
+	require.Equal(t, "alice@github", verifyResp.Users[0].ID)
+
+	// Invalid statement
+	b := bytes.Replace(exportResp.Bundle, []byte(`"data":"dGVzdA=="`), []byte(`"data":"dGVzdDI="`), 1)
+	_, err = service2.SigchainVerify(ctx, &SigchainVerifyRequest{Bundle: b})
+	require.EqualError(t, err, "invalid sigchain bundle: verify failed")
+
+	// Missing statement
+	bundle, err := decodeSigchainBundle(exportResp.Bundle)
+	require.NoError(t, err)
+	bundle.Statements = bundle.Statements[1:]
+	b, err = bundle.encode()
+	require.NoError(t, err)
+	_, err = service2.SigchainVerify(ctx, &SigchainVerifyRequest{Bundle: b})
+	require.EqualError(t, err, "invalid statement 2: invalid statement sequence expected 1, got 2")
+
+	// Invalid user proof
+	bundle, err = decodeSigchainBundle(exportResp.Bundle)
+	require.NoError(t, err)
+	bundle.Users[0].User.Name = "bob"
+	b, err = bundle.encode()
+	require.NoError(t, err)
+	_, err = service2.SigchainVerify(ctx, &SigchainVerifyRequest{Bundle: b})
+	require.EqualError(t, err, "invalid user proof bob@github, user mismatch with statement 1")
+
+	bundle, err = decodeSigchainBundle(exportResp.Bundle)
+	require.NoError(t, err)
+	bundle.Users[0].Statement = ""
+	b, err = bundle.encode()
+	require.NoError(t, err)
+	_, err = service2.SigchainVerify(ctx, &SigchainVerifyRequest{Bundle: b})
+	require.Error(t, err)
+}
+
+func TestSigchainExportNotFound(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	_, err := service.SigchainExport(context.TODO(), &SigchainExportRequest{
+		KID: alice.ID().String(),
+	})
+	require.EqualError(t, err, "sigchain not found on key server")
+}
@@ -1,11 +1,14 @@
 package service
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"io"
+	"os"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
 	"github.com/keys-pub/keys/saltpack"
 	"github.com/pkg/errors"
 )
@@ -24,7 +27,26 @@ func (s *service) Sign(ctx context.Context, req *SignRequest) (*SignResponse, er
 		return nil, err
 	}
 
-	signed, err := saltpack.Sign(req.Data, req.Armored, key)
+	envelope := req.Envelope || req.Context != "" || req.ContentType != ""
+	if envelope && req.Format != SaltpackSignature {
+		return nil, errors.Errorf("envelope not supported for %s signatures", req.Format)
+	}
+
+	var signed []byte
+	switch req.Format {
+	case SaltpackSignature:
+		if envelope {
+			signed, err = signEnvelope(req.Data, req.Armored, req.Detached, req.Context, req.ContentType, key, s.clock.Now())
+		} else {
+			signed, err = saltpack.Sign(req.Data, req.Armored, key)
+		}
+	case OpenPGPSignature:
+		signed, err = s.openpgpSign(req.Data, req.Armored, req.Detached, key)
+	case SSHSignature:
+		signed, err = sshsigSign(req.Data, req.Detached, req.Namespace, key)
+	default:
+		return nil, errors.Errorf("unsupported signature format %s", req.Format)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +58,7 @@ func (s *service) Sign(ctx context.Context, req *SignRequest) (*SignResponse, er
 }
 
 // SignFile (RPC) ...
+// Signature envelopes are only supported by Sign.
 func (s *service) SignFile(srv RPC_SignFileServer) error {
 	req, err := srv.Recv()
 	if err != nil {
@@ -63,8 +86,17 @@ func (s *service) SignFile(srv RPC_SignFileServer) error {
 		return err
 	}
 
-	if err := saltpack.SignFile(in, out, key, req.Armored, req.Detached); err != nil {
-		return err
+	if req.Format == SaltpackSignature {
+		if err := saltpack.SignFile(in, out, key, req.Armored, req.Detached); err != nil {
+			return err
+		}
+	} else {
+		newStream := func(w io.Writer) (io.WriteCloser, error) {
+			return s.newSignStream(w, req.Armored, req.Detached, req.Format, req.Namespace, key)
+		}
+		if err := signFileWithStream(in, out, newStream); err != nil {
+			return err
+		}
 	}
 
 	if err := srv.Send(&SignFileOutput{
@@ -78,6 +110,7 @@ func (s *service) SignFile(srv RPC_SignFileServer) error {
 }
 
 // SignStream (RPC) ...
+// Signature envelopes are only supported by Sign.
 func (s *service) SignStream(srv RPC_SignStreamServer) error {
 	init := false
 
@@ -114,17 +147,17 @@ func (s *service) SignStream(srv RPC_SignStreamServer) error {
 			if err != nil {
 				return err
 			}
-			logger.Debugf("Sign armored=%t detached=%t", req.Armored, req.Detached)
-			s, err := saltpack.NewSignStream(&buf, req.Armored, req.Detached, key)
+			logger.Debugf("Sign armored=%t detached=%t format=%s", req.Armored, req.Detached, req.Format)
+			st, err := s.newSignStream(&buf, req.Armored, req.Detached, req.Format, req.Namespace, key)
 			if err != nil {
 				return err
 			}
-			stream = s
+			stream = st
 			kid = key.ID()
 
 		} else {
 			// Make sure request only sends data after init
-			if req.Signer != "" || req.Armored || req.Detached {
+			if req.Signer != "" || req.Armored || req.Detached || req.Format != SaltpackSignature || req.Namespace != "" {
 				return errors.Errorf("after stream is initalized, only data should be sent")
 			}
 		}
@@ -166,3 +199,82 @@ func (s *service) SignStream(srv RPC_SignStreamServer) error {
 	}
 	return nil
 }
+
+func (s *service) newSignStream(w io.Writer, armored bool, detached bool, format SignatureFormat, namespace string, key *keys.EdX25519Key) (io.WriteCloser, error) {
+	switch format {
+	case SaltpackSignature:
+		return saltpack.NewSignStream(w, armored, detached, key)
+	case OpenPGPSignature:
+		entity, err := s.openpgpEntity(key)
+		if err != nil {
+			return nil, err
+		}
+		return newOpenPGPSignStream(w, armored, detached, entity, s.clock.Now())
+	case SSHSignature:
+		if !detached {
+			return nil, errors.Errorf("sshsig signatures are detached")
+		}
+		return keyring.NewSSHSignStream(w, key, sshsigNamespace(namespace))
+	default:
+		return nil, errors.Errorf("unsupported signature format %s", format)
+	}
+}
+
+// sshsigNamespace returns the SSHSIG namespace, defaults to "file" (like
+// ssh-keygen).
+func sshsigNamespace(namespace string) string {
+	if namespace == "" {
+		return "file"
+	}
+	return namespace
+}
+
+// sshsigSign signs data with SSHSIG (ssh-keygen -Y sign).
+func sshsigSign(b []byte, detached bool, namespace string, key *keys.EdX25519Key) ([]byte, error) {
+	if !detached {
+		return nil, errors.Errorf("sshsig signatures are detached")
+	}
+	out, err := keyring.SSHSign(key, sshsigNamespace(namespace), bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
+// signFileWithStream signs file in, writing to out.
+func signFileWithStream(in string, out string, newStream func(w io.Writer) (io.WriteCloser, error)) error {
+	inFile, err := os.Open(in) // #nosec
+	if err != nil {
+		return err
+	}
+	defer inFile.Close()
+
+	outTmp := out + ".tmp"
+	outFile, err := os.Create(outTmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = outFile.Close()
+		_ = os.Remove(outTmp)
+	}()
+
+	writer := bufio.NewWriter(outFile)
+	stream, err := newStream(writer)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(stream, bufio.NewReader(inFile)); err != nil {
+		return err
+	}
+	if err := stream.Close(); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if err := outFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(outTmp, out)
+}
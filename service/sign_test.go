@@ -225,7 +225,7 @@ func TestSignVerifyAttachedFile(t *testing.T) {
 	aliceClient, aliceClientCloseFn := newTestRPCClient(t, aliceService, env, "", &aliceOut)
 	defer aliceClientCloseFn()
 
-	err := signFile(aliceClient, alice.ID().String(), true, false, inPath, outPath)
+	err := signFile(aliceClient, alice.ID().String(), true, false, SaltpackSignature, "", inPath, outPath)
 	require.NoError(t, err)
 	require.Equal(t, fmt.Sprintf("out: %s.signed\n", inPath), aliceOut.String())
 
@@ -0,0 +1,332 @@
+package service
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/api"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// sshAgent is an ssh-agent (read only) backed by the vault keyring.
+// It lists EdX25519, RSA and ECDSA keys as identities and signs with them, if
+// the service is unlocked and the key policy allows it.
+type sshAgent struct {
+	s       *service
+	confirm func(key *api.Key, comment string) bool
+}
+
+var _ agent.ExtendedAgent = &sshAgent{}
+
+func newSSHAgent(s *service) *sshAgent {
+	return &sshAgent{
+		s:       s,
+		confirm: s.sshAgentConfirm,
+	}
+}
+
+func (a *sshAgent) isUnlocked() bool {
+	a.s.unlockMtx.Lock()
+	defer a.s.unlockMtx.Unlock()
+	return a.s.unlocked
+}
+
+// sshAgentKey is a key available to the agent.
+type sshAgentKey struct {
+	key    *api.Key
+	signer ssh.Signer
+}
+
+// keys returns keys available to the agent.
+func (a *sshAgent) keys() ([]*sshAgentKey, error) {
+	if !a.isUnlocked() {
+		return []*sshAgentKey{}, nil
+	}
+	kr := keyring.New(a.s.vault)
+	ks, err := kr.List()
+	if err != nil {
+		return nil, err
+	}
+	now := a.s.clock.Now()
+	out := []*sshAgentKey{}
+	for _, key := range ks {
+		if len(key.Private) == 0 {
+			continue
+		}
+		if keyring.MetadataForKey(key).SSHAgent == keyring.SSHAgentDeny {
+			continue
+		}
+		if keyring.IsExpired(key, now) {
+			continue
+		}
+		k := keyring.As(key)
+		if k == nil {
+			continue
+		}
+		signer, err := keyring.SSHSigner(k)
+		if err != nil {
+			// Not supported for ssh
+			continue
+		}
+		out = append(out, &sshAgentKey{key: key, signer: signer})
+	}
+	return out, nil
+}
+
+func sshAgentComment(key *api.Key) string {
+	if label := keyring.MetadataForKey(key).Label; label != "" {
+		return label
+	}
+	return key.ID.String()
+}
+
+// List (ssh-agent) returns the identities.
+func (a *sshAgent) List() ([]*agent.Key, error) {
+	ks, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*agent.Key, 0, len(ks))
+	for _, k := range ks {
+		pk := k.signer.PublicKey()
+		out = append(out, &agent.Key{
+			Format:  pk.Type(),
+			Blob:    pk.Marshal(),
+			Comment: sshAgentComment(k.key),
+		})
+	}
+	return out, nil
+}
+
+// Sign (ssh-agent) signs data with the key.
+func (a *sshAgent) Sign(pk ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(pk, data, 0)
+}
+
+// SignWithFlags (ssh-agent) signs data with the key, for RSA keys the flags
+// select the (SHA2) signature algorithm.
+func (a *sshAgent) SignWithFlags(pk ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	if !a.isUnlocked() {
+		return nil, errors.Errorf("vault is locked")
+	}
+	ks, err := a.keys()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range ks {
+		if string(k.signer.PublicKey().Marshal()) != string(pk.Marshal()) {
+			continue
+		}
+		if keyring.MetadataForKey(k.key).SSHAgent == keyring.SSHAgentConfirm {
+			if !a.confirm(k.key, sshAgentComment(k.key)) {
+				logger.Infof("SSH agent sign (%s) not confirmed", k.key.ID)
+				return nil, errors.Errorf("signature not confirmed")
+			}
+		}
+		logger.Infof("SSH agent sign (%s)", k.key.ID)
+		algo := ""
+		switch {
+		case flags&agent.SignatureFlagRsaSha512 != 0:
+			algo = ssh.SigAlgoRSASHA2512
+		case flags&agent.SignatureFlagRsaSha256 != 0:
+			algo = ssh.SigAlgoRSASHA2256
+		}
+		if algo != "" && k.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+			as, ok := k.signer.(ssh.AlgorithmSigner)
+			if !ok {
+				return nil, errors.Errorf("signature algorithm not supported")
+			}
+			return as.SignWithAlgorithm(rand.Reader, data, algo)
+		}
+		return k.signer.Sign(rand.Reader, data)
+	}
+	return nil, errors.Errorf("key not found")
+}
+
+// Extension (ssh-agent) is not supported.
+func (a *sshAgent) Extension(extensionType string, contents []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// Signers (ssh-agent) is not supported.
+func (a *sshAgent) Signers() ([]ssh.Signer, error) {
+	return nil, errors.Errorf("not supported")
+}
+
+// Add (ssh-agent) is not supported, import keys into the vault instead.
+func (a *sshAgent) Add(key agent.AddedKey) error {
+	return errors.Errorf("not supported")
+}
+
+// Remove (ssh-agent) is not supported.
+func (a *sshAgent) Remove(key ssh.PublicKey) error {
+	return errors.Errorf("not supported")
+}
+
+// RemoveAll (ssh-agent) is not supported.
+func (a *sshAgent) RemoveAll() error {
+	return errors.Errorf("not supported")
+}
+
+// Lock (ssh-agent) is not supported, use the vault lock instead.
+func (a *sshAgent) Lock(passphrase []byte) error {
+	return errors.Errorf("not supported")
+}
+
+// Unlock (ssh-agent) is not supported, use the vault unlock instead.
+func (a *sshAgent) Unlock(passphrase []byte) error {
+	return errors.Errorf("not supported")
+}
+
+// sshAgentConfirm asks to confirm the use of a key, using the askpass program
+// (like ssh-add -c).
+func (s *service) sshAgentConfirm(key *api.Key, comment string) bool {
+	askpass := s.env.SSHAgentAskPass()
+	if askpass == "" {
+		logger.Warningf("No askpass program for ssh agent confirmation")
+		return false
+	}
+	pk, err := keyring.SSHPublicKey(keyring.AsPublic(key))
+	if err != nil {
+		logger.Warningf("Failed to get ssh public key: %v", err)
+		return false
+	}
+	prompt := fmt.Sprintf("Allow use of key %s?\nKey fingerprint %s.", comment, ssh.FingerprintSHA256(pk))
+	cmd := exec.Command(askpass, prompt) // #nosec
+	cmd.Env = append(os.Environ(), "SSH_ASKPASS_PROMPT=confirm")
+	if err := cmd.Run(); err != nil {
+		logger.Infof("Askpass failed: %v", err)
+		return false
+	}
+	return true
+}
+
+// serveSSHAgent listens for ssh-agent connections on a unix socket at path.
+// Returns a close function to stop listening.
+func serveSSHAgent(path string, ag agent.Agent) (func(), error) {
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	lis, err := listenUnixPrivate(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to listen (ssh-agent)")
+	}
+	sock, err := os.Lstat(path)
+	if err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				if !strings.Contains(err.Error(), "use of closed network connection") {
+					logger.Errorf("SSH agent accept error: %v", err)
+				}
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if err := agent.ServeAgent(ag, conn); err != nil && err != io.EOF {
+					logger.Debugf("SSH agent error: %v", err)
+				}
+			}()
+		}
+	}()
+	closeFn := func() {
+		_ = lis.Close()
+		// Only remove the socket if it's still ours.
+		if fi, err := os.Lstat(path); err == nil && os.SameFile(fi, sock) {
+			_ = os.Remove(path)
+		}
+	}
+	return closeFn, nil
+}
+
+// removeStaleSocket removes a (stale) socket at path.
+// Errors if there is something else (not a socket) at path.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return errors.Errorf("failed to listen (ssh-agent): %s exists and is not a socket", path)
+	}
+	return os.Remove(path)
+}
+
+// listenUnixPrivate listens on a unix socket at path, only accessible by the
+// current user. The socket is created in a private (0700) directory and then
+// moved to path, so it's never accessible with the default umask.
+func listenUnixPrivate(path string) (*net.UnixListener, error) {
+	dir, err := ioutil.TempDir(filepath.Dir(path), ".sock")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	tmp := filepath.Join(dir, filepath.Base(path))
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmp, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	// We remove the socket (at path) on close.
+	lis.SetUnlinkOnClose(false)
+	if err := os.Chmod(tmp, 0600); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+func sshAgentPolicyToRPC(p keyring.SSHAgentPolicy) SSHAgentPolicy {
+	switch p {
+	case keyring.SSHAgentConfirm:
+		return SSHAgentConfirm
+	case keyring.SSHAgentDeny:
+		return SSHAgentDeny
+	default:
+		return SSHAgentAllow
+	}
+}
+
+func sshAgentPolicyFromRPC(p SSHAgentPolicy) keyring.SSHAgentPolicy {
+	switch p {
+	case SSHAgentConfirm:
+		return keyring.SSHAgentConfirm
+	case SSHAgentDeny:
+		return keyring.SSHAgentDeny
+	default:
+		return keyring.SSHAgentAllow
+	}
+}
+
+func sshAgentPolicyFromString(s string) (SSHAgentPolicy, error) {
+	switch s {
+	case "allow":
+		return SSHAgentAllow, nil
+	case "confirm":
+		return SSHAgentConfirm, nil
+	case "deny":
+		return SSHAgentDeny, nil
+	default:
+		return SSHAgentAllow, errors.Errorf("invalid ssh agent policy: %s", s)
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/api"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func TestSSHAgent(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	testImportKey(t, service, bob)
+
+	_, err := service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), Label: "alice"})
+	require.NoError(t, err)
+
+	confirmed := false
+	ag := newSSHAgent(service)
+	ag.confirm = func(key *api.Key, comment string) bool { return confirmed }
+
+	path := keys.RandTempPath()
+	agentCloseFn, err := serveSSHAgent(path, ag)
+	require.NoError(t, err)
+	defer agentCloseFn()
+	if runtime.GOOS != "windows" {
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	}
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := agent.NewClient(conn)
+
+	ks, err := client.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ks))
+	comments := []string{ks[0].Comment, ks[1].Comment}
+	require.Contains(t, comments, "alice")
+	require.Contains(t, comments, bob.ID().String())
+
+	alicePK := alice.SSHSigner().PublicKey()
+	data := []byte("challenge")
+	sig, err := client.Sign(alicePK, data)
+	require.NoError(t, err)
+	require.Equal(t, ssh.KeyAlgoED25519, sig.Format)
+	err = alicePK.Verify(data, sig)
+	require.NoError(t, err)
+
+	// Unknown key
+	_, err = client.Sign(charlie.SSHSigner().PublicKey(), data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+
+	// Confirm
+	resp, err := service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), SSHAgent: SSHAgentConfirm})
+	require.NoError(t, err)
+	require.Equal(t, SSHAgentConfirm, resp.Key.SSHAgent)
+	_, err = client.Sign(alicePK, data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+	confirmed = true
+	_, err = client.Sign(alicePK, data)
+	require.NoError(t, err)
+
+	// Deny
+	_, err = service.KeyUpdate(ctx, &KeyUpdateRequest{KID: alice.ID().String(), SSHAgent: SSHAgentDeny})
+	require.NoError(t, err)
+	ks, err = client.List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ks))
+	require.Equal(t, bob.ID().String(), ks[0].Comment)
+	_, err = client.Sign(alicePK, data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+
+	// Lock
+	testAuthLock(t, service)
+	ks, err = client.List()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ks))
+	_, err = client.Sign(bob.SSHSigner().PublicKey(), data)
+	require.EqualError(t, err, "agent: failed to sign challenge")
+
+	err = client.RemoveAll()
+	require.Error(t, err)
+}
+
+func TestSSHAgentRSAECDSA(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+
+	kr := keyring.New(service.vault)
+	rk := keys.GenerateRSAKey()
+	err := kr.Save(api.NewKey(rk))
+	require.NoError(t, err)
+	ek := keyring.GenerateECDSAKey()
+	err = kr.Save(api.NewKey(ek))
+	require.NoError(t, err)
+	// Not supported for ssh
+	err = kr.Save(api.NewKey(keyring.GenerateSecp256k1Key()))
+	require.NoError(t, err)
+
+	path := keys.RandTempPath()
+	agentCloseFn, err := serveSSHAgent(path, newSSHAgent(service))
+	require.NoError(t, err)
+	defer agentCloseFn()
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := agent.NewClient(conn)
+
+	ks, err := client.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ks))
+
+	data := []byte("challenge")
+
+	rpk, err := keyring.SSHPublicKey(rk)
+	require.NoError(t, err)
+	sig, err := client.SignWithFlags(rpk, data, agent.SignatureFlagRsaSha256)
+	require.NoError(t, err)
+	require.Equal(t, ssh.SigAlgoRSASHA2256, sig.Format)
+	err = rpk.Verify(data, sig)
+	require.NoError(t, err)
+
+	epk, err := keyring.SSHPublicKey(ek)
+	require.NoError(t, err)
+	sig, err = client.Sign(epk, data)
+	require.NoError(t, err)
+	require.Equal(t, ssh.KeyAlgoECDSA256, sig.Format)
+	err = epk.Verify(data, sig)
+	require.NoError(t, err)
+}
+
+func TestSSHAgentSocketPath(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+
+	// Not a socket
+	path := keys.RandTempPath()
+	err := ioutil.WriteFile(path, []byte("not a socket"), 0600)
+	require.NoError(t, err)
+	defer os.Remove(path)
+	_, err = serveSSHAgent(path, newSSHAgent(service))
+	require.EqualError(t, err, "failed to listen (ssh-agent): "+path+" exists and is not a socket")
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "not a socket", string(b))
+
+	// Stale socket
+	path = keys.RandTempPath()
+	staleCloseFn, err := serveSSHAgent(path, newSSHAgent(service))
+	require.NoError(t, err)
+	agentCloseFn, err := serveSSHAgent(path, newSSHAgent(service))
+	require.NoError(t, err)
+	staleCloseFn()
+	fi, err := os.Lstat(path)
+	require.NoError(t, err)
+	require.True(t, fi.Mode()&os.ModeSocket != 0)
+
+	// Replaced (not our socket) on close
+	err = os.Remove(path)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(path, []byte("replaced"), 0600)
+	require.NoError(t, err)
+	defer os.Remove(path)
+	agentCloseFn()
+	b, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "replaced", string(b))
+}
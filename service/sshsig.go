@@ -0,0 +1,516 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// RunSSHSig runs a program compatible with the ssh-keygen -Y calling
+// convention used by git (gpg.ssh.program), signing and verifying with keys
+// from the keys service.
+//
+//	git config gpg.format ssh
+//	git config gpg.ssh.program keys-sshsig
+//	git config user.signingkey "key::ssh-ed25519 AAAA..."
+//
+// The signing key can also be a path to a public key file, for example from
+// keys export -t ssh --public. Git passes the key to us as a file (-f), which
+// may contain an ssh public key or a key ID.
+func RunSSHSig(build Build) {
+	client := NewClient()
+	defer client.Close()
+
+	connectFn := func() error {
+		env, err := NewEnv(build.DefaultAppName, build)
+		if err != nil {
+			return err
+		}
+		return connect(env, client, build, os.Getenv("KEYS_AUTH"), false)
+	}
+
+	if err := runSSHSig(os.Args[1:], client, connectFn, os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+type sshsigOptions struct {
+	op           string
+	namespace    string
+	file         string
+	principal    string
+	sig          string
+	keyFromAgent bool
+	verifyTime   time.Time
+	args         []string
+}
+
+func parseSSHSigOptions(args []string) (*sshsigOptions, error) {
+	opts := &sshsigOptions{}
+	fs := flag.NewFlagSet("keys-sshsig", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.StringVar(&opts.op, "Y", "", "operation (sign, verify, find-principals, check-novalidate)")
+	fs.StringVar(&opts.namespace, "n", "", "namespace")
+	fs.StringVar(&opts.file, "f", "", "key file (sign) or allowed signers file (verify)")
+	fs.StringVar(&opts.principal, "I", "", "principal")
+	fs.StringVar(&opts.sig, "s", "", "signature file")
+	fs.BoolVar(&opts.keyFromAgent, "U", false, "key is public key (ignored)")
+	fs.Var(sshsigOptionFlag{opts}, "O", "option (verify-time, others are ignored)")
+	if err := fs.Parse(splitSSHSigOptionArgs(args)); err != nil {
+		return nil, err
+	}
+	opts.args = fs.Args()
+	return opts, nil
+}
+
+// splitSSHSigOptionArgs splits -O options with the value attached (as git
+// passes -Overify-time=...), which flag doesn't support.
+func splitSSHSigOptionArgs(args []string) []string {
+	out := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-O") && len(arg) > 2 {
+			out = append(out, "-O", arg[2:])
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
+
+type sshsigOptionFlag struct {
+	opts *sshsigOptions
+}
+
+func (f sshsigOptionFlag) String() string { return "" }
+
+func (f sshsigOptionFlag) Set(s string) error {
+	if strings.HasPrefix(s, "verify-time=") {
+		t, err := parseSSHTime(strings.TrimPrefix(s, "verify-time="))
+		if err != nil {
+			return err
+		}
+		f.opts.verifyTime = t
+	}
+	return nil
+}
+
+// verifyAt is the time to check allowed signers validity (defaults to now).
+func (o *sshsigOptions) verifyAt() time.Time {
+	if o.verifyTime.IsZero() {
+		return time.Now()
+	}
+	return o.verifyTime
+}
+
+func runSSHSig(args []string, client *Client, connectFn func() error, in io.Reader, out io.Writer) error {
+	opts, err := parseSSHSigOptions(args)
+	if err != nil {
+		return err
+	}
+
+	switch opts.op {
+	case "sign":
+		if err := connectFn(); err != nil {
+			return err
+		}
+		return sshsigSignFiles(client, opts)
+	case "verify":
+		if err := connectFn(); err != nil {
+			return err
+		}
+		return sshsigVerify(client, opts, in, out)
+	case "check-novalidate":
+		if err := connectFn(); err != nil {
+			return err
+		}
+		return sshsigCheckNoValidate(client, opts, in, out)
+	case "find-principals":
+		return sshsigFindPrincipals(opts, out)
+	case "":
+		return errors.Errorf("no operation specified (-Y)")
+	default:
+		return errors.Errorf("unsupported operation %s", opts.op)
+	}
+}
+
+// sshsigSignFiles signs each file (arg), writing <file>.sig.
+// The key file (-f) contains an ssh public key or a key ID.
+func sshsigSignFiles(client *Client, opts *sshsigOptions) error {
+	if opts.namespace == "" {
+		return errors.Errorf("no namespace specified (-n)")
+	}
+	kid, err := readSSHSigKeyFile(opts.file)
+	if err != nil {
+		return err
+	}
+	if len(opts.args) == 0 {
+		return errors.Errorf("no files to sign")
+	}
+	for _, file := range opts.args {
+		b, err := ioutil.ReadFile(file) // #nosec
+		if err != nil {
+			return err
+		}
+		resp, err := client.RPCClient().Sign(context.TODO(), &SignRequest{
+			Data:      b,
+			Signer:    kid.String(),
+			Detached:  true,
+			Armored:   true,
+			Format:    SSHSignature,
+			Namespace: opts.namespace,
+		})
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file+".sig", resp.Data, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSSHSigKeyFile(file string) (keys.ID, error) {
+	if file == "" {
+		return "", errors.Errorf("no key file specified (-f)")
+	}
+	b, err := ioutil.ReadFile(file) // #nosec
+	if err != nil {
+		return "", err
+	}
+	s := strings.TrimSpace(string(b))
+	if kid, err := keys.ParseID(s); err == nil {
+		return kid, nil
+	}
+	key, err := keyring.ParseSSHPublicKey(s)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read key file")
+	}
+	return key.ID(), nil
+}
+
+func sshsigVerifyStdin(client *Client, opts *sshsigOptions, in io.Reader) (*keyring.SSHSignature, error) {
+	if opts.sig == "" {
+		return nil, errors.Errorf("no signature file specified (-s)")
+	}
+	if opts.namespace == "" {
+		return nil, errors.Errorf("no namespace specified (-n)")
+	}
+	sigBytes, err := ioutil.ReadFile(opts.sig)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := parseSSHSigFile(sigBytes)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.RPCClient().VerifyDetached(context.TODO(), &VerifyDetachedRequest{
+		Sig:       sigBytes,
+		Data:      data,
+		Format:    SSHSignature,
+		Namespace: opts.namespace,
+	}); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+func sshsigVerify(client *Client, opts *sshsigOptions, in io.Reader, out io.Writer) error {
+	if opts.principal == "" {
+		return errors.Errorf("no principal specified (-I)")
+	}
+	signers, err := readAllowedSigners(opts.file)
+	if err != nil {
+		return err
+	}
+	sig, err := sshsigVerifyStdin(client, opts, in)
+	if err != nil {
+		return err
+	}
+	if !allowedSignersMatch(signers, opts.principal, opts.namespace, sig.PublicKey, opts.verifyAt()) {
+		return errors.Errorf("signature key not allowed for %s", opts.principal)
+	}
+	fmt.Fprintf(out, "Good %q signature for %s with %s key %s\n", opts.namespace, opts.principal, sshsigKeyType(sig.PublicKey), ssh.FingerprintSHA256(sig.PublicKey))
+	return nil
+}
+
+func sshsigCheckNoValidate(client *Client, opts *sshsigOptions, in io.Reader, out io.Writer) error {
+	sig, err := sshsigVerifyStdin(client, opts, in)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Good %q signature with %s key %s\n", opts.namespace, sshsigKeyType(sig.PublicKey), ssh.FingerprintSHA256(sig.PublicKey))
+	return nil
+}
+
+func sshsigFindPrincipals(opts *sshsigOptions, out io.Writer) error {
+	if opts.sig == "" {
+		return errors.Errorf("no signature file specified (-s)")
+	}
+	signers, err := readAllowedSigners(opts.file)
+	if err != nil {
+		return err
+	}
+	sigBytes, err := ioutil.ReadFile(opts.sig)
+	if err != nil {
+		return err
+	}
+	sig, err := parseSSHSigFile(sigBytes)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, signer := range signers {
+		if !signer.matchesKey(sig.PublicKey) || !signer.validAt(opts.verifyAt()) {
+			continue
+		}
+		fmt.Fprintf(out, "%s\n", strings.Join(signer.Principals, ","))
+		found = true
+	}
+	if !found {
+		return errors.Errorf("no principal matched")
+	}
+	return nil
+}
+
+// parseSSHSigFile parses a signature, rejecting certificate signers which we
+// don't support (see cert-authority in allowed signers).
+func parseSSHSigFile(b []byte) (*keyring.SSHSignature, error) {
+	sig, err := keyring.ParseSSHSignature(b)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := sig.PublicKey.(*ssh.Certificate); ok {
+		return nil, errors.Errorf("ssh certificate signatures not supported")
+	}
+	return sig, nil
+}
+
+func sshsigKeyType(pk ssh.PublicKey) string {
+	switch pk.Type() {
+	case ssh.KeyAlgoED25519:
+		return "ED25519"
+	case ssh.KeyAlgoRSA:
+		return "RSA"
+	case ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521:
+		return "ECDSA"
+	default:
+		return strings.ToUpper(pk.Type())
+	}
+}
+
+// allowedSigner is an entry from an ssh allowed signers file, see
+// ssh-keygen(1) ALLOWED SIGNERS.
+type allowedSigner struct {
+	Principals []string
+	Namespaces []string
+	PublicKey  ssh.PublicKey
+	// CertAuthority entries only match certificates signed by the key, which we
+	// don't support, so they never match.
+	CertAuthority bool
+	ValidAfter    time.Time
+	ValidBefore   time.Time
+}
+
+func (s allowedSigner) matchesKey(pk ssh.PublicKey) bool {
+	if s.CertAuthority {
+		return false
+	}
+	return s.PublicKey.Type() == pk.Type() && string(s.PublicKey.Marshal()) == string(pk.Marshal())
+}
+
+func (s allowedSigner) validAt(t time.Time) bool {
+	if !s.ValidAfter.IsZero() && t.Before(s.ValidAfter) {
+		return false
+	}
+	if !s.ValidBefore.IsZero() && t.After(s.ValidBefore) {
+		return false
+	}
+	return true
+}
+
+func (s allowedSigner) matchesPrincipal(principal string) bool {
+	for _, p := range s.Principals {
+		if ok, _ := path.Match(p, principal); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s allowedSigner) matchesNamespace(namespace string) bool {
+	if len(s.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range s.Namespaces {
+		if ok, _ := path.Match(ns, namespace); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func allowedSignersMatch(signers []*allowedSigner, principal string, namespace string, pk ssh.PublicKey, t time.Time) bool {
+	for _, signer := range signers {
+		if signer.matchesPrincipal(principal) && signer.matchesNamespace(namespace) && signer.matchesKey(pk) && signer.validAt(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func readAllowedSigners(file string) ([]*allowedSigner, error) {
+	if file == "" {
+		return nil, errors.Errorf("no allowed signers file specified (-f)")
+	}
+	f, err := os.Open(file) // #nosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseAllowedSigners(f)
+}
+
+func parseAllowedSigners(r io.Reader) ([]*allowedSigner, error) {
+	signers := []*allowedSigner{}
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		signer, err := parseAllowedSigner(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid allowed signers (line %d)", n)
+		}
+		signers = append(signers, signer)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return signers, nil
+}
+
+// parseAllowedSigner parses a line:
+//
+//	principals [options] keytype base64-key [comment]
+func parseAllowedSigner(line string) (*allowedSigner, error) {
+	fields := splitUnquoted(line, " \t")
+	if len(fields) < 3 {
+		return nil, errors.Errorf("not enough fields")
+	}
+	signer := &allowedSigner{Principals: strings.Split(fields[0], ",")}
+
+	rest := fields[1:]
+	if !isSSHKeyType(rest[0]) {
+		for _, opt := range splitUnquoted(rest[0], ",") {
+			name, value := opt, ""
+			if i := strings.Index(opt, "="); i >= 0 {
+				name, value = opt[:i], strings.Trim(opt[i+1:], `"`)
+			}
+			switch strings.ToLower(name) {
+			case "namespaces":
+				signer.Namespaces = strings.Split(value, ",")
+			case "cert-authority":
+				signer.CertAuthority = true
+			case "valid-after":
+				t, err := parseSSHTime(value)
+				if err != nil {
+					return nil, err
+				}
+				signer.ValidAfter = t
+			case "valid-before":
+				t, err := parseSSHTime(value)
+				if err != nil {
+					return nil, err
+				}
+				signer.ValidBefore = t
+			default:
+				return nil, errors.Errorf("unsupported option %s", name)
+			}
+		}
+		rest = rest[1:]
+	}
+	if len(rest) < 2 {
+		return nil, errors.Errorf("no public key")
+	}
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(rest[0] + " " + rest[1]))
+	if err != nil {
+		return nil, err
+	}
+	signer.PublicKey = pk
+	return signer, nil
+}
+
+// parseSSHTime parses a time in the ssh-keygen format, YYYYMMDD[HHMM[SS]],
+// in local time or UTC with a Z suffix.
+func parseSSHTime(s string) (time.Time, error) {
+	loc := time.Local
+	if strings.HasSuffix(s, "Z") || strings.HasSuffix(s, "z") {
+		s, loc = s[:len(s)-1], time.UTC
+	}
+	var layout string
+	switch len(s) {
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, errors.Errorf("invalid time %q", s)
+	}
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q", s)
+	}
+	return t, nil
+}
+
+func isSSHKeyType(s string) bool {
+	return strings.HasPrefix(s, "ssh-") || strings.HasPrefix(s, "ecdsa-sha2-") || strings.HasPrefix(s, "sk-")
+}
+
+// splitUnquoted splits on any of the separator characters, except within
+// quotes, skipping empty fields.
+func splitUnquoted(s string, seps string) []string {
+	fields := []string{}
+	var sb strings.Builder
+	quoted := false
+	for _, c := range s {
+		switch {
+		case c == '"':
+			quoted = !quoted
+			sb.WriteRune(c)
+		case strings.ContainsRune(seps, c) && !quoted:
+			if sb.Len() > 0 {
+				fields = append(fields, sb.String())
+				sb.Reset()
+			}
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	if sb.Len() > 0 {
+		fields = append(fields, sb.String())
+	}
+	return fields
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSignSSHSig(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	ctx := context.TODO()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	message := []byte("I'm alice")
+
+	signResp, err := service.Sign(ctx, &SignRequest{
+		Data:      message,
+		Signer:    alice.ID().String(),
+		Detached:  true,
+		Format:    SSHSignature,
+		Namespace: "git",
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(signResp.Data), "-----BEGIN SSH SIGNATURE-----"))
+
+	verifyResp, err := service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Sig:       signResp.Data,
+		Data:      message,
+		Format:    SSHSignature,
+		Namespace: "git",
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice.ID().String(), verifyResp.Signer.ID)
+
+	_, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Sig:    signResp.Data,
+		Data:   message,
+		Format: SSHSignature,
+	})
+	require.EqualError(t, err, `ssh signature namespace mismatch "git" != "file"`)
+
+	_, err = service.VerifyDetached(ctx, &VerifyDetachedRequest{
+		Sig:       signResp.Data,
+		Data:      []byte("I'm not alice"),
+		Format:    SSHSignature,
+		Namespace: "git",
+	})
+	require.EqualError(t, err, "verify failed")
+
+	_, err = service.Sign(ctx, &SignRequest{
+		Data:   message,
+		Signer: alice.ID().String(),
+		Format: SSHSignature,
+	})
+	require.EqualError(t, err, "sshsig signatures are detached")
+}
+
+func TestRunSSHSig(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+
+	var out bytes.Buffer
+	client, clientCloseFn := newTestRPCClient(t, service, env, "", &out)
+	defer clientCloseFn()
+	connectFn := func() error { return nil }
+
+	dir, err := ioutil.TempDir("", "sshsig")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	spk, err := ssh.NewPublicKey(ed25519.PublicKey(alice.PublicKey().Bytes()))
+	require.NoError(t, err)
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(spk)))
+
+	keyPath := filepath.Join(dir, "key.pub")
+	err = ioutil.WriteFile(keyPath, []byte(authorizedKey+"\n"), 0600)
+	require.NoError(t, err)
+	allowedPath := filepath.Join(dir, "allowed_signers")
+	expiredPath := filepath.Join(dir, "allowed_signers_expired")
+	err = ioutil.WriteFile(allowedPath, []byte("alice@keys.pub namespaces=\"git\" "+authorizedKey+"\n"), 0600)
+	require.NoError(t, err)
+
+	message := []byte("tree 1234\n\nCommit message\n")
+	msgPath := filepath.Join(dir, "commit")
+	err = ioutil.WriteFile(msgPath, message, 0600)
+	require.NoError(t, err)
+
+	// Sign (git: -Y sign -n git -f <key> -U <file>)
+	err = runSSHSig([]string{"-Y", "sign", "-n", "git", "-f", keyPath, "-U", msgPath}, client, connectFn, nil, &out)
+	require.NoError(t, err)
+	sigPath := msgPath + ".sig"
+	_, err = os.Stat(sigPath)
+	require.NoError(t, err)
+
+	// Find principals
+	out.Reset()
+	err = runSSHSig([]string{"-Y", "find-principals", "-f", allowedPath, "-s", sigPath}, client, connectFn, nil, &out)
+	require.NoError(t, err)
+	require.Equal(t, "alice@keys.pub\n", out.String())
+
+	// Verify
+	out.Reset()
+	args := []string{"-Y", "verify", "-n", "git", "-f", allowedPath, "-I", "alice@keys.pub", "-s", sigPath}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader(message), &out)
+	require.NoError(t, err)
+	require.Equal(t, `Good "git" signature for alice@keys.pub with ED25519 key `+ssh.FingerprintSHA256(spk)+"\n", out.String())
+
+	// Verify (expired)
+	err = ioutil.WriteFile(expiredPath, []byte("alice@keys.pub valid-before=\"20200101Z\" "+authorizedKey+"\n"), 0600)
+	require.NoError(t, err)
+	args = []string{"-Y", "verify", "-n", "git", "-f", expiredPath, "-I", "alice@keys.pub", "-s", sigPath}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader(message), &out)
+	require.EqualError(t, err, "signature key not allowed for alice@keys.pub")
+
+	// Verify (expired, with verify-time)
+	out.Reset()
+	args = []string{"-Y", "verify", "-n", "git", "-f", expiredPath, "-I", "alice@keys.pub", "-s", sigPath, "-Overify-time=20191231000000Z"}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader(message), &out)
+	require.NoError(t, err)
+
+	// Verify (unknown principal)
+	args = []string{"-Y", "verify", "-n", "git", "-f", allowedPath, "-I", "bob@keys.pub", "-s", sigPath}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader(message), &out)
+	require.EqualError(t, err, "signature key not allowed for bob@keys.pub")
+
+	// Verify (invalid)
+	args = []string{"-Y", "verify", "-n", "git", "-f", allowedPath, "-I", "alice@keys.pub", "-s", sigPath}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader([]byte("other")), &out)
+	require.EqualError(t, err, "rpc error: code = Unknown desc = verify failed")
+
+	// Check (no validate)
+	out.Reset()
+	args = []string{"-Y", "check-novalidate", "-n", "git", "-s", sigPath}
+	err = runSSHSig(args, client, connectFn, bytes.NewReader(message), &out)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out.String(), `Good "git" signature with ED25519 key SHA256:`))
+
+	// Sign (key file with kid)
+	err = ioutil.WriteFile(keyPath, []byte(alice.ID().String()), 0600)
+	require.NoError(t, err)
+	err = runSSHSig([]string{"-Y", "sign", "-n", "git", "-f", keyPath, msgPath}, client, connectFn, nil, &out)
+	require.NoError(t, err)
+
+	err = runSSHSig([]string{"-Y", "unknown"}, client, connectFn, nil, &out)
+	require.EqualError(t, err, "unsupported operation unknown")
+}
+
+func TestParseAllowedSigners(t *testing.T) {
+	sk := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	spk, err := ssh.NewPublicKey(ed25519.PublicKey(sk.PublicKey().Bytes()))
+	require.NoError(t, err)
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(spk)))
+
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	in := "# comment\n\n" +
+		"alice@keys.pub,*@example.com " + authorizedKey + " alice\n" +
+		`bob@keys.pub namespaces="git,file" ` + authorizedKey + "\n" +
+		`charlie@keys.pub cert-authority ` + authorizedKey + "\n" +
+		`dave@keys.pub valid-after="20210101Z",valid-before="202105312359Z" ` + authorizedKey + "\n"
+	signers, err := parseAllowedSigners(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Equal(t, 4, len(signers))
+	require.Equal(t, []string{"alice@keys.pub", "*@example.com"}, signers[0].Principals)
+	require.Empty(t, signers[0].Namespaces)
+	require.Equal(t, []string{"bob@keys.pub"}, signers[1].Principals)
+	require.Equal(t, []string{"git", "file"}, signers[1].Namespaces)
+	require.True(t, signers[2].CertAuthority)
+	require.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), signers[3].ValidAfter)
+	require.Equal(t, time.Date(2021, 5, 31, 23, 59, 0, 0, time.UTC), signers[3].ValidBefore)
+
+	require.True(t, allowedSignersMatch(signers, "frank@example.com", "git", spk, now))
+	require.True(t, allowedSignersMatch(signers, "bob@keys.pub", "file", spk, now))
+	require.False(t, allowedSignersMatch(signers, "bob@keys.pub", "other", spk, now))
+	require.False(t, allowedSignersMatch(signers, "eve@keys.pub", "git", spk, now))
+	// Certificate authority (we only support plain keys)
+	require.False(t, allowedSignersMatch(signers, "charlie@keys.pub", "git", spk, now))
+	// Validity
+	require.False(t, allowedSignersMatch(signers, "dave@keys.pub", "git", spk, now))
+	require.True(t, allowedSignersMatch(signers, "dave@keys.pub", "git", spk, now.Add(-time.Hour)))
+	require.False(t, allowedSignersMatch(signers, "dave@keys.pub", "git", spk, time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)))
+
+	_, err = parseAllowedSigners(strings.NewReader(`alice@keys.pub valid-after="2021" ` + authorizedKey + "\n"))
+	require.EqualError(t, err, `invalid allowed signers (line 1): invalid time "2021"`)
+
+	_, err = parseAllowedSigners(strings.NewReader(`alice@keys.pub unknown ` + authorizedKey + "\n"))
+	require.EqualError(t, err, "invalid allowed signers (line 1): unsupported option unknown")
+
+	_, err = parseAllowedSigners(strings.NewReader("alice@keys.pub ssh-ed25519\n"))
+	require.EqualError(t, err, "invalid allowed signers (line 1): not enough fields")
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
+	"github.com/keys-pub/keys/user"
+	"github.com/pkg/errors"
+)
+
+// statementData is typed (JSON) data for a sigchain statement.
+// The statement itself is signed and verified like any other statement, the
+// type only describes (and restricts) the data.
+type statementData interface {
+	// verify checks the data is valid for a statement from kid.
+	verify(kid keys.ID) error
+	// describe returns a short description, for display.
+	describe() string
+}
+
+// statementTypes are the known statement types.
+var statementTypes = map[string]func() statementData{
+	"device":               func() statementData { return &deviceStatement{} },
+	"email":                func() statementData { return &emailStatement{} },
+	"pgp":                  func() statementData { return &pgpStatement{} },
+	"dns":                  func() statementData { return &dnsStatement{} },
+	endorseStatementType:   func() statementData { return &endorsement{} },
+	"user":                 func() statementData { return &userStatement{} },
+	rotateStatementType:    func() statementData { return &keyRotation{} },
+	api.ForgeStatementType: func() statementData { return &forgeStatement{} },
+}
+
+// reservedStatementTypes have their own RPC (or are created by the sigchain).
+var reservedStatementTypes = map[string]bool{
+	"user":                 true,
+	"revoke":               true,
+	rotateStatementType:    true,
+	api.ForgeStatementType: true,
+}
+
+// encodeStatementData encodes and verifies typed statement data.
+func encodeStatementData(kid keys.ID, data statementData) ([]byte, error) {
+	if err := data.verify(kid); err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+// decodeStatementData decodes and verifies typed statement data.
+func decodeStatementData(kid keys.ID, typ string, b []byte) (statementData, error) {
+	newData, ok := statementTypes[typ]
+	if !ok {
+		return nil, errors.Errorf("unknown statement type %q", typ)
+	}
+	data := newData()
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(data); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s statement", typ)
+	}
+	if err := data.verify(kid); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s statement", typ)
+	}
+	return data, nil
+}
+
+// describeStatement returns a description for a typed statement, or empty if
+// the statement has no (known) type.
+func describeStatement(st *keys.Statement) string {
+	if st.Type == "" {
+		return ""
+	}
+	if st.Type == "revoke" {
+		return fmt.Sprintf("revoke: %d", st.Revoke)
+	}
+	data, err := decodeStatementData(st.KID, st.Type, st.Data)
+	if err != nil {
+		return fmt.Sprintf("%s: (%s)", st.Type, err)
+	}
+	return fmt.Sprintf("%s: %s", st.Type, data.describe())
+}
+
+// deviceStatement is a (device) key added by the sigchain key.
+type deviceStatement struct {
+	Name string  `json:"name"`
+	KID  keys.ID `json:"kid"`
+}
+
+func (d *deviceStatement) verify(kid keys.ID) error {
+	if strings.TrimSpace(d.Name) == "" {
+		return errors.Errorf("no device name")
+	}
+	if _, err := keys.ParseID(d.KID.String()); err != nil {
+		return errors.Wrapf(err, "invalid device kid")
+	}
+	if !d.KID.IsEdX25519() && !d.KID.IsX25519() {
+		return errors.Errorf("invalid device kid, unsupported key type")
+	}
+	if d.KID == kid {
+		return errors.Errorf("device kid can't be the sigchain key")
+	}
+	return nil
+}
+
+func (d *deviceStatement) describe() string {
+	return fmt.Sprintf("%s (%s)", d.Name, d.KID)
+}
+
+// emailStatement is an email contact address.
+type emailStatement struct {
+	Email string `json:"email"`
+}
+
+func (e *emailStatement) verify(kid keys.ID) error {
+	addr, err := mail.ParseAddress(e.Email)
+	if err != nil || addr.Address != e.Email {
+		return errors.Errorf("invalid email %q", e.Email)
+	}
+	return nil
+}
+
+func (e *emailStatement) describe() string {
+	return e.Email
+}
+
+// pgpStatement cross-signs a PGP key. Sig is an (armored) detached signature
+// by the PGP key, so the PGP key agrees to the link.
+type pgpStatement struct {
+	Fingerprint string `json:"fingerprint"`
+	PublicKey   string `json:"publicKey"`
+	Sig         string `json:"sig"`
+}
+
+func pgpCrossSignMessage(kid keys.ID) []byte {
+	return []byte("keys.pub/pgp/v1\n" + kid.String())
+}
+
+func (p *pgpStatement) verify(kid keys.ID) error {
+	el, err := openpgp.ReadArmoredKeyRing(strings.NewReader(p.PublicKey))
+	if err != nil {
+		return errors.Wrapf(err, "invalid pgp public key")
+	}
+	if len(el) != 1 {
+		return errors.Errorf("invalid pgp public key, expected 1 key, got %d", len(el))
+	}
+	fingerprint := hex.EncodeToString(el[0].PrimaryKey.Fingerprint)
+	if !strings.EqualFold(fingerprint, p.Fingerprint) {
+		return errors.Errorf("pgp fingerprint mismatch %s != %s", p.Fingerprint, fingerprint)
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(el, bytes.NewReader(pgpCrossSignMessage(kid)), strings.NewReader(p.Sig), nil); err != nil {
+		return errors.Errorf("invalid pgp signature")
+	}
+	return nil
+}
+
+func (p *pgpStatement) describe() string {
+	return strings.ToUpper(p.Fingerprint)
+}
+
+// domainRegex is a (lowercase) domain name with at least 2 labels.
+var domainRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// dnsStatement is a domain with a TXT record that links back to the
+// sigchain key (see api.DomainTXT), the same record as for domain (https)
+// users:
+//
+//	keys.pub=kex1...
+//
+// The TXT record is checked when the statement is created (see verifyTXT).
+type dnsStatement struct {
+	Domain string `json:"domain"`
+}
+
+// verifyTXT checks the domain has a TXT record for kid.
+func (d *dnsStatement) verifyTXT(ctx context.Context, resolver api.TXTResolver, kid keys.ID) error {
+	return api.VerifyDomainTXT(ctx, resolver, d.Domain, kid)
+}
+
+func (d *dnsStatement) verify(kid keys.ID) error {
+	if len(d.Domain) > 253 || !domainRegex.MatchString(d.Domain) {
+		return errors.Errorf("invalid domain %q", d.Domain)
+	}
+	return nil
+}
+
+func (d *dnsStatement) describe() string {
+	return d.Domain
+}
+
+// userStatement is a (service) user link, see user.NewSigchainStatement.
+type userStatement struct {
+	user.User
+}
+
+func (u *userStatement) verify(kid keys.ID) error {
+	if u.KID != kid {
+		return errors.Errorf("user kid mismatch %s != %s", u.KID, kid)
+	}
+	return u.Validate()
+}
+
+func (u *userStatement) describe() string {
+	return fmt.Sprintf("%s %s", u.ID(), u.URL)
+}
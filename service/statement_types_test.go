@@ -0,0 +1,156 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/keys-pub/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementTypes(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	testImportKey(t, service, alice)
+	ctx := context.TODO()
+
+	device, err := encodeStatementData(alice.ID(), &deviceStatement{Name: "laptop", KID: bob.ID()})
+	require.NoError(t, err)
+	resp, err := service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "device",
+		Data: device,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "device", resp.Statement.Type)
+
+	st, err := statementFromRPC(resp.Statement)
+	require.NoError(t, err)
+	require.Equal(t, "device: laptop ("+bob.ID().String()+")", describeStatement(st))
+
+	resp, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "email",
+		Data: []byte(`{"email":"alice@keys.pub"}`),
+	})
+	require.NoError(t, err)
+	st, err = statementFromRPC(resp.Statement)
+	require.NoError(t, err)
+	require.Equal(t, "email: alice@keys.pub", describeStatement(st))
+
+	// DNS (no TXT record)
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "dns",
+		Data: []byte(`{"domain":"keys.pub"}`),
+	})
+	require.EqualError(t, err, "failed to lookup TXT records for keys.pub: no such host")
+
+	env.resolver["keys.pub"] = []string{"keys.pub=" + bob.ID().String()}
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "dns",
+		Data: []byte(`{"domain":"keys.pub"}`),
+	})
+	require.EqualError(t, err, "no TXT record keys.pub="+alice.ID().String()+" for keys.pub")
+
+	env.resolver["keys.pub"] = []string{"v=spf1 -all", "keys.pub=" + alice.ID().String()}
+	resp, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "dns",
+		Data: []byte(`{"domain":"keys.pub"}`),
+	})
+	require.NoError(t, err)
+
+	// Sigchain verifies
+	sc, err := service.scs.Sigchain(alice.ID())
+	require.NoError(t, err)
+	require.Equal(t, 3, sc.Length())
+	rsc := keys.NewSigchain(alice.ID())
+	require.NoError(t, rsc.AddAll(sc.Statements()))
+
+	// Invalid
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "email",
+		Data: []byte(`{"email":"alice"}`),
+	})
+	require.EqualError(t, err, `invalid email statement: invalid email "alice"`)
+
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "email",
+		Data: []byte(`{"email":"alice@keys.pub","name":"Alice"}`),
+	})
+	require.EqualError(t, err, `invalid email statement: json: unknown field "name"`)
+
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "dns",
+		Data: []byte(`{"domain":"https://keys.pub"}`),
+	})
+	require.EqualError(t, err, `invalid dns statement: invalid domain "https://keys.pub"`)
+
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "device",
+		Data: []byte(`{"name":"laptop","kid":"` + alice.ID().String() + `"}`),
+	})
+	require.EqualError(t, err, "invalid device statement: device kid can't be the sigchain key")
+
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "unknown",
+		Data: []byte(`{}`),
+	})
+	require.EqualError(t, err, `unknown statement type "unknown"`)
+
+	_, err = service.StatementCreate(ctx, &StatementCreateRequest{
+		KID:  alice.ID().String(),
+		Type: "user",
+		Data: []byte(`{}`),
+	})
+	require.EqualError(t, err, "statement type user is reserved")
+}
+
+func TestStatementTypePGP(t *testing.T) {
+	entity, err := openpgp.NewEntity("Alice", "", "alice@keys.pub", nil)
+	require.NoError(t, err)
+
+	var pub bytes.Buffer
+	w, err := armor.Encode(&pub, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	var sig bytes.Buffer
+	err = openpgp.ArmoredDetachSign(&sig, entity, bytes.NewReader(pgpCrossSignMessage(alice.ID())), nil)
+	require.NoError(t, err)
+
+	fingerprint := hex.EncodeToString(entity.PrimaryKey.Fingerprint)
+	pgp := &pgpStatement{
+		Fingerprint: fingerprint,
+		PublicKey:   pub.String(),
+		Sig:         sig.String(),
+	}
+	b, err := encodeStatementData(alice.ID(), pgp)
+	require.NoError(t, err)
+
+	data, err := decodeStatementData(alice.ID(), "pgp", b)
+	require.NoError(t, err)
+	require.Equal(t, pgp, data)
+
+	// Signature for a different key
+	_, err = decodeStatementData(bob.ID(), "pgp", b)
+	require.EqualError(t, err, "invalid pgp statement: invalid pgp signature")
+
+	pgp.Fingerprint = "0000000000000000000000000000000000000000"
+	_, err = encodeStatementData(alice.ID(), pgp)
+	require.EqualError(t, err, "pgp fingerprint mismatch "+pgp.Fingerprint+" != "+fingerprint)
+}
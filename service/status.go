@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/tsutil"
 )
 
 // RuntimeStatus (RPC) gets the current runtime status.
@@ -24,27 +26,54 @@ func (s *service) RuntimeStatus(ctx context.Context, req *RuntimeStatusRequest)
 		return nil, err
 	}
 
-	// Check vault sync if unlocked
+	var expiries []*KeyExpiry
+	// Check vault sync and key expiries if unlocked
 	if status == vault.Unlocked {
 		go func() {
 			if err := s.vaultUpdate(context.TODO(), time.Minute*5); err != nil {
 				logger.Errorf("Failed to check sync: %v", err)
 			}
 		}()
+
+		expiries, err = s.keyExpiries()
+		if err != nil {
+			logger.Errorf("Failed to check key expiries: %v", err)
+		}
 	}
 
 	resp := RuntimeStatusResponse{
-		Version:    s.build.Version,
-		AppName:    s.env.AppName(),
-		Exe:        exe,
-		AuthStatus: vaultStatusToRPC(status),
-		Sync:       sync,
-		FIDO2:      s.auth.fas != nil,
+		Version:     s.build.Version,
+		AppName:     s.env.AppName(),
+		Exe:         exe,
+		AuthStatus:  vaultStatusToRPC(status),
+		Sync:        sync,
+		FIDO2:       s.auth.fas != nil,
+		KeyExpiries: expiries,
 	}
 	logger.Infof("Runtime status, %s", resp.String())
 	return &resp, nil
 }
 
+func (s *service) keyExpiries() ([]*KeyExpiry, error) {
+	kr := keyring.New(s.vault)
+	now := s.clock.Now()
+	ks, err := kr.Expiring(now, s.env.KeyExpiryWarning())
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*KeyExpiry, 0, len(ks))
+	for _, key := range ks {
+		md := keyring.MetadataForKey(key)
+		out = append(out, &KeyExpiry{
+			KID:       key.ID.String(),
+			Label:     md.Label,
+			ExpiresAt: tsutil.Millis(md.ExpiresAt),
+			Expired:   keyring.IsExpired(key, now),
+		})
+	}
+	return out, nil
+}
+
 func vaultStatusToRPC(st vault.Status) AuthStatus {
 	switch st {
 	case vault.Locked:
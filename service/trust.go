@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/pins"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// TrustPin (RPC) pins a key, recording how it was verified.
+func (s *service) TrustPin(ctx context.Context, req *TrustPinRequest) (*TrustPinResponse, error) {
+	method, err := pinMethodFromRPC(req.Method)
+	if err != nil {
+		return nil, err
+	}
+	level, err := trustLevelFromRPC(req.Level)
+	if err != nil {
+		return nil, err
+	}
+	kid, err := s.lookup(ctx, req.Key, nil)
+	if err != nil {
+		return nil, err
+	}
+	usr, err := s.pinUser(ctx, req.Key, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := pins.New(s.vault).Save(&pins.Pin{
+		KID:    kid,
+		User:   usr,
+		Method: method,
+		Level:  level,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TrustPinResponse{
+		Pin: pinToRPC(pin),
+	}, nil
+}
+
+// TrustUnpin (RPC) removes a pinned key.
+func (s *service) TrustUnpin(ctx context.Context, req *TrustUnpinRequest) (*TrustUnpinResponse, error) {
+	if req.Key == "" {
+		return nil, errors.Errorf("no key specified")
+	}
+	pv := pins.New(s.vault)
+	var kid keys.ID
+	if strings.Contains(req.Key, "@") {
+		pin, err := pv.FindUser(req.Key)
+		if err != nil {
+			return nil, err
+		}
+		if pin == nil {
+			return nil, keys.NewErrNotFound(req.Key)
+		}
+		kid = pin.KID
+	} else {
+		k, err := keys.ParseID(req.Key)
+		if err != nil {
+			return nil, err
+		}
+		kid = k
+	}
+	ok, err := pv.Delete(kid)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, keys.NewErrNotFound(req.Key)
+	}
+	return &TrustUnpinResponse{}, nil
+}
+
+// Pins (RPC) lists pinned keys.
+func (s *service) Pins(ctx context.Context, req *PinsRequest) (*PinsResponse, error) {
+	ps, err := pins.New(s.vault).List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Pin, 0, len(ps))
+	for _, pin := range ps {
+		out = append(out, pinToRPC(pin))
+	}
+	return &PinsResponse{
+		Pins: out,
+	}, nil
+}
+
+// pinUser returns the user (name@service) for a key, from the (local) user
+// result or the key (if it was a user@service).
+func (s *service) pinUser(ctx context.Context, key string, kid keys.ID) (string, error) {
+	res, err := s.users.Get(ctx, kid)
+	if err != nil {
+		return "", err
+	}
+	if res != nil {
+		return res.User.ID(), nil
+	}
+	if strings.Contains(key, "@") {
+		return key, nil
+	}
+	return "", nil
+}
+
+// checkPin checks a key (kid or user@service) that resolved to kid against
+// pinned keys. If the user has a different key pinned (that wasn't rotated to
+// kid) this fails. If the user has no pinned key, kid is pinned (TOFU).
+func (s *service) checkPin(ctx context.Context, key string, kid keys.ID) error {
+	pv := pins.New(s.vault)
+	pin, err := pv.Get(kid)
+	if err != nil {
+		return err
+	}
+	if pin != nil && pin.Level == pins.Never {
+		return errors.Errorf("key %s is not trusted", kid)
+	}
+
+	usr, err := s.pinUser(ctx, key, kid)
+	if err != nil {
+		return err
+	}
+	if usr == "" {
+		return nil
+	}
+	upin, err := pv.FindUser(usr)
+	if err != nil {
+		return err
+	}
+	switch {
+	case upin == nil:
+		if pin != nil {
+			return nil
+		}
+		logger.Infof("Pinning %s for %s (tofu)", kid, usr)
+		_, err := pv.Save(&pins.Pin{KID: kid, User: usr, Method: pins.TOFU})
+		return err
+	case upin.KID == kid:
+		return nil
+	}
+
+	// If the pinned key was rotated to this key, move the pin.
+	rotated, err := s.followRotations(upin.KID)
+	if err != nil {
+		return err
+	}
+	if rotated == kid {
+		logger.Infof("Pinning %s for %s (rotated from %s)", kid, usr, upin.KID)
+		_, err := pv.Save(&pins.Pin{KID: kid, User: usr, Method: upin.Method, Level: upin.Level})
+		return err
+	}
+	return errors.Errorf("%s", keyChangedWarning(usr, upin.KID, kid))
+}
+
+func keyChangedWarning(usr string, pinned keys.ID, kid keys.ID) string {
+	return fmt.Sprintf("key for %s changed from pinned %s to %s (pin the new key if you trust it)", usr, pinned, kid)
+}
+
+// trustLevel returns the trust level for a pinned key.
+func (s *service) trustLevel(kid keys.ID) (TrustLevel, error) {
+	pin, err := pins.New(s.vault).Get(kid)
+	if err != nil {
+		return TrustUnknown, err
+	}
+	if pin == nil {
+		return TrustUnknown, nil
+	}
+	return trustLevelToRPC(pin.Level), nil
+}
+
+// trustWarning returns a warning if the key is not trusted, or the key user
+// has a different key pinned.
+func (s *service) trustWarning(ctx context.Context, kid keys.ID) (string, error) {
+	pv := pins.New(s.vault)
+	pin, err := pv.Get(kid)
+	if err != nil {
+		return "", err
+	}
+	if pin != nil {
+		if pin.Level == pins.Never {
+			return fmt.Sprintf("key %s is not trusted", kid), nil
+		}
+		return "", nil
+	}
+	res, err := s.users.Get(ctx, kid)
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", nil
+	}
+	upin, err := pv.FindUser(res.User.ID())
+	if err != nil {
+		return "", err
+	}
+	if upin != nil && upin.KID != kid {
+		return keyChangedWarning(res.User.ID(), upin.KID, kid), nil
+	}
+	return "", nil
+}
+
+func pinToRPC(pin *pins.Pin) *Pin {
+	return &Pin{
+		KID:       pin.KID.String(),
+		User:      pin.User,
+		Method:    pinMethodToRPC(pin.Method),
+		Level:     trustLevelToRPC(pin.Level),
+		CreatedAt: tsutil.Millis(pin.CreatedAt),
+		UpdatedAt: tsutil.Millis(pin.UpdatedAt),
+	}
+}
+
+func pinMethodToRPC(m pins.Method) PinMethod {
+	switch m {
+	case pins.TOFU:
+		return PinTOFU
+	case pins.Proof:
+		return PinProof
+	case pins.Wormhole:
+		return PinWormhole
+	case pins.InPerson:
+		return PinInPerson
+	default:
+		return PinNone
+	}
+}
+
+func pinMethodFromRPC(m PinMethod) (pins.Method, error) {
+	switch m {
+	case PinTOFU:
+		return pins.TOFU, nil
+	case PinProof:
+		return pins.Proof, nil
+	case PinWormhole:
+		return pins.Wormhole, nil
+	case PinInPerson:
+		return pins.InPerson, nil
+	default:
+		return "", errors.Errorf("no pin method specified")
+	}
+}
+
+func trustLevelToRPC(l pins.Level) TrustLevel {
+	switch l {
+	case pins.Never:
+		return TrustNever
+	case pins.Marginal:
+		return TrustMarginal
+	case pins.Full:
+		return TrustFull
+	default:
+		return TrustUnknown
+	}
+}
+
+func trustLevelFromRPC(l TrustLevel) (pins.Level, error) {
+	switch l {
+	case TrustUnknown:
+		// Default for method
+		return "", nil
+	case TrustNever:
+		return pins.Never, nil
+	case TrustMarginal:
+		return pins.Marginal, nil
+	case TrustFull:
+		return pins.Full, nil
+	default:
+		return "", errors.Errorf("invalid trust level %s", l)
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/keys-pub/keys-ext/vault/pins"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustPin(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := context.TODO()
+
+	aliceService, aliceCloseFn := newTestService(t, env)
+	defer aliceCloseFn()
+	testAuthSetup(t, aliceService)
+	testImportKey(t, aliceService, alice)
+	testUserSetupGithub(t, env, aliceService, alice, "alice")
+
+	bobService, bobCloseFn := newTestService(t, env)
+	defer bobCloseFn()
+	testAuthSetup(t, bobService)
+	testImportKey(t, bobService, bob)
+	testUserSetupGithub(t, env, bobService, bob, "bob")
+
+	testPull(t, aliceService, bob.ID())
+
+	encrypt := func() error {
+		_, err := aliceService.Encrypt(ctx, &EncryptRequest{
+			Data:       []byte("hi"),
+			Recipients: []string{"bob@github"},
+			Sender:     "alice@github",
+		})
+		return err
+	}
+
+	// TOFU
+	require.NoError(t, encrypt())
+	pinsResp, err := aliceService.Pins(ctx, &PinsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pinsResp.Pins))
+	require.Equal(t, bob.ID().String(), pinsResp.Pins[0].KID)
+	require.Equal(t, "bob@github", pinsResp.Pins[0].User)
+	require.Equal(t, PinTOFU, pinsResp.Pins[0].Method)
+	require.Equal(t, TrustMarginal, pinsResp.Pins[0].Level)
+
+	keyResp, err := aliceService.Key(ctx, &KeyRequest{Key: bob.ID().String()})
+	require.NoError(t, err)
+	require.Equal(t, TrustMarginal, keyResp.Key.Trust)
+
+	// Different key pinned for bob@github
+	_, err = pins.New(aliceService.vault).Save(&pins.Pin{KID: charlie.ID(), User: "bob@github", Method: pins.InPerson})
+	require.NoError(t, err)
+	err = encrypt()
+	require.EqualError(t, err, "key for bob@github changed from pinned "+charlie.ID().String()+" to "+bob.ID().String()+" (pin the new key if you trust it)")
+
+	signResp, err := bobService.Sign(ctx, &SignRequest{Data: []byte("hi"), Signer: bob.ID().String()})
+	require.NoError(t, err)
+	verifyResp, err := aliceService.Verify(ctx, &VerifyRequest{Data: signResp.Data})
+	require.NoError(t, err)
+	require.Equal(t, bob.ID().String(), verifyResp.Signer.ID)
+	require.Equal(t, "key for bob@github changed from pinned "+charlie.ID().String()+" to "+bob.ID().String()+" (pin the new key if you trust it)", verifyResp.Signer.TrustWarning)
+
+	// Pin bob's key
+	pinResp, err := aliceService.TrustPin(ctx, &TrustPinRequest{Key: "bob@github", Method: PinInPerson})
+	require.NoError(t, err)
+	require.Equal(t, bob.ID().String(), pinResp.Pin.KID)
+	require.Equal(t, TrustFull, pinResp.Pin.Level)
+	require.NoError(t, encrypt())
+	verifyResp, err = aliceService.Verify(ctx, &VerifyRequest{Data: signResp.Data})
+	require.NoError(t, err)
+	require.Empty(t, verifyResp.Signer.TrustWarning)
+
+	// Never
+	_, err = aliceService.TrustPin(ctx, &TrustPinRequest{Key: bob.ID().String(), Method: PinProof, Level: TrustNever})
+	require.NoError(t, err)
+	err = encrypt()
+	require.EqualError(t, err, "key "+bob.ID().String()+" is not trusted")
+
+	_, err = aliceService.TrustPin(ctx, &TrustPinRequest{Key: bob.ID().String()})
+	require.EqualError(t, err, "no pin method specified")
+
+	// Unpin
+	_, err = aliceService.TrustUnpin(ctx, &TrustUnpinRequest{Key: "bob@github"})
+	require.NoError(t, err)
+	_, err = aliceService.TrustUnpin(ctx, &TrustUnpinRequest{Key: "bob@github"})
+	require.EqualError(t, err, "bob@github not found")
+	require.NoError(t, encrypt())
+}
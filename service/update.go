@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys-ext/vault/keyring"
 	"github.com/keys-pub/keys/user"
 	"github.com/keys-pub/keys/user/services"
@@ -99,29 +100,28 @@ func (s *service) updateUser(ctx context.Context, kid keys.ID, allowProxyCache b
 		return nil, err
 	}
 
-	service := func(usr *user.User) services.Service {
+	res, err := s.users.Update(ctx, kid, users.UseService(s.userLookup(allowProxyCache)))
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// userLookup returns the service lookup for verifying users, using the proxy
+// for twitter, and DNS TXT records for domain (https) users.
+func (s *service) userLookup(allowProxyCache bool) users.ServiceLookupFn {
+	domain := api.DomainService(s.resolver)
+	return func(usr *user.User) services.Service {
 		switch usr.Service {
 		case "twitter":
 			if allowProxyCache {
 				return services.KeysPub
 			}
 			return services.Proxy
+		case "https":
+			return domain
 		}
 		return nil
 	}
-
-	res, err := s.users.Update(ctx, kid, users.UseService(service))
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-func twitterProxy(usr *user.User) services.Service {
-	switch usr.Service {
-	case "twitter":
-		return services.Proxy
-	}
-	return nil
 }
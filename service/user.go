@@ -74,8 +74,20 @@ func (s *service) User(ctx context.Context, req *UserRequest) (*UserResponse, er
 		}
 	}
 
+	var history []*UserStatusEvent
+	if req.History && !req.Local && user != nil {
+		resp, err := s.client.UserHistory(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if resp != nil {
+			history = userHistoryToRPC(resp.History)
+		}
+	}
+
 	return &UserResponse{
-		User: user,
+		User:    user,
+		History: history,
 	}, nil
 }
 
@@ -122,6 +134,17 @@ func (s *service) UserSign(ctx context.Context, req *UserSignRequest) (*UserSign
 		return nil, err
 	}
 
+	if req.Service == api.ForgeService {
+		msg, fu, err := forgeSign(key, req.Forge, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		return &UserSignResponse{
+			Message: msg,
+			Name:    fu.Name,
+		}, nil
+	}
+
 	user, err := user.NewForSigning(key.ID(), req.Service, req.Name)
 	if err != nil {
 		return nil, err
@@ -160,14 +183,34 @@ func (s *service) UserAdd(ctx context.Context, req *UserAddRequest) (*UserAddRes
 		return nil, err
 	}
 
+	if req.Service == api.ForgeService {
+		usr, st, err := s.forgeUserAdd(ctx, key, req.Forge, req.Name, req.URL, req.Local)
+		if err != nil {
+			return nil, err
+		}
+		stOut := statementToRPC(st)
+		if req.Local {
+			stOut.Status = StatementPending
+		}
+		return &UserAddResponse{
+			User:      usr,
+			Statement: stOut,
+		}, nil
+	}
+
 	user, st, err := s.sigchainUserAdd(ctx, key, req.Service, req.Name, req.URL, req.Local)
 	if err != nil {
 		return nil, err
 	}
 
+	stOut := statementToRPC(st)
+	if req.Local {
+		stOut.Status = StatementPending
+	}
+
 	return &UserAddResponse{
 		User:      userResultToRPC(user),
-		Statement: statementToRPC(st),
+		Statement: stOut,
 	}, nil
 }
 
@@ -192,7 +235,7 @@ func (s *service) sigchainUserAdd(ctx context.Context, key *keys.EdX25519Key, se
 		return nil, nil, err
 	}
 
-	userService, err := users.LookupService(usr, users.UseService(twitterProxy))
+	userService, err := users.LookupService(usr, users.UseService(s.userLookup(false)))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -227,7 +270,13 @@ func (s *service) sigchainUserAdd(ctx context.Context, key *keys.EdX25519Key, se
 		return nil, nil, err
 	}
 
-	if _, err = s.users.Update(ctx, key.ID(), users.UseService(twitterProxy)); err != nil {
+	if localOnly {
+		if err := s.addPending(ctx, st); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if _, err = s.users.Update(ctx, key.ID(), users.UseService(s.userLookup(false))); err != nil {
 		return nil, nil, err
 	}
 
@@ -353,25 +402,34 @@ func (s *service) searchUsersRemote(ctx context.Context, query string, limit int
 	return resp.Users, nil
 }
 
-func (s *service) lookupUser(ctx context.Context, user string, searchRemote bool) (keys.ID, error) {
+// lookupUser returns the key for a user, following key rotations, and the
+// user key (before following rotations).
+func (s *service) lookupUser(ctx context.Context, user string, searchRemote bool) (keys.ID, keys.ID, error) {
+	var userKID keys.ID
 	if searchRemote {
 		result, err := s.searchRemoteCheckUser(ctx, user)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		if result == nil {
-			return "", keys.NewErrNotFound(user)
+			return "", "", keys.NewErrNotFound(user)
 		}
-		return keys.ID(result.KID), nil
+		userKID = keys.ID(result.KID)
+	} else {
+		res, err := s.users.User(ctx, user)
+		if err != nil {
+			return "", "", err
+		}
+		if res == nil {
+			return "", "", keys.NewErrNotFound(user)
+		}
+		userKID = res.User.KID
 	}
-	res, err := s.users.User(ctx, user)
+	kid, err := s.followRotations(userKID)
 	if err != nil {
-		return "", err
-	}
-	if res == nil {
-		return "", keys.NewErrNotFound(user)
+		return "", "", err
 	}
-	return res.User.KID, nil
+	return kid, userKID, nil
 }
 
 // userVerifiedExpire is how long a verify lasts.
@@ -387,6 +445,9 @@ const userCheckExpire = time.Hour * 24
 const userCheckFailureExpire = time.Hour * 4
 
 func (s *service) ensureUserVerified(ctx context.Context, kid keys.ID) error {
+	if err := s.ensureForgeUsersVerified(ctx, kid); err != nil {
+		return err
+	}
 	res, err := s.users.Get(ctx, kid)
 	if err != nil {
 		return err
@@ -433,3 +494,16 @@ func (s *service) ensureUserVerified(ctx context.Context, kid keys.ID) error {
 // 	}
 // 	return userResultToRPC(res), nil
 // }
+
+func userHistoryToRPC(history []*api.UserStatusEvent) []*UserStatusEvent {
+	events := make([]*UserStatusEvent, 0, len(history))
+	for _, e := range history {
+		events = append(events, &UserStatusEvent{
+			User:      e.User,
+			Status:    userStatus(e.Status),
+			Err:       e.Err,
+			Timestamp: e.Timestamp,
+		})
+	}
+	return events
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/keys-pub/keys"
+	httpapi "github.com/keys-pub/keys-ext/http/api"
 	"github.com/keys-pub/keys-ext/vault/keyring"
 	"github.com/keys-pub/keys/http"
 	"github.com/keys-pub/keys/user"
@@ -69,6 +70,16 @@ func TestUser(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp.User)
 	require.Equal(t, alice.ID().String(), resp.User.KID)
+	require.Nil(t, resp.History)
+
+	resp, err = service.User(ctx, &UserRequest{
+		KID:     alice.ID().String(),
+		History: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.History))
+	require.Equal(t, "alice@github", resp.History[0].User)
+	require.Equal(t, UserStatusOK, resp.History[0].Status)
 
 	key := keys.GenerateEdX25519Key()
 	resp, err = service.User(ctx, &UserRequest{
@@ -308,6 +319,72 @@ func TestUserAddEcho(t *testing.T) {
 	require.Equal(t, keys.ID("kex1syuhwr4g05t4744r23nvxnr7en9cmz53knhr0gja7c84hr7fkw2quf6zcg"), kid)
 }
 
+func TestUserAddDomain(t *testing.T) {
+	env := newTestEnv(t)
+	service, closeFn := newTestService(t, env)
+	defer closeFn()
+	testAuthSetup(t, service)
+	ctx := context.TODO()
+
+	// Alice (DNS TXT)
+	testImportKey(t, service, alice)
+	signResp, err := service.UserSign(ctx, &UserSignRequest{
+		KID:     alice.ID().String(),
+		Service: "https",
+		Name:    "alice.example",
+	})
+	require.NoError(t, err)
+	notFound := func(ctx context.Context, req *http.Request) http.ProxyResponse {
+		return http.ProxyResponse{Err: http.Err{Code: 404}}
+	}
+	env.client.SetProxy("https://alice.example/.well-known/keys.pub.txt", notFound)
+	env.client.SetProxy("https://alice.example/.well-known/keyspub.txt", notFound)
+	_, err = service.UserAdd(ctx, &UserAddRequest{
+		KID:     alice.ID().String(),
+		Service: "https",
+		Name:    "alice.example",
+		URL:     "https://alice.example/.well-known/keyspub.txt",
+	})
+	require.EqualError(t, err, "user check failed: resource not found")
+
+	env.resolver["alice.example"] = []string{"v=spf1 -all", httpapi.DomainTXT(alice.ID())}
+	addResp, err := service.UserAdd(ctx, &UserAddRequest{
+		KID:     alice.ID().String(),
+		Service: "https",
+		Name:    "alice.example",
+		URL:     "https://alice.example/.well-known/keyspub.txt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "alice.example@https", addResp.User.ID)
+	require.Equal(t, UserStatusOK, addResp.User.Status)
+
+	// Bob (.well-known)
+	testImportKey(t, service, bob)
+	signResp, err = service.UserSign(ctx, &UserSignRequest{
+		KID:     bob.ID().String(),
+		Service: "https",
+		Name:    "bob.example",
+	})
+	require.NoError(t, err)
+	env.client.SetProxy("https://bob.example/.well-known/keys.pub.txt", func(ctx context.Context, req *http.Request) http.ProxyResponse {
+		return http.ProxyResponse{Body: []byte(signResp.Message)}
+	})
+	addResp, err = service.UserAdd(ctx, &UserAddRequest{
+		KID:     bob.ID().String(),
+		Service: "https",
+		Name:    "bob.example",
+		URL:     "https://bob.example/.well-known/keyspub.txt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, UserStatusOK, addResp.User.Status)
+
+	// Alice TXT record removed
+	delete(env.resolver, "alice.example")
+	res, err := service.updateUser(ctx, alice.ID(), false)
+	require.NoError(t, err)
+	require.Equal(t, user.StatusResourceNotFound, res.Status)
+}
+
 func TestSearchUsers(t *testing.T) {
 	env := newTestEnv(t)
 	service, closeFn := newTestService(t, env)
@@ -92,8 +92,21 @@ func (s *service) VaultStatus(ctx context.Context, req *VaultStatusRequest) (*Va
 	if status == nil {
 		return &VaultStatusResponse{}, nil
 	}
-	return &VaultStatusResponse{
+	resp := &VaultStatusResponse{
 		KID:      status.KID.String(),
 		SyncedAt: tsutil.Millis(status.SyncedAt),
-	}, nil
+	}
+	// Usage is informational, so we don't fail if the server is unavailable.
+	usage, err := s.vault.Usage(ctx)
+	if err != nil {
+		logger.Warningf("Failed to get vault usage: %v", err)
+	} else if usage != nil {
+		resp.Usage = &VaultUsage{
+			Bytes:     usage.Bytes,
+			Events:    usage.Events,
+			MaxBytes:  usage.MaxBytes,
+			MaxEvents: usage.MaxEvents,
+		}
+	}
+	return resp, nil
 }
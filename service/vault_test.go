@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/http/server"
 	"github.com/keys-pub/keys-ext/vault"
 	"github.com/stretchr/testify/require"
 )
@@ -69,6 +70,11 @@ func TestVault(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, status)
 	require.Equal(t, service.vault.Remote().Key.ID(), keys.ID(status.KID))
+	require.NotNil(t, status.Usage)
+	require.True(t, status.Usage.Events > 0)
+	require.True(t, status.Usage.Bytes > 0)
+	require.Equal(t, server.DefaultVaultQuota.MaxBytes, status.Usage.MaxBytes)
+	require.Equal(t, server.DefaultVaultQuota.MaxEvents, status.Usage.MaxEvents)
 
 	testImportKey(t, service, alice)
 
@@ -1,9 +1,14 @@
 package service
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"io"
+	"os"
 
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
 	"github.com/keys-pub/keys/saltpack"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -11,10 +16,13 @@ import (
 
 // Verify (RPC) ...
 func (s *service) Verify(ctx context.Context, req *VerifyRequest) (*VerifyResponse, error) {
-	verified, kid, err := saltpack.Verify(req.Data)
+	verified, envelope, kid, err := verifyEnvelope(req.Data)
 	if err != nil {
 		return nil, err
 	}
+	if err := envelope.checkContext(req.Context); err != nil {
+		return nil, err
+	}
 
 	var signer *Key
 	if kid != "" {
@@ -29,15 +37,32 @@ func (s *service) Verify(ctx context.Context, req *VerifyRequest) (*VerifyRespon
 		}
 	}
 
-	return &VerifyResponse{Data: verified, Signer: signer}, nil
+	resp := &VerifyResponse{Data: verified, Signer: signer}
+	if envelope != nil {
+		resp.Envelope = envelope.toRPC()
+	}
+	return resp, nil
 }
 
 // VerifyDetached (RPC) ...
 func (s *service) VerifyDetached(ctx context.Context, req *VerifyDetachedRequest) (*VerifyDetachedResponse, error) {
-	kid, err := saltpack.VerifyDetached(req.Sig, req.Data)
+	var kid keys.ID
+	var envelope *envelopeHeader
+	var err error
+	switch req.Format {
+	case SaltpackSignature:
+		envelope, kid, err = verifyDetachedEnvelope(req.Sig, req.Data)
+	case SSHSignature:
+		kid, err = verifySSHSig(req.Sig, req.Data, sshsigNamespace(req.Namespace))
+	default:
+		return nil, errors.Errorf("unsupported signature format %s", req.Format)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err := envelope.checkContext(req.Context); err != nil {
+		return nil, err
+	}
 
 	var signer *Key
 	if kid != "" {
@@ -48,7 +73,11 @@ func (s *service) VerifyDetached(ctx context.Context, req *VerifyDetachedRequest
 		signer = s
 	}
 
-	return &VerifyDetachedResponse{Signer: signer}, nil
+	resp := &VerifyDetachedResponse{Signer: signer}
+	if envelope != nil {
+		resp.Envelope = envelope.toRPC()
+	}
+	return resp, nil
 }
 
 // VerifyFile (RPC) ...
@@ -69,6 +98,10 @@ func (s *service) VerifyFile(srv RPC_VerifyFileServer) error {
 	if err != nil {
 		return err
 	}
+	if err := checkNoEnvelopeFile(out); err != nil {
+		_ = os.Remove(out)
+		return err
+	}
 
 	if err := srv.Send(&VerifyFileOutput{
 		Signer: signer,
@@ -97,6 +130,9 @@ func (s *service) VerifyDetachedFile(srv RPC_VerifyDetachedFileServer) error {
 	if in == "" {
 		return errors.Errorf("in not specified")
 	}
+	if isDetachedEnvelopeSig(req.Sig) {
+		return errEnvelopeUnsupported
+	}
 
 	signer, err := s.verifyDetachedIn(srv.Context(), req.Sig, in)
 	if err != nil {
@@ -131,10 +167,18 @@ func (s *service) verifyStream(srv verifyStreamServer, armored bool) error {
 
 	reader := newStreamReader(srv.Context(), recvFn)
 
-	streamReader, kid, err := saltpack.NewVerifyStream(reader)
+	verifyReader, kid, err := saltpack.NewVerifyStream(reader)
 	if err != nil {
 		return errors.Wrapf(err, "faild to verify stream")
 	}
+	streamReader := bufio.NewReader(verifyReader)
+	peek, err := streamReader.Peek(len(envelopeMagic))
+	if err != nil && err != io.EOF {
+		return errors.Wrapf(err, "failed to read from verify stream")
+	}
+	if isEnvelope(peek) {
+		return errEnvelopeUnsupported
+	}
 
 	var signer *Key
 	if kid != "" {
@@ -174,6 +218,10 @@ func (s *service) VerifyDetachedStream(srv RPC_VerifyDetachedStreamServer) error
 		return req.Data, nil
 	}
 
+	if isDetachedEnvelopeSig(first.Sig) {
+		return errEnvelopeUnsupported
+	}
+
 	reader := newStreamReader(srv.Context(), recvFn)
 	if err := reader.write(first.Data); err != nil {
 		return err
@@ -256,6 +304,24 @@ func (s *service) verifyWriteInOut(ctx context.Context, in string, out string) (
 	return signer, nil
 }
 
+// checkNoEnvelopeFile returns an error if the (verified) file is an envelope.
+func checkNoEnvelopeFile(path string) error {
+	f, err := os.Open(path) // #nosec
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	b := make([]byte, len(envelopeMagic))
+	n, err := io.ReadFull(f, b)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return err
+	}
+	if isEnvelope(b[:n]) {
+		return errEnvelopeUnsupported
+	}
+	return nil
+}
+
 func (s *service) verifyDetachedIn(ctx context.Context, sig []byte, in string) (*Key, error) {
 	logger.Infof("Verify (detached) %s", in)
 
@@ -275,3 +341,19 @@ func (s *service) verifyDetachedIn(ctx context.Context, sig []byte, in string) (
 
 	return signer, nil
 }
+
+// verifySSHSig verifies SSHSIG signature, returning the signer key ID.
+func verifySSHSig(sig []byte, data []byte, namespace string) (keys.ID, error) {
+	ssig, err := keyring.ParseSSHSignature(sig)
+	if err != nil {
+		return "", err
+	}
+	if err := ssig.Verify(namespace, bytes.NewReader(data)); err != nil {
+		return "", err
+	}
+	key, err := ssig.Key()
+	if err != nil {
+		return "", err
+	}
+	return key.ID(), nil
+}
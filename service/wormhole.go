@@ -51,7 +51,7 @@ func (s *service) wormholeInit(ctx context.Context, req *WormholeInput, wh *worm
 		if req.Recipient == "" {
 			return errors.Errorf("no recipient specified")
 		}
-		rid, err := s.lookup(ctx, req.Recipient, &lookupOpts{VerifyUser: true})
+		rid, err := s.lookup(ctx, req.Recipient, &lookupOpts{VerifyUser: true, CheckPin: true})
 		if err != nil {
 			return err
 		}
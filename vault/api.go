@@ -59,6 +59,15 @@ type Response struct {
 	Truncated bool         `json:"truncated,omitempty" msgpack:"trunc,omitempty"`
 }
 
+// Usage of a vault on the server.
+// Max values of 0 are unlimited.
+type Usage struct {
+	Bytes     int64 `json:"bytes"`
+	Events    int64 `json:"events"`
+	MaxBytes  int64 `json:"maxBytes,omitempty"`
+	MaxEvents int64 `json:"maxEvents,omitempty"`
+}
+
 // VaultSend saves events to the vault API with a key.
 // Events are encrypted with the key before saving.
 func (c *Client) VaultSend(ctx context.Context, key *keys.EdX25519Key, events []*Event) error {
@@ -169,6 +178,23 @@ func (c *Client) VaultExists(ctx context.Context, key *keys.EdX25519Key) (bool,
 	return true, nil
 }
 
+// VaultUsage returns vault usage and quota, or nil if vault not found.
+func (c *Client) VaultUsage(ctx context.Context, key *keys.EdX25519Key) (*Usage, error) {
+	path := dstore.Path("vault", key.ID(), "usage")
+	resp, err := c.Request(ctx, &client.Request{Method: "GET", Path: path, Key: key})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	var usage Usage
+	if err := json.Unmarshal(resp.Data, &usage); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
 // NewEvent creates a new event.
 func NewEvent(path string, b []byte) *Event {
 	return &Event{
@@ -0,0 +1,252 @@
+// Package contacts provides an address book (of other users keys) stored in
+// the vault.
+package contacts
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/groups"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+)
+
+// Contact is a named person or service, with their keys and linked users.
+type Contact struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// KIDs for the contact.
+	KIDs []keys.ID `json:"kids,omitempty"`
+	// Users (name@service) for the contact.
+	Users []string `json:"users,omitempty"`
+	Notes string   `json:"notes,omitempty"`
+	// Groups (recipient groups) the contact is in.
+	Groups []string `json:"groups,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Contacts vault.
+type Contacts struct {
+	*vault.Vault
+}
+
+// New contacts vault.
+func New(vlt *vault.Vault) *Contacts {
+	return &Contacts{vlt}
+}
+
+// contactItemType is type for contact.
+const contactItemType string = "contact"
+
+var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,63}$`)
+
+// ValidateName returns error if contact name is invalid.
+// Contact names are used as recipients so can't be a kid or user@service.
+func ValidateName(name string) error {
+	if name == "" {
+		return errors.Errorf("no contact name")
+	}
+	if !nameRegex.MatchString(name) {
+		return errors.Errorf("invalid contact name %q", name)
+	}
+	if _, err := keys.ParseID(name); err == nil {
+		return errors.Errorf("invalid contact name %q, can't be a kid", name)
+	}
+	return nil
+}
+
+// Save a contact, creating it (if ID is empty) or updating it.
+func (v *Contacts) Save(contact *Contact) (*Contact, error) {
+	contact.Name = strings.TrimSpace(contact.Name)
+	if err := ValidateName(contact.Name); err != nil {
+		return nil, err
+	}
+	for _, usr := range contact.Users {
+		if !strings.Contains(usr, "@") {
+			return nil, errors.Errorf("invalid user %q", usr)
+		}
+	}
+	contact.KIDs = normalizeKIDs(contact.KIDs)
+	for _, kid := range contact.KIDs {
+		if _, err := keys.ParseID(kid.String()); err != nil {
+			return nil, errors.Wrapf(err, "invalid contact kid %q", kid)
+		}
+	}
+	contact.Users = normalize(contact.Users)
+	contact.Groups = normalizeGroups(contact.Groups)
+	for _, group := range contact.Groups {
+		if err := groups.ValidateName(group); err != nil {
+			return nil, err
+		}
+	}
+	if len(contact.KIDs) == 0 && len(contact.Users) == 0 {
+		return nil, errors.Errorf("no contact kids or users")
+	}
+
+	now := v.Now()
+	existing, err := v.Get(contact.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ID != contact.ID {
+		return nil, errors.Errorf("contact %s already exists", contact.Name)
+	}
+	if contact.ID == "" {
+		contact.ID = encoding.MustEncode(keys.RandBytes(32), encoding.Base62)
+		contact.CreatedAt = now
+	} else {
+		item, err := v.Vault.Get(contact.ID)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil || item.Type != contactItemType {
+			return nil, keys.NewErrNotFound(contact.ID)
+		}
+		prev, err := asContact(item)
+		if err != nil {
+			return nil, err
+		}
+		contact.CreatedAt = prev.CreatedAt
+	}
+	contact.UpdatedAt = now
+
+	b, err := json.Marshal(contact)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Set(vault.NewItem(contact.ID, b, contactItemType, now)); err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+// Get a contact by name (case insensitive), or nil if not found.
+func (v *Contacts) Get(name string) (*Contact, error) {
+	contacts, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, contact := range contacts {
+		if strings.EqualFold(contact.Name, name) {
+			return contact, nil
+		}
+	}
+	return nil, nil
+}
+
+// Delete a contact by name.
+// Returns false if not found.
+func (v *Contacts) Delete(name string) (bool, error) {
+	contact, err := v.Get(name)
+	if err != nil {
+		return false, err
+	}
+	if contact == nil {
+		return false, nil
+	}
+	return v.Vault.Delete(contact.ID)
+}
+
+// List contacts, sorted by name.
+func (v *Contacts) List() ([]*Contact, error) {
+	items, err := v.Items()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Contact{}
+	for _, item := range items {
+		if item.Type != contactItemType {
+			continue
+		}
+		contact, err := asContact(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, contact)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return strings.ToLower(out[i].Name) < strings.ToLower(out[j].Name)
+	})
+	return out, nil
+}
+
+// InGroup returns contacts in a group.
+func (v *Contacts) InGroup(group string) ([]*Contact, error) {
+	group = groups.NormalizeName(group)
+	contacts, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Contact{}
+	for _, contact := range contacts {
+		for _, g := range contact.Groups {
+			if g == group {
+				out = append(out, contact)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// Recipients returns the kids and users for the contact.
+func (c *Contact) Recipients() []string {
+	out := make([]string, 0, len(c.KIDs)+len(c.Users))
+	for _, kid := range c.KIDs {
+		out = append(out, kid.String())
+	}
+	return append(out, c.Users...)
+}
+
+func asContact(item *vault.Item) (*Contact, error) {
+	var contact Contact
+	if err := json.Unmarshal(item.Data, &contact); err != nil {
+		return nil, err
+	}
+	if contact.ID != item.ID {
+		return nil, errors.Errorf("contact id mismatch")
+	}
+	return &contact, nil
+}
+
+func normalizeKIDs(kids []keys.ID) []keys.ID {
+	out := []keys.ID{}
+	seen := map[keys.ID]bool{}
+	for _, kid := range kids {
+		if kid == "" || seen[kid] {
+			continue
+		}
+		seen[kid] = true
+		out = append(out, kid)
+	}
+	return out
+}
+
+func normalize(ss []string) []string {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, s := range ss {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+func normalizeGroups(gs []string) []string {
+	out := make([]string, 0, len(gs))
+	for _, g := range gs {
+		out = append(out, groups.NormalizeName(g))
+	}
+	return normalize(out)
+}
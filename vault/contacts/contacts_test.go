@@ -0,0 +1,102 @@
+package contacts_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/contacts"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVault(t *testing.T) (*vault.Vault, func()) {
+	clock := tsutil.NewTestClock()
+	mem := vault.NewMem()
+	err := mem.Open()
+	require.NoError(t, err)
+	vlt := vault.New(mem, vault.WithClock(clock))
+	key := keys.Bytes32(bytes.Repeat([]byte{0xFF}, 32))
+	provision := &vault.Provision{
+		ID:        encoding.MustEncode(bytes.Repeat([]byte{0xFE}, 32), encoding.Base62),
+		Type:      vault.UnknownAuth,
+		CreatedAt: clock.Now(),
+	}
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	return vlt, func() { mem.Close() }
+}
+
+func TestContacts(t *testing.T) {
+	vlt, closeFn := newTestVault(t)
+	defer closeFn()
+	cv := contacts.New(vlt)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+
+	contact, err := cv.Save(&contacts.Contact{
+		Name:   "Alice",
+		KIDs:   []keys.ID{alice.ID(), alice.ID()},
+		Users:  []string{"alice@github", " alice@github"},
+		Notes:  "Met at conference",
+		Groups: []string{"@Backend-Team"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, contact.ID)
+	require.Equal(t, []keys.ID{alice.ID()}, contact.KIDs)
+	require.Equal(t, []string{"alice@github"}, contact.Users)
+	require.Equal(t, []string{"backend-team"}, contact.Groups)
+	require.Equal(t, []string{alice.ID().String(), "alice@github"}, contact.Recipients())
+
+	_, err = cv.Save(&contacts.Contact{Name: "bob", KIDs: []keys.ID{bob.ID()}})
+	require.NoError(t, err)
+
+	_, err = cv.Save(&contacts.Contact{Name: "invalid", KIDs: []keys.ID{"kex1invalid"}})
+	require.EqualError(t, err, `invalid contact kid "kex1invalid": failed to parse id: invalid character data part: s[0]=105`)
+
+	out, err := cv.Get("alice")
+	require.NoError(t, err)
+	require.Equal(t, contact.ID, out.ID)
+
+	// Update
+	out.Users = nil
+	updated, err := cv.Save(out)
+	require.NoError(t, err)
+	require.Equal(t, contact.ID, updated.ID)
+	require.Equal(t, 0, len(updated.Users))
+
+	_, err = cv.Save(&contacts.Contact{Name: "alice", KIDs: []keys.ID{bob.ID()}})
+	require.EqualError(t, err, "contact alice already exists")
+
+	list, err := cv.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	require.Equal(t, "Alice", list[0].Name)
+	require.Equal(t, "bob", list[1].Name)
+
+	inGroup, err := cv.InGroup("@backend-team")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inGroup))
+	require.Equal(t, "Alice", inGroup[0].Name)
+
+	ok, err := cv.Delete("bob")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = cv.Delete("bob")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = cv.Save(&contacts.Contact{Name: "bad name", KIDs: []keys.ID{bob.ID()}})
+	require.EqualError(t, err, `invalid contact name "bad name"`)
+	_, err = cv.Save(&contacts.Contact{Name: bob.ID().String(), KIDs: []keys.ID{bob.ID()}})
+	require.EqualError(t, err, `invalid contact name "`+bob.ID().String()+`", can't be a kid`)
+	_, err = cv.Save(&contacts.Contact{Name: "empty"})
+	require.EqualError(t, err, "no contact kids or users")
+	_, err = cv.Save(&contacts.Contact{Name: "charlie", Users: []string{"charlie"}})
+	require.EqualError(t, err, `invalid user "charlie"`)
+}
@@ -3,7 +3,9 @@ module github.com/keys-pub/keys-ext/vault
 go 1.14
 
 require (
+	github.com/ScaleFT/sshkeys v0.0.0-20200327173127-6142f742bca5
 	github.com/davecgh/go-spew v1.1.1
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1
 	github.com/golang/snappy v0.0.2 // indirect
 	github.com/keys-pub/keys v0.1.22-0.20210523195800-d583c5244ce9
 	github.com/keys-pub/keys-ext/http/api v0.0.0-20210525002537-0c132efd0ef7
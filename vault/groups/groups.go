@@ -0,0 +1,174 @@
+// Package groups provides named recipient groups stored in the vault.
+package groups
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+)
+
+// Group is a named set of members (kid or user@service).
+type Group struct {
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Groups vault.
+type Groups struct {
+	*vault.Vault
+}
+
+// New groups vault.
+func New(vlt *vault.Vault) *Groups {
+	return &Groups{vlt}
+}
+
+// groupItemType is type for group.
+const groupItemType string = "group"
+
+var nameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+
+// NormalizeName returns the group name, without a "@" prefix, lowercased.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+}
+
+// IsGroupName returns true if s is a group reference (@name).
+func IsGroupName(s string) bool {
+	return strings.HasPrefix(s, "@") && nameRegex.MatchString(NormalizeName(s))
+}
+
+// ValidateName returns error if group name is invalid.
+func ValidateName(name string) error {
+	if name == "" {
+		return errors.Errorf("no group name")
+	}
+	if !nameRegex.MatchString(name) {
+		return errors.Errorf("invalid group name %q", name)
+	}
+	return nil
+}
+
+// Save a group, creating it or replacing the members of an existing group
+// with the same name.
+func (v *Groups) Save(name string, members []string) (*Group, error) {
+	name = NormalizeName(name)
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	members = normalizeMembers(members)
+	if len(members) == 0 {
+		return nil, errors.Errorf("no group members")
+	}
+
+	now := v.Now()
+	group, err := v.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		group = &Group{
+			ID:        encoding.MustEncode(keys.RandBytes(32), encoding.Base62),
+			Name:      name,
+			CreatedAt: now,
+		}
+	}
+	group.Members = members
+	group.UpdatedAt = now
+
+	b, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Set(vault.NewItem(group.ID, b, groupItemType, now)); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// Get a group by name, or nil if not found.
+func (v *Groups) Get(name string) (*Group, error) {
+	name = NormalizeName(name)
+	groups, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+	return nil, nil
+}
+
+// Delete a group by name.
+// Returns false if not found.
+func (v *Groups) Delete(name string) (bool, error) {
+	group, err := v.Get(name)
+	if err != nil {
+		return false, err
+	}
+	if group == nil {
+		return false, nil
+	}
+	return v.Vault.Delete(group.ID)
+}
+
+// List groups, sorted by name.
+func (v *Groups) List() ([]*Group, error) {
+	items, err := v.Items()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Group{}
+	for _, item := range items {
+		if item.Type != groupItemType {
+			continue
+		}
+		group, err := asGroup(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, group)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+func asGroup(item *vault.Item) (*Group, error) {
+	var group Group
+	if err := json.Unmarshal(item.Data, &group); err != nil {
+		return nil, err
+	}
+	if group.ID != item.ID {
+		return nil, errors.Errorf("group id mismatch")
+	}
+	return &group, nil
+}
+
+func normalizeMembers(members []string) []string {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, m := range members {
+		m = strings.TrimSpace(m)
+		if m == "" || seen[m] {
+			continue
+		}
+		seen[m] = true
+		out = append(out, m)
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package groups_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/groups"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVault(t *testing.T) (*vault.Vault, func()) {
+	clock := tsutil.NewTestClock()
+	mem := vault.NewMem()
+	err := mem.Open()
+	require.NoError(t, err)
+	vlt := vault.New(mem, vault.WithClock(clock))
+	key := keys.Bytes32(bytes.Repeat([]byte{0xFF}, 32))
+	provision := &vault.Provision{
+		ID:        encoding.MustEncode(bytes.Repeat([]byte{0xFE}, 32), encoding.Base62),
+		Type:      vault.UnknownAuth,
+		CreatedAt: clock.Now(),
+	}
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	return vlt, func() { mem.Close() }
+}
+
+func TestGroups(t *testing.T) {
+	vlt, closeFn := newTestVault(t)
+	defer closeFn()
+	gv := groups.New(vlt)
+
+	group, err := gv.Save("@Backend-Team", []string{"alice@github", " bob@github", "alice@github", ""})
+	require.NoError(t, err)
+	require.Equal(t, "backend-team", group.Name)
+	require.Equal(t, []string{"alice@github", "bob@github"}, group.Members)
+
+	_, err = gv.Save("ops", []string{"charlie@github"})
+	require.NoError(t, err)
+
+	out, err := gv.Get("@backend-team")
+	require.NoError(t, err)
+	require.Equal(t, group.ID, out.ID)
+
+	// Update
+	updated, err := gv.Save("backend-team", []string{"bob@github"})
+	require.NoError(t, err)
+	require.Equal(t, group.ID, updated.ID)
+	require.Equal(t, []string{"bob@github"}, updated.Members)
+
+	list, err := gv.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	require.Equal(t, "backend-team", list[0].Name)
+	require.Equal(t, "ops", list[1].Name)
+
+	ok, err := gv.Delete("backend-team")
+	require.NoError(t, err)
+	require.True(t, ok)
+	out, err = gv.Get("backend-team")
+	require.NoError(t, err)
+	require.Nil(t, out)
+	ok, err = gv.Delete("backend-team")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = gv.Save("bad name", []string{"alice@github"})
+	require.EqualError(t, err, `invalid group name "bad name"`)
+	_, err = gv.Save("empty", []string{})
+	require.EqualError(t, err, "no group members")
+
+	require.True(t, groups.IsGroupName("@backend-team"))
+	require.False(t, groups.IsGroupName("alice@github"))
+	require.False(t, groups.IsGroupName("backend-team"))
+}
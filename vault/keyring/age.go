@@ -0,0 +1,257 @@
+package keyring
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Password protected age identities are age encrypted files (armored) with a
+// scrypt recipient, the same as age -p -a, see https://age-encryption.org/v1.
+
+const (
+	ageVersionLine  = "age-encryption.org/v1"
+	ageScryptLabel  = "age-encryption.org/v1/scrypt"
+	ageArmorType    = "AGE ENCRYPTED FILE"
+	ageScryptLogN   = 18
+	ageMaxLogN      = 22
+	ageChunkSize    = 64 * 1024
+	ageColumnsPerLn = 64
+)
+
+var ageBase64 = base64.RawStdEncoding
+
+// ageEncryptScrypt encrypts b with a password, returning an armored age file.
+func ageEncryptScrypt(b []byte, password string) (string, error) {
+	if password == "" {
+		return "", errors.Errorf("no password")
+	}
+	fileKey := keys.RandBytes(16)
+	salt := keys.RandBytes(16)
+	wrapKey, err := scrypt.Key([]byte(password), append([]byte(ageScryptLabel), salt...), 1<<ageScryptLogN, 8, 1, chacha20poly1305.KeySize)
+	if err != nil {
+		return "", err
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return "", err
+	}
+	wrapped := aead.Seal(nil, make([]byte, chacha20poly1305.NonceSize), fileKey, nil)
+
+	var out bytes.Buffer
+	out.WriteString(ageVersionLine + "\n")
+	out.WriteString("-> scrypt " + ageBase64.EncodeToString(salt) + " " + strconv.Itoa(ageScryptLogN) + "\n")
+	out.WriteString(ageWrapBody(ageBase64.EncodeToString(wrapped)))
+	out.WriteString("---")
+	mac, err := ageHeaderMAC(fileKey, out.Bytes())
+	if err != nil {
+		return "", err
+	}
+	out.WriteString(" " + ageBase64.EncodeToString(mac) + "\n")
+
+	nonce := keys.RandBytes(16)
+	payload, err := ageSealPayload(fileKey, nonce, b)
+	if err != nil {
+		return "", err
+	}
+	out.Write(nonce)
+	out.Write(payload)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: ageArmorType, Bytes: out.Bytes()})), nil
+}
+
+// ageDecryptScrypt decrypts an age file (binary) with a scrypt recipient.
+func ageDecryptScrypt(b []byte, password string) ([]byte, error) {
+	r := bytes.NewBuffer(b)
+	readLine := func() (string, error) {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return "", errors.Errorf("invalid age header")
+		}
+		return strings.TrimSuffix(line, "\n"), nil
+	}
+
+	version, err := readLine()
+	if err != nil {
+		return nil, err
+	}
+	if version != ageVersionLine {
+		return nil, errors.Errorf("unsupported age version")
+	}
+	stanza, err := readLine()
+	if err != nil {
+		return nil, err
+	}
+	args := strings.Split(stanza, " ")
+	if len(args) != 4 || args[0] != "->" || args[1] != "scrypt" {
+		return nil, errors.Errorf("age file is not password encrypted")
+	}
+	salt, err := ageBase64.DecodeString(args[2])
+	if err != nil || len(salt) != 16 {
+		return nil, errors.Errorf("invalid age scrypt salt")
+	}
+	logN, err := strconv.Atoi(args[3])
+	if err != nil || logN <= 0 || logN > ageMaxLogN || strconv.Itoa(logN) != args[3] {
+		return nil, errors.Errorf("invalid age scrypt work factor")
+	}
+	var body string
+	for {
+		line, err := readLine()
+		if err != nil {
+			return nil, err
+		}
+		body += line
+		if len(line) < ageColumnsPerLn {
+			break
+		}
+	}
+	wrapped, err := ageBase64.DecodeString(body)
+	if err != nil {
+		return nil, errors.Errorf("invalid age scrypt body")
+	}
+	macLine, err := readLine()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(macLine, "--- ") {
+		return nil, errors.Errorf("age file has more than one recipient")
+	}
+	mac, err := ageBase64.DecodeString(strings.TrimPrefix(macLine, "--- "))
+	if err != nil {
+		return nil, errors.Errorf("invalid age header mac")
+	}
+	header := b[:len(b)-r.Len()-len(macLine)-1+len("---")]
+
+	wrapKey, err := scrypt.Key([]byte(password), append([]byte(ageScryptLabel), salt...), 1<<logN, 8, 1, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.New(wrapKey)
+	if err != nil {
+		return nil, err
+	}
+	fileKey, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), wrapped, nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to decrypt age file (invalid password?)")
+	}
+	expected, err := ageHeaderMAC(fileKey, header)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, expected) {
+		return nil, errors.Errorf("invalid age header mac")
+	}
+
+	rest := r.Bytes()
+	if len(rest) < 16 {
+		return nil, errors.Errorf("invalid age payload")
+	}
+	return ageOpenPayload(fileKey, rest[:16], rest[16:])
+}
+
+func ageWrapBody(s string) string {
+	var out strings.Builder
+	for len(s) >= ageColumnsPerLn {
+		out.WriteString(s[:ageColumnsPerLn] + "\n")
+		s = s[ageColumnsPerLn:]
+	}
+	// The last line is always shorter than a full line (it may be empty).
+	out.WriteString(s + "\n")
+	return out.String()
+}
+
+func ageHKDF(secret []byte, salt []byte, info string) ([]byte, error) {
+	out := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(info)), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func ageHeaderMAC(fileKey []byte, header []byte) ([]byte, error) {
+	key, err := ageHKDF(fileKey, nil, "header")
+	if err != nil {
+		return nil, err
+	}
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write(header)
+	return h.Sum(nil), nil
+}
+
+// ageChunkNonce is the STREAM nonce, a big endian chunk counter and last chunk
+// flag.
+func ageChunkNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+func ageSealPayload(fileKey []byte, nonce []byte, b []byte) ([]byte, error) {
+	key, err := ageHKDF(fileKey, nonce, "payload")
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	out := []byte{}
+	for counter := uint64(0); ; counter++ {
+		n := len(b)
+		if n > ageChunkSize {
+			n = ageChunkSize
+		}
+		last := n == len(b)
+		out = aead.Seal(out, ageChunkNonce(counter, last), b[:n], nil)
+		b = b[n:]
+		if last {
+			return out, nil
+		}
+	}
+}
+
+func ageOpenPayload(fileKey []byte, nonce []byte, b []byte) ([]byte, error) {
+	key, err := ageHKDF(fileKey, nonce, "payload")
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	chunkSize := ageChunkSize + aead.Overhead()
+	out := []byte{}
+	for counter := uint64(0); ; counter++ {
+		n := len(b)
+		if n > chunkSize {
+			n = chunkSize
+		}
+		last := n == len(b)
+		if n < aead.Overhead() || (n == aead.Overhead() && counter > 0) {
+			return nil, errors.Errorf("invalid age payload")
+		}
+		out, err = aead.Open(out, ageChunkNonce(counter, last), b[:n], nil)
+		if err != nil {
+			return nil, errors.Errorf("failed to decrypt age payload")
+		}
+		b = b[n:]
+		if last {
+			return out, nil
+		}
+	}
+}
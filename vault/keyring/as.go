@@ -0,0 +1,122 @@
+package keyring
+
+import (
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+)
+
+// As returns key as concrete type, including key types only supported by the
+// keyring (ECDSA, secp256k1).
+// Returns nil if we can't resolve.
+func As(k *api.Key) keys.Key {
+	if k.Private == nil {
+		return AsPublic(k)
+	}
+	switch k.Type {
+	case string(ECDSA):
+		if out := AsECDSA(k); out != nil {
+			return out
+		}
+	case string(Secp256k1):
+		if out := AsSecp256k1(k); out != nil {
+			return out
+		}
+	default:
+		if out := k.As(); out != nil {
+			return out
+		}
+	}
+	return nil
+}
+
+// AsPublic returns public key as concrete type, including key types only
+// supported by the keyring (ECDSA, secp256k1).
+// Returns nil if we can't resolve.
+func AsPublic(k *api.Key) keys.Key {
+	switch k.Type {
+	case string(ECDSA):
+		if out := AsECDSAPublic(k); out != nil {
+			return out
+		}
+	case string(Secp256k1):
+		if out := AsSecp256k1Public(k); out != nil {
+			return out
+		}
+	default:
+		if out := k.AsPublic(); out != nil {
+			return out
+		}
+	}
+	return nil
+}
+
+// AsECDSA returns a *ECDSAKey.
+// Returns nil if we can't resolve.
+func AsECDSA(k *api.Key) *ECDSAKey {
+	if k.Type != string(ECDSA) || k.Private == nil {
+		return nil
+	}
+	out, err := NewECDSAKeyFromBytes(k.Private)
+	if err != nil {
+		return nil
+	}
+	if out.ID() != k.ID {
+		return nil
+	}
+	return out
+}
+
+// AsECDSAPublic returns a *ECDSAPublicKey.
+// Returns nil if we can't resolve.
+func AsECDSAPublic(k *api.Key) *ECDSAPublicKey {
+	if k.Type != string(ECDSA) {
+		return nil
+	}
+	out, err := NewECDSAPublicKeyFromBytes(k.Public)
+	if err != nil {
+		return nil
+	}
+	if out.ID() != k.ID {
+		return nil
+	}
+	return out
+}
+
+// AsSecp256k1 returns a *Secp256k1Key.
+// Returns nil if we can't resolve.
+func AsSecp256k1(k *api.Key) *Secp256k1Key {
+	if k.Type != string(Secp256k1) || k.Private == nil {
+		return nil
+	}
+	out, err := NewSecp256k1KeyFromPrivateKey(k.Private)
+	if err != nil {
+		return nil
+	}
+	if out.ID() != k.ID {
+		return nil
+	}
+	return out
+}
+
+// AsSecp256k1Public returns a *Secp256k1PublicKey.
+// Returns nil if we can't resolve.
+func AsSecp256k1Public(k *api.Key) *Secp256k1PublicKey {
+	if k.Type != string(Secp256k1) {
+		return nil
+	}
+	out, err := NewSecp256k1PublicKeyFromID(k.ID)
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+// AsRSA returns a *keys.RSAKey.
+// Returns nil if we can't resolve.
+func AsRSA(k *api.Key) *keys.RSAKey {
+	out := k.AsRSA()
+	if out == nil || out.ID() != k.ID {
+		return nil
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package keyring
+
+import (
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/pkg/errors"
+)
+
+// Derived keys store the root key ID and derivation path as Ext fields.
+const (
+	rootExt = "root"
+	pathExt = "path"
+)
+
+// DeriveEdX25519Key derives a child EdX25519Key from a root key and path.
+// The path is a "/" separated list of labels, for example "backend/signing".
+// The same root and path always derive the same key.
+func DeriveEdX25519Key(root *keys.EdX25519Key, path string) (*keys.EdX25519Key, error) {
+	seed, err := deriveSeed(root, path, "keys.pub/derive/edx25519")
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewEdX25519KeyFromSeed(seed), nil
+}
+
+// DeriveX25519Key derives a child X25519Key from a root key and path.
+// See DeriveEdX25519Key.
+func DeriveX25519Key(root *keys.EdX25519Key, path string) (*keys.X25519Key, error) {
+	seed, err := deriveSeed(root, path, "keys.pub/derive/x25519")
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewX25519KeyFromSeed(seed), nil
+}
+
+// DeriveKey derives a child key of type (edx25519 or x25519) from a root key
+// and path. The returned api.Key records the root key ID and path.
+func DeriveKey(root *keys.EdX25519Key, typ keys.KeyType, path string) (*api.Key, error) {
+	var key keys.Key
+	var err error
+	switch typ {
+	case keys.EdX25519:
+		key, err = DeriveEdX25519Key(root, path)
+	case keys.X25519:
+		key, err = DeriveX25519Key(root, path)
+	default:
+		return nil, errors.Errorf("unsupported key type for derivation %s", typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := api.NewKey(key)
+	out.SetExtString(rootExt, root.ID().String())
+	out.SetExtString(pathExt, path)
+	return out, nil
+}
+
+// DerivedFrom returns the root key ID and path if the key was derived, or
+// empty values if not.
+func DerivedFrom(key *api.Key) (keys.ID, string) {
+	return keys.ID(key.ExtString(rootExt)), key.ExtString(pathExt)
+}
+
+func deriveSeed(root *keys.EdX25519Key, path string, info string) (*[32]byte, error) {
+	if root == nil {
+		return nil, errors.Errorf("no root key")
+	}
+	segments, err := parseDerivePath(path)
+	if err != nil {
+		return nil, err
+	}
+	seed := root.Seed()[:]
+	for _, segment := range segments {
+		seed = keys.HKDFSHA256(seed, 32, nil, []byte("keys.pub/derive/path/"+segment))
+	}
+	return keys.Bytes32(keys.HKDFSHA256(seed, 32, nil, []byte(info))), nil
+}
+
+func parseDerivePath(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.Errorf("no derivation path")
+	}
+	segments := strings.Split(path, "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, errors.Errorf("invalid derivation path %q", path)
+		}
+	}
+	return segments, nil
+}
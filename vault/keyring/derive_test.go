@@ -0,0 +1,53 @@
+package keyring_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerive(t *testing.T) {
+	root := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	sk, err := keyring.DeriveEdX25519Key(root, "backend/signing")
+	require.NoError(t, err)
+	sk2, err := keyring.DeriveEdX25519Key(root, "backend/signing")
+	require.NoError(t, err)
+	require.Equal(t, sk.ID(), sk2.ID())
+	require.NotEqual(t, root.ID(), sk.ID())
+
+	sk3, err := keyring.DeriveEdX25519Key(root, "backend/deploy")
+	require.NoError(t, err)
+	require.NotEqual(t, sk.ID(), sk3.ID())
+
+	// Path segments aren't concatenated
+	sk4, err := keyring.DeriveEdX25519Key(root, "backendsigning")
+	require.NoError(t, err)
+	require.NotEqual(t, sk.ID(), sk4.ID())
+
+	bk, err := keyring.DeriveX25519Key(root, "backend/signing")
+	require.NoError(t, err)
+	require.NotEqual(t, sk.X25519Key().ID(), bk.ID())
+
+	other := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	sk5, err := keyring.DeriveEdX25519Key(other, "backend/signing")
+	require.NoError(t, err)
+	require.NotEqual(t, sk.ID(), sk5.ID())
+
+	key, err := keyring.DeriveKey(root, keys.X25519, "backend/signing")
+	require.NoError(t, err)
+	require.Equal(t, bk.ID(), key.ID)
+	rid, path := keyring.DerivedFrom(key)
+	require.Equal(t, root.ID(), rid)
+	require.Equal(t, "backend/signing", path)
+
+	_, err = keyring.DeriveEdX25519Key(root, "")
+	require.EqualError(t, err, "no derivation path")
+	_, err = keyring.DeriveEdX25519Key(root, "backend//signing")
+	require.EqualError(t, err, `invalid derivation path "backend//signing"`)
+	_, err = keyring.DeriveKey(root, keys.RSA, "backend")
+	require.EqualError(t, err, "unsupported key type for derivation rsa")
+}
@@ -0,0 +1,182 @@
+package keyring
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+)
+
+// ECDSA key type (NIST P-256, P-384, P-521), as used by SSH.
+const ECDSA keys.KeyType = "ecdsa"
+const ecdsaKeyHRP = "ecdsa"
+
+// ECDSAPublicKey is the public part of an ECDSA key pair.
+type ECDSAPublicKey struct {
+	id keys.ID
+	pk *ecdsa.PublicKey
+}
+
+// ECDSAKey implements keys.Key interface for ECDSA.
+type ECDSAKey struct {
+	privateKey *ecdsa.PrivateKey
+	publicKey  *ECDSAPublicKey
+}
+
+var _ keys.Key = &ECDSAKey{}
+var _ keys.Key = &ECDSAPublicKey{}
+
+// NewECDSAKey from ecdsa.PrivateKey.
+func NewECDSAKey(k *ecdsa.PrivateKey) *ECDSAKey {
+	pk := NewECDSAPublicKey(&k.PublicKey)
+	return &ECDSAKey{k, pk}
+}
+
+// NewECDSAKeyFromBytes constructs ECDSA key from a private key (SEC1, ASN.1 DER).
+func NewECDSAKeyFromBytes(privateKey []byte) (*ECDSAKey, error) {
+	k, err := x509.ParseECPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewECDSAKey(k), nil
+}
+
+// GenerateECDSAKey generates an ECDSA key (P-256).
+func GenerateECDSAKey() *ECDSAKey {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return NewECDSAKey(priv)
+}
+
+// PublicKey ...
+func (k *ECDSAKey) PublicKey() *ECDSAPublicKey {
+	return k.publicKey
+}
+
+// ID for the key.
+func (k *ECDSAKey) ID() keys.ID {
+	return k.publicKey.ID()
+}
+
+// Type of key.
+func (k *ECDSAKey) Type() keys.KeyType {
+	return ECDSA
+}
+
+// Private key data (SEC1, ASN.1 DER).
+func (k *ECDSAKey) Private() []byte {
+	b, err := x509.MarshalECPrivateKey(k.privateKey)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Public key data (PKIX, ASN.1 DER).
+func (k *ECDSAKey) Public() []byte {
+	return k.publicKey.Public()
+}
+
+// Signer returns crypto.Signer.
+func (k *ECDSAKey) Signer() crypto.Signer {
+	return k.privateKey
+}
+
+// Sign the digest of b, returning an ASN.1 encoded signature.
+// The digest is SHA256, SHA384 or SHA512 for P-256, P-384 or P-521 keys.
+func (k *ECDSAKey) Sign(b []byte) []byte {
+	sig, err := ecdsa.SignASN1(rand.Reader, k.privateKey, ecdsaDigest(k.privateKey.Curve, b))
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+// ecdsaDigest returns the digest of b for the curve (as in RFC 5656).
+func ecdsaDigest(curve elliptic.Curve, b []byte) []byte {
+	switch bits := curve.Params().BitSize; {
+	case bits <= 256:
+		h := sha256.Sum256(b)
+		return h[:]
+	case bits <= 384:
+		h := sha512.Sum384(b)
+		return h[:]
+	default:
+		h := sha512.Sum512(b)
+		return h[:]
+	}
+}
+
+// NewECDSAPublicKey returns ECDSA public key.
+func NewECDSAPublicKey(pk *ecdsa.PublicKey) *ECDSAPublicKey {
+	return &ECDSAPublicKey{keyIDFromECDSA(pk), pk}
+}
+
+// NewECDSAPublicKeyFromBytes returns ECDSA public key from PKIX bytes.
+func NewECDSAPublicKeyFromBytes(publicKey []byte) (*ECDSAPublicKey, error) {
+	k, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	pk, ok := k.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("not an ecdsa public key")
+	}
+	return NewECDSAPublicKey(pk), nil
+}
+
+func keyIDFromECDSA(pk *ecdsa.PublicKey) keys.ID {
+	// SHA256 of PKIX public key
+	b, err := x509.MarshalPKIXPublicKey(pk)
+	if err != nil {
+		panic(err)
+	}
+	h := sha256.Sum256(b)
+	return keys.MustID(ecdsaKeyHRP, h[:])
+}
+
+// ID is key identifier.
+func (k *ECDSAPublicKey) ID() keys.ID {
+	return k.id
+}
+
+// Type of key.
+func (k *ECDSAPublicKey) Type() keys.KeyType {
+	return ECDSA
+}
+
+// Bytes for public key (PKIX, ASN.1 DER).
+func (k *ECDSAPublicKey) Bytes() []byte {
+	b, err := x509.MarshalPKIXPublicKey(k.pk)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Public key data.
+func (k *ECDSAPublicKey) Public() []byte {
+	return k.Bytes()
+}
+
+// Private returns nil.
+func (k *ECDSAPublicKey) Private() []byte {
+	return nil
+}
+
+// Verify an ASN.1 encoded signature of the digest of b.
+// The digest is SHA256, SHA384 or SHA512 for P-256, P-384 or P-521 keys.
+func (k *ECDSAPublicKey) Verify(b []byte, sig []byte) error {
+	if !ecdsa.VerifyASN1(k.pk, ecdsaDigest(k.pk.Curve, b), sig) {
+		return errors.Errorf("verify failed")
+	}
+	return nil
+}
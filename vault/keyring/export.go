@@ -0,0 +1,220 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/bech32"
+	"github.com/pkg/errors"
+)
+
+// JWK is a JSON Web Key (RFC 8037 OKP) for Ed25519 or X25519.
+type JWK struct {
+	KID string `json:"kid,omitempty"`
+	KTY string `json:"kty"`
+	CRV string `json:"crv"`
+	X   string `json:"x"`
+	D   string `json:"d,omitempty"`
+}
+
+// EncodeJWK encodes an EdX25519 or X25519 (public or private) key as JWK.
+func EncodeJWK(key keys.Key) (string, error) {
+	jwk := &JWK{KID: key.ID().String(), KTY: "OKP"}
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		jwk.CRV = "Ed25519"
+		jwk.X = encodeJWKBytes(k.PublicKey().Bytes())
+		jwk.D = encodeJWKBytes(k.Seed()[:])
+	case *keys.EdX25519PublicKey:
+		jwk.CRV = "Ed25519"
+		jwk.X = encodeJWKBytes(k.Bytes())
+	case *keys.X25519Key:
+		jwk.CRV = "X25519"
+		jwk.X = encodeJWKBytes(k.PublicKey().Bytes())
+		jwk.D = encodeJWKBytes(k.PrivateKey()[:])
+	case *keys.X25519PublicKey:
+		jwk.CRV = "X25519"
+		jwk.X = encodeJWKBytes(k.Bytes())
+	default:
+		return "", errors.Errorf("unsupported key type for jwk")
+	}
+	b, err := json.MarshalIndent(jwk, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ParseJWK parses an (OKP) JSON Web Key.
+func ParseJWK(b []byte) (*api.Key, error) {
+	var jwk JWK
+	if err := json.Unmarshal(b, &jwk); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse jwk")
+	}
+	if jwk.KTY != "OKP" {
+		return nil, errors.Errorf("unsupported jwk kty %s", jwk.KTY)
+	}
+	d, err := decodeJWKBytes(jwk.D)
+	if err != nil {
+		return nil, err
+	}
+	x, err := decodeJWKBytes(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+	if len(d) != 0 && len(d) != 32 || len(x) != 32 {
+		return nil, errors.Errorf("invalid jwk key length")
+	}
+
+	var key keys.Key
+	switch jwk.CRV {
+	case "Ed25519":
+		if len(d) > 0 {
+			key = keys.NewEdX25519KeyFromSeed(keys.Bytes32(d))
+		} else {
+			key = keys.NewEdX25519PublicKey(keys.Bytes32(x))
+		}
+	case "X25519":
+		if len(d) > 0 {
+			key = keys.NewX25519KeyFromPrivateKey(keys.Bytes32(d))
+		} else {
+			key = keys.NewX25519PublicKey(keys.Bytes32(x))
+		}
+	default:
+		return nil, errors.Errorf("unsupported jwk crv %s", jwk.CRV)
+	}
+	return api.NewKey(key), nil
+}
+
+func encodeJWKBytes(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeJWKBytes(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid jwk encoding")
+	}
+	return b, nil
+}
+
+// EncodePEMKey encodes a private key as PEM (PKCS#8) or a public key as PEM
+// (PKIX). Supports EdX25519, X25519, RSA, ECDSA and secp256k1 keys.
+// If password is specified, the private key is encrypted (PKCS#8 PBES2).
+func EncodePEMKey(key keys.Key, password string) (string, error) {
+	var typ string
+	var b []byte
+	var err error
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		typ = "PRIVATE KEY"
+		b, err = x509.MarshalPKCS8PrivateKey(ed25519.NewKeyFromSeed(k.Seed()[:]))
+	case *keys.X25519Key:
+		typ = "PRIVATE KEY"
+		b, err = marshalX25519PKCS8(k)
+	case *keys.RSAKey:
+		rk, rerr := rsaPrivateKey(k)
+		if rerr != nil {
+			return "", rerr
+		}
+		typ = "PRIVATE KEY"
+		b, err = x509.MarshalPKCS8PrivateKey(rk)
+	case *ECDSAKey:
+		typ = "PRIVATE KEY"
+		b, err = x509.MarshalPKCS8PrivateKey(k.privateKey)
+	case *Secp256k1Key:
+		typ = "PRIVATE KEY"
+		b, err = k.MarshalPKCS8()
+	case *keys.EdX25519PublicKey:
+		typ = "PUBLIC KEY"
+		b, err = x509.MarshalPKIXPublicKey(ed25519.PublicKey(k.Bytes()))
+	case *keys.X25519PublicKey:
+		typ = "PUBLIC KEY"
+		b, err = asn1.Marshal(pkixPublicKey{
+			Algo:      pkix.AlgorithmIdentifier{Algorithm: oidX25519},
+			PublicKey: asn1.BitString{Bytes: k.Bytes(), BitLength: 8 * len(k.Bytes())},
+		})
+	case *Secp256k1PublicKey:
+		typ = "PUBLIC KEY"
+		b, err = k.MarshalPKIX()
+	case *keys.RSAPublicKey:
+		pk, perr := x509.ParsePKCS1PublicKey(k.Bytes())
+		if perr != nil {
+			return "", perr
+		}
+		typ = "PUBLIC KEY"
+		b, err = x509.MarshalPKIXPublicKey(pk)
+	case *ECDSAPublicKey:
+		typ = "PUBLIC KEY"
+		b = k.Bytes()
+	default:
+		return "", errors.Errorf("unsupported key type for pem")
+	}
+	if err != nil {
+		return "", err
+	}
+	if password != "" {
+		if typ != "PRIVATE KEY" {
+			return "", errors.Errorf("password not supported when exporting public key")
+		}
+		typ = "ENCRYPTED PRIVATE KEY"
+		b, err = encryptPKCS8(b, password)
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: b})), nil
+}
+
+func marshalX25519PKCS8(k *keys.X25519Key) ([]byte, error) {
+	priv, err := asn1.Marshal(k.PrivateKey()[:])
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo:       pkix.AlgorithmIdentifier{Algorithm: oidX25519},
+		PrivateKey: priv,
+	})
+}
+
+// EncodeAgeKey encodes a key as an age identity (private) or recipient
+// (public). EdX25519 keys are converted to X25519.
+// If password is specified, the identity is encrypted (as with age -p -a).
+func EncodeAgeKey(key keys.Key, password string) (string, error) {
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		return EncodeAgeKey(k.X25519Key(), password)
+	case *keys.EdX25519PublicKey:
+		return EncodeAgeKey(k.X25519PublicKey(), password)
+	case *keys.X25519Key:
+		recipient, err := bech32.Encode(ageRecipientHRP, k.PublicKey().Bytes())
+		if err != nil {
+			return "", err
+		}
+		identity, err := bech32.Encode(strings.ToLower(ageSecretKeyHRP), k.PrivateKey()[:])
+		if err != nil {
+			return "", err
+		}
+		out := fmt.Sprintf("# public key: %s\n%s\n", recipient, strings.ToUpper(identity))
+		if password != "" {
+			return ageEncryptScrypt([]byte(out), password)
+		}
+		return out, nil
+	case *keys.X25519PublicKey:
+		if password != "" {
+			return "", errors.Errorf("password not supported when exporting public key")
+		}
+		return bech32.Encode(ageRecipientHRP, k.Bytes())
+	default:
+		return "", errors.Errorf("unsupported key type for age")
+	}
+}
@@ -0,0 +1,290 @@
+package keyring
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/bech32"
+	"github.com/pkg/errors"
+)
+
+// KeyFormat is a detected key (import) format.
+type KeyFormat string
+
+// Key formats.
+const (
+	// SaltpackFormat is the (armored) api.EncodeKey format.
+	SaltpackFormat KeyFormat = "saltpack"
+	// IDFormat is a key ID (public key).
+	IDFormat KeyFormat = "id"
+	// SSHFormat is an OpenSSH private key.
+	SSHFormat KeyFormat = "ssh"
+	// SSHPublicFormat is an SSH public key (authorized_keys format).
+	SSHPublicFormat KeyFormat = "ssh-public"
+	// PEMFormat is a (classic) PEM private key, PKCS#1 (RSA) or SEC1 (EC,
+	// including secp256k1).
+	PEMFormat KeyFormat = "pem"
+	// PKCS8Format is a PEM PKCS#8 private key (or encrypted private key).
+	PKCS8Format KeyFormat = "pkcs8"
+	// PKIXFormat is a PEM PKIX public key.
+	PKIXFormat KeyFormat = "pkix"
+	// AgeFormat is an age identity (AGE-SECRET-KEY-1...), a password encrypted
+	// (armored) identity, or recipient (age1...).
+	AgeFormat KeyFormat = "age"
+	// PaperFormat is a paper backup (see EncodePaperKey).
+	PaperFormat KeyFormat = "paper"
+	// JWKFormat is a JSON Web Key (see EncodeJWK).
+	JWKFormat KeyFormat = "jwk"
+)
+
+// ASN.1 object identifiers from RFC 8410.
+var (
+	oidX25519  = asn1.ObjectIdentifier{1, 3, 101, 110}
+	oidEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+const (
+	ageSecretKeyHRP = "AGE-SECRET-KEY-"
+	ageRecipientHRP = "age"
+)
+
+// DetectKey determines the key format and parses the key bytes.
+func DetectKey(b []byte, password string) (*api.Key, KeyFormat, error) {
+	s := strings.TrimSpace(string(b))
+
+	if IsPaperKey(s) {
+		if password != "" {
+			return nil, "", errors.Errorf("password unsupported for paper key")
+		}
+		key, err := ParsePaperKey(s)
+		return key, PaperFormat, err
+	}
+
+	if isAge(s) {
+		if password != "" {
+			return nil, "", errors.Errorf("password unsupported for age key")
+		}
+		key, err := ParseAgeKey(s)
+		return key, AgeFormat, err
+	}
+
+	if strings.HasPrefix(s, "{") {
+		if password != "" {
+			return nil, "", errors.Errorf("password unsupported for jwk")
+		}
+		key, err := ParseJWK([]byte(s))
+		return key, JWKFormat, err
+	}
+
+	if strings.HasPrefix(s, "ssh-") || strings.HasPrefix(s, "ecdsa-sha2-") {
+		if password != "" {
+			return nil, "", errors.Errorf("password unsupported for ssh public key")
+		}
+		out, err := ParseSSHPublicKey(s)
+		if err != nil {
+			return nil, "", err
+		}
+		return api.NewKey(out), SSHPublicFormat, nil
+	}
+
+	if strings.HasPrefix(s, "-----BEGIN ") {
+		return parsePEM([]byte(s), password)
+	}
+
+	if kid, err := keys.ParseID(s); err == nil {
+		return api.NewKey(kid), IDFormat, nil
+	}
+
+	key, err := api.DecodeKey(s, password)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, SaltpackFormat, nil
+}
+
+func parsePEM(b []byte, password string) (*api.Key, KeyFormat, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, "", errors.Errorf("failed to decode pem")
+	}
+	switch block.Type {
+	case "OPENSSH PRIVATE KEY":
+		out, err := ParseSSHKey(b, password)
+		if err != nil {
+			return nil, "", err
+		}
+		return api.NewKey(out), SSHFormat, nil
+	case "PRIVATE KEY":
+		out, err := parsePKCS8(block.Bytes)
+		if err != nil {
+			return nil, "", err
+		}
+		if out == nil {
+			// Not Ed25519 or X25519, try RSA or ECDSA.
+			k, err := ParseSSHKey(b, password)
+			if err != nil {
+				return nil, "", err
+			}
+			out = k
+		}
+		return api.NewKey(out), PKCS8Format, nil
+	case "ENCRYPTED PRIVATE KEY":
+		if password == "" {
+			return nil, "", errors.Errorf("password required for encrypted pem")
+		}
+		der, err := decryptPKCS8(block.Bytes, password)
+		if err != nil {
+			return nil, "", err
+		}
+		return parsePEM(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), "")
+	case ageArmorType:
+		if password == "" {
+			return nil, "", errors.Errorf("password required for encrypted age key")
+		}
+		b, err := ageDecryptScrypt(block.Bytes, password)
+		if err != nil {
+			return nil, "", err
+		}
+		key, err := ParseAgeKey(string(b))
+		return key, AgeFormat, err
+	case "PUBLIC KEY":
+		out, err := parsePKIX(block.Bytes)
+		if err != nil {
+			return nil, "", err
+		}
+		return api.NewKey(out), PKIXFormat, nil
+	case "EC PRIVATE KEY":
+		if _, encrypted := block.Headers["DEK-Info"]; !encrypted {
+			sk, err := parseSecp256k1SEC1(block.Bytes, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			if sk != nil {
+				if password != "" {
+					return nil, "", errors.Errorf("password unsupported for unencrypted pem")
+				}
+				return api.NewKey(sk), PEMFormat, nil
+			}
+		}
+		out, err := ParseSSHKey(b, password)
+		if err != nil {
+			return nil, "", err
+		}
+		return api.NewKey(out), PEMFormat, nil
+	case "RSA PRIVATE KEY":
+		out, err := ParseSSHKey(b, password)
+		if err != nil {
+			return nil, "", err
+		}
+		return api.NewKey(out), PEMFormat, nil
+	default:
+		return nil, "", errors.Errorf("unsupported pem type %s", block.Type)
+	}
+}
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+	Attributes asn1.RawValue  `asn1:"optional,tag:0"`
+	PublicKey  asn1.BitString `asn1:"optional,tag:1"`
+}
+
+// parsePKCS8 parses an Ed25519, X25519 or secp256k1 PKCS#8 private key,
+// returns nil if not one of these algorithms.
+func parsePKCS8(b []byte) (keys.Key, error) {
+	var pk pkcs8
+	if _, err := asn1.Unmarshal(b, &pk); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pkcs8")
+	}
+	if isSecp256k1Algorithm(pk.Algo) {
+		return parseSecp256k1SEC1(pk.PrivateKey, oidSecp256k1)
+	}
+	if !pk.Algo.Algorithm.Equal(oidEd25519) && !pk.Algo.Algorithm.Equal(oidX25519) {
+		return nil, nil
+	}
+	// The private key is an OCTET STRING (CurvePrivateKey) in an OCTET STRING.
+	var priv []byte
+	if _, err := asn1.Unmarshal(pk.PrivateKey, &priv); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pkcs8 private key")
+	}
+	if len(priv) != 32 {
+		return nil, errors.Errorf("invalid pkcs8 private key length")
+	}
+	if pk.Algo.Algorithm.Equal(oidEd25519) {
+		return keys.NewEdX25519KeyFromSeed(keys.Bytes32(priv)), nil
+	}
+	return keys.NewX25519KeyFromPrivateKey(keys.Bytes32(priv)), nil
+}
+
+type pkixPublicKey struct {
+	Algo      pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// parsePKIX parses an Ed25519, X25519, RSA, ECDSA or secp256k1 PKIX public
+// key.
+func parsePKIX(b []byte) (keys.Key, error) {
+	var pk pkixPublicKey
+	if _, err := asn1.Unmarshal(b, &pk); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pkix public key")
+	}
+	switch {
+	case pk.Algo.Algorithm.Equal(oidEd25519):
+		if len(pk.PublicKey.Bytes) != 32 {
+			return nil, errors.Errorf("invalid ed25519 public key length")
+		}
+		return keys.NewEdX25519PublicKey(keys.Bytes32(pk.PublicKey.Bytes)), nil
+	case pk.Algo.Algorithm.Equal(oidX25519):
+		if len(pk.PublicKey.Bytes) != 32 {
+			return nil, errors.Errorf("invalid x25519 public key length")
+		}
+		return keys.NewX25519PublicKey(keys.Bytes32(pk.PublicKey.Bytes)), nil
+	case isSecp256k1Algorithm(pk.Algo):
+		return NewSecp256k1PublicKeyFromBytes(pk.PublicKey.Bytes)
+	default:
+		return NewECDSAPublicKeyFromBytes(b)
+	}
+}
+
+func isAge(s string) bool {
+	return strings.Contains(s, ageSecretKeyHRP+"1") || strings.HasPrefix(s, ageRecipientHRP+"1")
+}
+
+// ParseAgeKey parses an age identity (AGE-SECRET-KEY-1...), or identity file,
+// or an age recipient (age1...) as a X25519 key.
+func ParseAgeKey(s string) (*api.Key, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, ageRecipientHRP+"1") {
+		hrp, b, err := bech32.Decode(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse age recipient")
+		}
+		if hrp != ageRecipientHRP || len(b) != 32 {
+			return nil, errors.Errorf("invalid age recipient")
+		}
+		return api.NewKey(keys.NewX25519PublicKey(keys.Bytes32(b))), nil
+	}
+
+	// Identity files can have comments and multiple identities, we import the
+	// first identity.
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hrp, b, err := bech32.Decode(strings.ToLower(line))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse age identity")
+		}
+		if hrp != strings.ToLower(ageSecretKeyHRP) || len(b) != 32 {
+			return nil, errors.Errorf("invalid age identity")
+		}
+		return api.NewKey(keys.NewX25519KeyFromPrivateKey(keys.Bytes32(b))), nil
+	}
+	return nil, errors.Errorf("no age identity found")
+}
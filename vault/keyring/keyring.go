@@ -114,8 +114,9 @@ func (v *Keyring) List() ([]*api.Key, error) {
 }
 
 // ImportKey imports key into the vault.
+// The key format is detected, see DetectKey.
 func (v *Keyring) ImportKey(msg string, password string) (*api.Key, error) {
-	key, err := api.DecodeKey(msg, password)
+	key, err := ParseKey([]byte(msg), password)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +176,57 @@ func (v *Keyring) X25519Keys() ([]*keys.X25519Key, error) {
 	return out, nil
 }
 
+// RSAKeys from the vault.
+func (v *Keyring) RSAKeys() ([]*keys.RSAKey, error) {
+	ks, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*keys.RSAKey, 0, len(ks))
+	for _, key := range ks {
+		rk := AsRSA(key)
+		if rk == nil {
+			continue
+		}
+		out = append(out, rk)
+	}
+	return out, nil
+}
+
+// ECDSAKeys from the vault.
+func (v *Keyring) ECDSAKeys() ([]*ECDSAKey, error) {
+	ks, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ECDSAKey, 0, len(ks))
+	for _, key := range ks {
+		ek := AsECDSA(key)
+		if ek == nil {
+			continue
+		}
+		out = append(out, ek)
+	}
+	return out, nil
+}
+
+// Secp256k1Keys from the vault.
+func (v *Keyring) Secp256k1Keys() ([]*Secp256k1Key, error) {
+	ks, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Secp256k1Key, 0, len(ks))
+	for _, key := range ks {
+		sk := AsSecp256k1(key)
+		if sk == nil {
+			continue
+		}
+		out = append(out, sk)
+	}
+	return out, nil
+}
+
 // FindEdX25519PublicKey searches all our EdX25519 public keys for a match to a converted
 // X25519 public key.
 func (v *Keyring) FindEdX25519PublicKey(kid keys.ID) (*keys.EdX25519PublicKey, error) {
@@ -0,0 +1,169 @@
+package keyring
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/pkg/errors"
+)
+
+// Key metadata is stored in the api.Key, tags as Labels, notes as Notes, and
+// label and expiry as Ext fields.
+const (
+	labelExt    = "label"
+	expiresExt  = "expires"
+	sshAgentExt = "ssh-agent"
+)
+
+// SSHAgentPolicy is whether the ssh agent can use a key.
+type SSHAgentPolicy string
+
+const (
+	// SSHAgentAllow (default) lets the ssh agent list and sign with the key.
+	SSHAgentAllow SSHAgentPolicy = ""
+	// SSHAgentConfirm requires confirmation for each ssh agent signature.
+	SSHAgentConfirm SSHAgentPolicy = "confirm"
+	// SSHAgentDeny hides the key from the ssh agent.
+	SSHAgentDeny SSHAgentPolicy = "deny"
+)
+
+// Metadata for a key.
+type Metadata struct {
+	// Label is a short description of what the key is for.
+	Label string
+	// Notes for the key.
+	Notes string
+	// Tags for the key.
+	Tags []string
+	// ExpiresAt (optional), zero if key doesn't expire.
+	ExpiresAt time.Time
+	// SSHAgent policy for the key.
+	SSHAgent SSHAgentPolicy
+}
+
+// MetadataForKey returns metadata from key.
+func MetadataForKey(key *api.Key) *Metadata {
+	return &Metadata{
+		Label:     key.ExtString(labelExt),
+		Notes:     key.Notes,
+		Tags:      append([]string{}, key.Labels...),
+		ExpiresAt: ExpiresAt(key),
+		SSHAgent:  SSHAgentPolicy(key.ExtString(sshAgentExt)),
+	}
+}
+
+// ExpiresAt returns key expiry, or zero time if not set.
+func ExpiresAt(key *api.Key) time.Time {
+	s := key.ExtString(expiresExt)
+	if s == "" {
+		return time.Time{}
+	}
+	ts, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return tsutil.ParseMillis(ts)
+}
+
+// IsExpired returns true if key has an expiry before now.
+func IsExpired(key *api.Key, now time.Time) bool {
+	exp := ExpiresAt(key)
+	return !exp.IsZero() && !exp.After(now)
+}
+
+// SetMetadata sets metadata on the key.
+func SetMetadata(key *api.Key, md *Metadata) {
+	setExtString(key, labelExt, strings.TrimSpace(md.Label))
+	key.Notes = md.Notes
+	key.Labels = nil
+	for _, tag := range md.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		key.WithLabels(tag)
+	}
+	if md.ExpiresAt.IsZero() {
+		setExtString(key, expiresExt, "")
+	} else {
+		setExtString(key, expiresExt, strconv.FormatInt(tsutil.Millis(md.ExpiresAt), 10))
+	}
+	setExtString(key, sshAgentExt, string(md.SSHAgent))
+}
+
+// setExtString sets an Ext value, removing it if empty.
+func setExtString(key *api.Key, name string, value string) {
+	if value == "" {
+		delete(key.Ext, name)
+		if len(key.Ext) == 0 {
+			key.Ext = nil
+		}
+		return
+	}
+	key.SetExtString(name, value)
+}
+
+// MatchQuery returns true if the query matches the key label, notes or tags.
+// Matching is case insensitive.
+func (m *Metadata) MatchQuery(query string) bool {
+	query = strings.ToLower(query)
+	if query == "" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(m.Label), query) {
+		return true
+	}
+	if strings.Contains(strings.ToLower(m.Notes), query) {
+		return true
+	}
+	for _, tag := range m.Tags {
+		if strings.ToLower(tag) == query {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveMetadata updates the metadata for a key in the vault.
+func (v *Keyring) SaveMetadata(kid keys.ID, md *Metadata) (*api.Key, error) {
+	key, err := v.Get(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, keys.NewErrNotFound(kid.String())
+	}
+	if md == nil {
+		return nil, errors.Errorf("no metadata")
+	}
+	SetMetadata(key, md)
+	key.Updated(tsutil.Millis(v.Now()))
+	if err := v.Save(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Expiring returns keys with an expiry before now+within (including keys that
+// already expired).
+func (v *Keyring) Expiring(now time.Time, within time.Duration) ([]*api.Key, error) {
+	ks, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	out := []*api.Key{}
+	for _, key := range ks {
+		exp := ExpiresAt(key)
+		if exp.IsZero() {
+			continue
+		}
+		if exp.Before(now.Add(within)) {
+			out = append(out, key)
+		}
+	}
+	return out, nil
+}
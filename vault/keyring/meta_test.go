@@ -0,0 +1,73 @@
+package keyring_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadata(t *testing.T) {
+	clock := tsutil.NewTestClock()
+	vlt, closeFn := NewTestVault(t, &TestVaultOptions{Unlock: true, Clock: clock})
+	defer closeFn()
+	kr := keyring.New(vlt)
+
+	sk := keys.GenerateEdX25519Key()
+	err := kr.Save(api.NewKey(sk))
+	require.NoError(t, err)
+	sk2 := keys.GenerateEdX25519Key()
+	err = kr.Save(api.NewKey(sk2))
+	require.NoError(t, err)
+
+	exp := clock.Now().Add(time.Hour * 24)
+	_, err = kr.SaveMetadata(sk.ID(), &keyring.Metadata{
+		Label:     "Release signing",
+		Notes:     "Backed up in safe",
+		Tags:      []string{"release", " ", "ci"},
+		ExpiresAt: exp,
+		SSHAgent:  keyring.SSHAgentConfirm,
+	})
+	require.NoError(t, err)
+
+	key, err := kr.Get(sk.ID())
+	require.NoError(t, err)
+	md := keyring.MetadataForKey(key)
+	require.Equal(t, "Release signing", md.Label)
+	require.Equal(t, "Backed up in safe", md.Notes)
+	require.Equal(t, []string{"release", "ci"}, md.Tags)
+	require.Equal(t, tsutil.Millis(exp), tsutil.Millis(md.ExpiresAt))
+	require.Equal(t, keyring.SSHAgentConfirm, md.SSHAgent)
+	require.NotNil(t, key.AsEdX25519())
+
+	require.True(t, md.MatchQuery("signing"))
+	require.True(t, md.MatchQuery("SAFE"))
+	require.True(t, md.MatchQuery("ci"))
+	require.False(t, md.MatchQuery("deploy"))
+
+	expiring, err := kr.Expiring(clock.Now(), time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(expiring))
+	expiring, err = kr.Expiring(clock.Now(), time.Hour*48)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(expiring))
+	require.Equal(t, sk.ID(), expiring[0].ID)
+	require.False(t, keyring.IsExpired(key, clock.Now()))
+	require.True(t, keyring.IsExpired(key, exp))
+
+	// Clear
+	_, err = kr.SaveMetadata(sk.ID(), &keyring.Metadata{})
+	require.NoError(t, err)
+	key, err = kr.Get(sk.ID())
+	require.NoError(t, err)
+	md = keyring.MetadataForKey(key)
+	require.Equal(t, &keyring.Metadata{Tags: []string{}}, md)
+	require.Empty(t, key.Ext)
+
+	_, err = kr.SaveMetadata(keys.RandID("kex"), &keyring.Metadata{})
+	require.Error(t, err)
+}
@@ -0,0 +1,139 @@
+package keyring
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/pkg/errors"
+)
+
+// paperHeader is the first line of a paper backup.
+const paperHeader = "keys.pub paper backup"
+
+// EncodePaperKey encodes an EdX25519 or X25519 private key as a printable
+// paper backup, with the key seed as a (24 word) BIP39 phrase and a checksum.
+func EncodePaperKey(key keys.Key) (string, error) {
+	seed, err := paperSeed(key)
+	if err != nil {
+		return "", err
+	}
+	phrase, err := encoding.BytesToPhrase(seed[:])
+	if err != nil {
+		return "", err
+	}
+	words := strings.Fields(phrase)
+
+	var sb strings.Builder
+	sb.WriteString(paperHeader + "\n\n")
+	sb.WriteString(fmt.Sprintf("Type: %s\n", key.Type()))
+	sb.WriteString(fmt.Sprintf("ID: %s\n\n", key.ID()))
+	for i := 0; i < len(words); i += 4 {
+		row := []string{}
+		for j := i; j < i+4 && j < len(words); j++ {
+			row = append(row, fmt.Sprintf("%2d. %-10s", j+1, words[j]))
+		}
+		sb.WriteString(strings.TrimRight(strings.Join(row, " "), " ") + "\n")
+	}
+	sb.WriteString(fmt.Sprintf("\nChecksum: %s\n", paperChecksum(key.Type(), seed)))
+	return sb.String(), nil
+}
+
+// IsPaperKey returns true if the input looks like a paper backup or a BIP39
+// phrase.
+func IsPaperKey(s string) bool {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, paperHeader) {
+		return true
+	}
+	return encoding.IsValidPhrase(s, true)
+}
+
+// ParsePaperKey parses a paper backup (from EncodePaperKey).
+// The input can also be the BIP39 phrase only, in which case the key type
+// is assumed to be EdX25519.
+func ParsePaperKey(s string) (*api.Key, error) {
+	typ := keys.EdX25519
+	var id keys.ID
+	var checksum string
+	words := []string{}
+
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line == paperHeader:
+			continue
+		case strings.HasPrefix(line, "Type:"):
+			typ = keys.KeyType(strings.TrimSpace(strings.TrimPrefix(line, "Type:")))
+		case strings.HasPrefix(line, "ID:"):
+			id = keys.ID(strings.TrimSpace(strings.TrimPrefix(line, "ID:")))
+		case strings.HasPrefix(line, "Checksum:"):
+			checksum = strings.TrimSpace(strings.TrimPrefix(line, "Checksum:"))
+		default:
+			for _, f := range strings.Fields(line) {
+				// Skip word numbers (for example "1.")
+				if _, err := strconv.Atoi(strings.TrimSuffix(f, ".")); err == nil {
+					continue
+				}
+				words = append(words, f)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	seed, err := encoding.PhraseToBytes(strings.Join(words, " "), true)
+	if err != nil {
+		return nil, err
+	}
+	if checksum != "" {
+		expected := paperChecksum(typ, seed)
+		if subtle.ConstantTimeCompare([]byte(strings.ToUpper(checksum)), []byte(expected)) != 1 {
+			return nil, errors.Errorf("paper key checksum mismatch")
+		}
+	}
+
+	var key keys.Key
+	switch typ {
+	case keys.EdX25519:
+		key = keys.NewEdX25519KeyFromSeed(seed)
+	case keys.X25519:
+		key = keys.NewX25519KeyFromPrivateKey(seed)
+	default:
+		return nil, errors.Errorf("unsupported paper key type %s", typ)
+	}
+	if id != "" && id != key.ID() {
+		return nil, errors.Errorf("paper key ID mismatch")
+	}
+	return api.NewKey(key), nil
+}
+
+func paperSeed(key keys.Key) (*[32]byte, error) {
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		return k.Seed(), nil
+	case *keys.X25519Key:
+		return k.PrivateKey(), nil
+	default:
+		return nil, errors.Errorf("unsupported key type for paper export")
+	}
+}
+
+// paperChecksum is the first 4 bytes of SHA256(type, seed), as (upper case)
+// hex with a separator, for example "1A2B-3C4D".
+func paperChecksum(typ keys.KeyType, seed *[32]byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(typ))
+	_, _ = h.Write(seed[:])
+	s := strings.ToUpper(hex.EncodeToString(h.Sum(nil)[:4]))
+	return s[:4] + "-" + s[4:]
+}
@@ -0,0 +1,67 @@
+package keyring_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaperKey(t *testing.T) {
+	sk := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+
+	out, err := keyring.EncodePaperKey(sk)
+	require.NoError(t, err)
+	expected := `keys.pub paper backup
+
+Type: edx25519
+ID: kex132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqqph077
+
+ 1. absurd      2. amount      3. doctor      4. acoustic
+ 5. avoid       6. letter      7. advice      8. cage
+ 9. absurd     10. amount     11. doctor     12. acoustic
+13. avoid      14. letter     15. advice     16. cage
+17. absurd     18. amount     19. doctor     20. acoustic
+21. avoid      22. letter     23. advice     24. comic
+
+Checksum: BB35-6E64
+`
+	require.Equal(t, expected, out)
+
+	key, err := keyring.ParseKey([]byte(out), "")
+	require.NoError(t, err)
+	require.Equal(t, sk.ID(), key.ID)
+	require.Equal(t, string(keys.EdX25519), key.Type)
+
+	_, err = keyring.ParseKey([]byte(out), "testpassword")
+	require.EqualError(t, err, "password unsupported for paper key")
+
+	// Phrase only
+	phrase, err := encoding.BytesToPhrase(sk.Seed()[:])
+	require.NoError(t, err)
+	key, err = keyring.ParseKey([]byte(phrase), "")
+	require.NoError(t, err)
+	require.Equal(t, sk.ID(), key.ID)
+
+	// Checksum mismatch
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	lines[len(lines)-1] = "Checksum: 0000-0000"
+	_, err = keyring.ParsePaperKey(strings.Join(lines, "\n"))
+	require.EqualError(t, err, "paper key checksum mismatch")
+
+	// X25519
+	bk := keys.NewX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	out, err = keyring.EncodePaperKey(bk)
+	require.NoError(t, err)
+	key, err = keyring.ParseKey([]byte(out), "")
+	require.NoError(t, err)
+	require.Equal(t, bk.ID(), key.ID)
+	require.Equal(t, string(keys.X25519), key.Type)
+
+	_, err = keyring.EncodePaperKey(sk.PublicKey())
+	require.EqualError(t, err, "unsupported key type for paper export")
+}
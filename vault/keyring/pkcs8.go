@@ -0,0 +1,185 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha1" // #nosec
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"hash"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Encrypted PKCS#8 (RFC 5958) uses PBES2 (RFC 8018) with PBKDF2 and AES-CBC,
+// the same as openssl pkcs8 -topk8.
+
+// ASN.1 object identifiers from RFC 8018.
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+const (
+	pbkdf2Iterations = 600000
+	// pbkdf2MaxIterations limits the work for keys we didn't create.
+	pbkdf2MaxIterations = 10000000
+)
+
+type encryptedPKCS8 struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// encryptPKCS8 encrypts a PKCS#8 private key (ASN.1 DER) with a password.
+func encryptPKCS8(der []byte, password string) ([]byte, error) {
+	if password == "" {
+		return nil, errors.Errorf("no password")
+	}
+	salt := keys.RandBytes(16)
+	iv := keys.RandBytes(aes.BlockSize)
+	key := pbkdf2.Key([]byte(password), salt, pbkdf2Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padded := pkcs7Pad(der, aes.BlockSize)
+	encrypted := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, padded)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pbkdf2Iterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(encryptedPKCS8{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+}
+
+// decryptPKCS8 decrypts an encrypted PKCS#8 private key, returning the
+// PKCS#8 private key (ASN.1 DER).
+func decryptPKCS8(b []byte, password string) ([]byte, error) {
+	var ek encryptedPKCS8
+	if _, err := asn1.Unmarshal(b, &ek); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse encrypted pkcs8")
+	}
+	if !ek.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, errors.Errorf("unsupported pkcs8 encryption %s", ek.Algo.Algorithm)
+	}
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(ek.Algo.Parameters.FullBytes, &params); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pbes2 params")
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, errors.Errorf("unsupported pkcs8 key derivation %s", params.KeyDerivationFunc.Algorithm)
+	}
+	var kdf pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pbkdf2 params")
+	}
+	if kdf.IterationCount <= 0 || kdf.IterationCount > pbkdf2MaxIterations {
+		return nil, errors.Errorf("invalid pbkdf2 iterations %d", kdf.IterationCount)
+	}
+	var prf func() hash.Hash
+	switch {
+	case len(kdf.PRF.Algorithm) == 0, kdf.PRF.Algorithm.Equal(oidHMACWithSHA1):
+		prf = sha1.New
+	case kdf.PRF.Algorithm.Equal(oidHMACWithSHA256):
+		prf = sha256.New
+	default:
+		return nil, errors.Errorf("unsupported pbkdf2 prf %s", kdf.PRF.Algorithm)
+	}
+	var keyLen int
+	switch {
+	case params.EncryptionScheme.Algorithm.Equal(oidAES128CBC):
+		keyLen = 16
+	case params.EncryptionScheme.Algorithm.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, errors.Errorf("unsupported pkcs8 cipher %s", params.EncryptionScheme.Algorithm)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pkcs8 iv")
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, errors.Errorf("invalid pkcs8 iv")
+	}
+	if len(ek.EncryptedData) == 0 || len(ek.EncryptedData)%aes.BlockSize != 0 {
+		return nil, errors.Errorf("invalid pkcs8 encrypted data")
+	}
+
+	key := pbkdf2.Key([]byte(password), kdf.Salt, kdf.IterationCount, keyLen, prf)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]byte, len(ek.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, ek.EncryptedData)
+	out, err := pkcs7Unpad(decrypted, aes.BlockSize)
+	if err != nil {
+		return nil, errors.Errorf("failed to decrypt pkcs8 (invalid password?)")
+	}
+	return out, nil
+}
+
+func pkcs7Pad(b []byte, size int) []byte {
+	n := size - len(b)%size
+	out := make([]byte, len(b), len(b)+n)
+	copy(out, b)
+	for i := 0; i < n; i++ {
+		out = append(out, byte(n))
+	}
+	return out
+}
+
+func pkcs7Unpad(b []byte, size int) ([]byte, error) {
+	if len(b) == 0 || len(b)%size != 0 {
+		return nil, errors.Errorf("invalid padding")
+	}
+	n := int(b[len(b)-1])
+	if n == 0 || n > size {
+		return nil, errors.Errorf("invalid padding")
+	}
+	for _, p := range b[len(b)-n:] {
+		if int(p) != n {
+			return nil, errors.Errorf("invalid padding")
+		}
+	}
+	return b[:len(b)-n], nil
+}
@@ -0,0 +1,244 @@
+package keyring
+
+import (
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+)
+
+// Secp256k1 key type.
+const Secp256k1 keys.KeyType = "secp256k1"
+const secp256k1KeyHRP = "secp256k1"
+
+// Secp256k1PublicKey is the public part of a secp256k1 key pair.
+type Secp256k1PublicKey struct {
+	id keys.ID
+	pk *secp256k1.PublicKey
+}
+
+// Secp256k1Key implements keys.Key interface for secp256k1.
+type Secp256k1Key struct {
+	privateKey *secp256k1.PrivateKey
+	publicKey  *Secp256k1PublicKey
+}
+
+var _ keys.Key = &Secp256k1Key{}
+var _ keys.Key = &Secp256k1PublicKey{}
+
+// NewSecp256k1KeyFromPrivateKey constructs secp256k1 key from 32 private key
+// bytes.
+func NewSecp256k1KeyFromPrivateKey(privateKey []byte) (*Secp256k1Key, error) {
+	if len(privateKey) != 32 {
+		return nil, errors.Errorf("invalid secp256k1 private key length")
+	}
+	sk := secp256k1.PrivKeyFromBytes(privateKey)
+	return &Secp256k1Key{
+		privateKey: sk,
+		publicKey:  newSecp256k1PublicKey(sk.PubKey()),
+	}, nil
+}
+
+// GenerateSecp256k1Key generates a secp256k1 key.
+func GenerateSecp256k1Key() *Secp256k1Key {
+	sk, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	return &Secp256k1Key{
+		privateKey: sk,
+		publicKey:  newSecp256k1PublicKey(sk.PubKey()),
+	}
+}
+
+// PublicKey ...
+func (k *Secp256k1Key) PublicKey() *Secp256k1PublicKey {
+	return k.publicKey
+}
+
+// ID for the key.
+func (k *Secp256k1Key) ID() keys.ID {
+	return k.publicKey.ID()
+}
+
+// Type of key.
+func (k *Secp256k1Key) Type() keys.KeyType {
+	return Secp256k1
+}
+
+// Private key data (32 bytes).
+func (k *Secp256k1Key) Private() []byte {
+	return k.privateKey.Serialize()
+}
+
+// Public key data (33 bytes, compressed).
+func (k *Secp256k1Key) Public() []byte {
+	return k.publicKey.Public()
+}
+
+// ASN.1 object identifiers for EC keys (RFC 5480, SEC 2).
+var (
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// ecPrivateKey is a SEC1 (RFC 5915) EC private key.
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// parseSecp256k1SEC1 parses a SEC1 EC private key, returns nil if not a
+// secp256k1 key. If the curve is omitted (as in PKCS#8), curve is used.
+func parseSecp256k1SEC1(b []byte, curve asn1.ObjectIdentifier) (*Secp256k1Key, error) {
+	var ek ecPrivateKey
+	if _, err := asn1.Unmarshal(b, &ek); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse ec private key")
+	}
+	if len(ek.NamedCurveOID) != 0 {
+		curve = ek.NamedCurveOID
+	}
+	if !curve.Equal(oidSecp256k1) {
+		return nil, nil
+	}
+	if ek.Version != 1 {
+		return nil, errors.Errorf("unsupported ec private key version %d", ek.Version)
+	}
+	return NewSecp256k1KeyFromPrivateKey(ek.PrivateKey)
+}
+
+func secp256k1AlgorithmIdentifier() (pkix.AlgorithmIdentifier, error) {
+	params, err := asn1.Marshal(oidSecp256k1)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, err
+	}
+	return pkix.AlgorithmIdentifier{
+		Algorithm:  oidECPublicKey,
+		Parameters: asn1.RawValue{FullBytes: params},
+	}, nil
+}
+
+// isSecp256k1Algorithm returns true if an ecPublicKey algorithm with
+// secp256k1 curve parameters.
+func isSecp256k1Algorithm(algo pkix.AlgorithmIdentifier) bool {
+	if !algo.Algorithm.Equal(oidECPublicKey) {
+		return false
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &curve); err != nil {
+		return false
+	}
+	return curve.Equal(oidSecp256k1)
+}
+
+// MarshalPKCS8 returns the PKCS#8 (ASN.1 DER) private key.
+func (k *Secp256k1Key) MarshalPKCS8() ([]byte, error) {
+	algo, err := secp256k1AlgorithmIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	pub := k.privateKey.PubKey().SerializeUncompressed()
+	priv, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: k.Private(),
+		PublicKey:  asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo:       algo,
+		PrivateKey: priv,
+	})
+}
+
+// Sign the SHA256 digest of b, returning a DER encoded signature.
+func (k *Secp256k1Key) Sign(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return ecdsa.Sign(k.privateKey, h[:]).Serialize()
+}
+
+func newSecp256k1PublicKey(pk *secp256k1.PublicKey) *Secp256k1PublicKey {
+	id := keys.MustID(secp256k1KeyHRP, pk.SerializeCompressed())
+	return &Secp256k1PublicKey{id, pk}
+}
+
+// NewSecp256k1PublicKeyFromBytes returns secp256k1 public key from compressed
+// (33 bytes) or uncompressed (65 bytes) public key bytes.
+func NewSecp256k1PublicKeyFromBytes(publicKey []byte) (*Secp256k1PublicKey, error) {
+	pk, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return newSecp256k1PublicKey(pk), nil
+}
+
+// NewSecp256k1PublicKeyFromID returns secp256k1 public key from a key
+// identifier.
+func NewSecp256k1PublicKeyFromID(id keys.ID) (*Secp256k1PublicKey, error) {
+	hrp, b, err := id.Decode()
+	if err != nil {
+		return nil, err
+	}
+	if hrp != secp256k1KeyHRP {
+		return nil, errors.Errorf("invalid key type for secp256k1")
+	}
+	return NewSecp256k1PublicKeyFromBytes(b)
+}
+
+// ID is key identifier.
+func (k *Secp256k1PublicKey) ID() keys.ID {
+	return k.id
+}
+
+// Type of key.
+func (k *Secp256k1PublicKey) Type() keys.KeyType {
+	return Secp256k1
+}
+
+// Bytes for public key (33 bytes, compressed).
+func (k *Secp256k1PublicKey) Bytes() []byte {
+	return k.pk.SerializeCompressed()
+}
+
+// Public key data.
+func (k *Secp256k1PublicKey) Public() []byte {
+	return k.Bytes()
+}
+
+// Private returns nil.
+func (k *Secp256k1PublicKey) Private() []byte {
+	return nil
+}
+
+// MarshalPKIX returns the PKIX (ASN.1 DER) public key.
+func (k *Secp256k1PublicKey) MarshalPKIX() ([]byte, error) {
+	algo, err := secp256k1AlgorithmIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	pub := k.pk.SerializeUncompressed()
+	return asn1.Marshal(pkixPublicKey{
+		Algo:      algo,
+		PublicKey: asn1.BitString{Bytes: pub, BitLength: 8 * len(pub)},
+	})
+}
+
+// Verify a DER encoded signature of the SHA256 digest of b.
+func (k *Secp256k1PublicKey) Verify(b []byte, sig []byte) error {
+	s, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return err
+	}
+	h := sha256.Sum256(b)
+	if !s.Verify(h[:], k.pk) {
+		return errors.Errorf("verify failed")
+	}
+	return nil
+}
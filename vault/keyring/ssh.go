@@ -0,0 +1,176 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"strings"
+
+	"github.com/ScaleFT/sshkeys"
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys/api"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// ParseKey tries to determine what key type and parses the key bytes.
+// This is like api.ParseKey but also supports SSH RSA and ECDSA keys, PKCS#8,
+// age and paper backups. See DetectKey.
+func ParseKey(b []byte, password string) (*api.Key, error) {
+	key, _, err := DetectKey(b, password)
+	return key, err
+}
+
+// ParseSSHPublicKey parses a SSH public key (ssh-ed25519, ssh-rsa, ecdsa-sha2-*).
+func ParseSSHPublicKey(s string) (keys.Key, error) {
+	pk, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse ssh public key")
+	}
+	cpk, ok := pk.(ssh.CryptoPublicKey)
+	if !ok {
+		return nil, errors.Errorf("unsupported ssh public key type %s", pk.Type())
+	}
+	switch k := cpk.CryptoPublicKey().(type) {
+	case ed25519.PublicKey:
+		if len(k) != ed25519.PublicKeySize {
+			return nil, errors.Errorf("invalid length for ssh ed25519 public key")
+		}
+		return keys.NewEdX25519PublicKey(keys.Bytes32(k)), nil
+	case *rsa.PublicKey:
+		return keys.NewRSAPublicKey(k), nil
+	case *ecdsa.PublicKey:
+		return NewECDSAPublicKey(k), nil
+	default:
+		return nil, errors.Errorf("unsupported ssh public key type %s", pk.Type())
+	}
+}
+
+// ParseSSHKey parses a SSH private key (ed25519, RSA or ECDSA), in OpenSSH or
+// PEM format.
+func ParseSSHKey(pemBytes []byte, password string) (keys.Key, error) {
+	var k interface{}
+	var err error
+	if password != "" {
+		k, err = ssh.ParseRawPrivateKeyWithPassphrase(pemBytes, []byte(password))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse ssh key with passphrase")
+		}
+	} else {
+		k, err = ssh.ParseRawPrivateKey(pemBytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse ssh key")
+		}
+	}
+
+	switch k := k.(type) {
+	case *ed25519.PrivateKey:
+		return keys.NewEdX25519KeyFromPrivateKey(keys.Bytes64(*k)), nil
+	case ed25519.PrivateKey:
+		return keys.NewEdX25519KeyFromPrivateKey(keys.Bytes64(k)), nil
+	case *rsa.PrivateKey:
+		return keys.NewRSAKey(k), nil
+	case *ecdsa.PrivateKey:
+		return NewECDSAKey(k), nil
+	default:
+		return nil, errors.Errorf("unsupported ssh key type %T", k)
+	}
+}
+
+// EncodeSSHKey encodes key to SSH.
+// RSA and EdX25519 private keys are encoded in the OpenSSH format, ECDSA
+// private keys are encoded as PEM (SEC1).
+// Public keys are encoded in the authorized_keys format.
+func EncodeSSHKey(key keys.Key, password string) (string, error) {
+	switch k := key.(type) {
+	case *keys.EdX25519Key, *keys.EdX25519PublicKey:
+		return keys.EncodeSSHKey(key, password)
+	case *keys.RSAKey:
+		rk, err := rsaPrivateKey(k)
+		if err != nil {
+			return "", err
+		}
+		out, err := sshkeys.Marshal(rk, &sshkeys.MarshalOptions{
+			Passphrase: []byte(password),
+			Format:     sshkeys.FormatOpenSSHv1,
+		})
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	case *ECDSAKey:
+		out, err := sshkeys.Marshal(k.privateKey, &sshkeys.MarshalOptions{
+			Passphrase: []byte(password),
+			Format:     sshkeys.FormatClassicPEM,
+		})
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	case *keys.RSAPublicKey, *ECDSAPublicKey:
+		if password != "" {
+			return "", errors.Errorf("password not supported when exporting public key")
+		}
+		pk, err := SSHPublicKey(k)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pk))), nil
+	default:
+		return "", errors.Errorf("unsupported key type")
+	}
+}
+
+// SSHPublicKey returns ssh.PublicKey for a (public or private) EdX25519, RSA
+// or ECDSA key.
+func SSHPublicKey(key keys.Key) (ssh.PublicKey, error) {
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		return ssh.NewPublicKey(ed25519.PublicKey(k.PublicKey().Bytes()))
+	case *keys.EdX25519PublicKey:
+		return ssh.NewPublicKey(ed25519.PublicKey(k.Bytes()))
+	case *keys.RSAKey:
+		rk, err := rsaPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return ssh.NewPublicKey(&rk.PublicKey)
+	case *keys.RSAPublicKey:
+		pk, err := x509.ParsePKCS1PublicKey(k.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return ssh.NewPublicKey(pk)
+	case *ECDSAKey:
+		return ssh.NewPublicKey(k.publicKey.pk)
+	case *ECDSAPublicKey:
+		return ssh.NewPublicKey(k.pk)
+	default:
+		return nil, errors.Errorf("unsupported key type for ssh")
+	}
+}
+
+// SSHSigner returns ssh.Signer for a EdX25519, RSA or ECDSA private key.
+func SSHSigner(key keys.Key) (ssh.Signer, error) {
+	switch k := key.(type) {
+	case *keys.EdX25519Key:
+		return k.SSHSigner(), nil
+	case *keys.RSAKey:
+		rk, err := rsaPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return ssh.NewSignerFromKey(rk)
+	case *ECDSAKey:
+		return ssh.NewSignerFromKey(k.privateKey)
+	default:
+		return nil, errors.Errorf("unsupported key type for ssh")
+	}
+}
+
+// rsaPrivateKey returns the rsa.PrivateKey from a keys.RSAKey (from its PKCS1
+// private key data).
+func rsaPrivateKey(k *keys.RSAKey) (*rsa.PrivateKey, error) {
+	return x509.ParsePKCS1PrivateKey(k.Private())
+}
@@ -0,0 +1,228 @@
+package keyring
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/keys-pub/keys"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHSIG (OpenSSH PROTOCOL.sshsig) signatures, as used by ssh-keygen -Y sign
+// and git (gpg.format=ssh).
+const (
+	sshsigMagic    = "SSHSIG"
+	sshsigVersion  = 1
+	sshsigBegin    = "-----BEGIN SSH SIGNATURE-----"
+	sshsigEnd      = "-----END SSH SIGNATURE-----"
+	sshsigLineSize = 70
+)
+
+type sshsigBlob struct {
+	Magic         [6]byte
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+type sshsigSignedData struct {
+	Magic         [6]byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// SSHSign creates an (armored) SSHSIG signature for the message in the
+// namespace (for example "git" or "file").
+func SSHSign(key keys.Key, namespace string, message io.Reader) (string, error) {
+	var buf bytes.Buffer
+	stream, err := NewSSHSignStream(&buf, key, namespace)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(stream, message); err != nil {
+		return "", err
+	}
+	if err := stream.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// NewSSHSignStream returns a stream that writes an (armored) SSHSIG signature
+// to w on Close.
+func NewSSHSignStream(w io.Writer, key keys.Key, namespace string) (io.WriteCloser, error) {
+	if namespace == "" {
+		return nil, errors.Errorf("no namespace")
+	}
+	signer, err := SSHSigner(key)
+	if err != nil {
+		return nil, err
+	}
+	return &sshsigStream{w: w, signer: signer, namespace: namespace, h: sha512.New()}, nil
+}
+
+type sshsigStream struct {
+	w         io.Writer
+	signer    ssh.Signer
+	namespace string
+	h         hash.Hash
+}
+
+func (s *sshsigStream) Write(b []byte) (int, error) {
+	return s.h.Write(b)
+}
+
+func (s *sshsigStream) Close() error {
+	signed := ssh.Marshal(sshsigSignedData{
+		Magic:         sshsigMagicBytes(),
+		Namespace:     s.namespace,
+		HashAlgorithm: "sha512",
+		Hash:          s.h.Sum(nil),
+	})
+
+	var sig *ssh.Signature
+	var err error
+	if as, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		sig, err = as.SignWithAlgorithm(rand.Reader, signed, ssh.SigAlgoRSASHA2512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signed)
+	}
+	if err != nil {
+		return err
+	}
+
+	blob := ssh.Marshal(sshsigBlob{
+		Magic:         sshsigMagicBytes(),
+		Version:       sshsigVersion,
+		PublicKey:     s.signer.PublicKey().Marshal(),
+		Namespace:     s.namespace,
+		HashAlgorithm: "sha512",
+		Signature:     ssh.Marshal(sig),
+	})
+	_, err = io.WriteString(s.w, armorSSHSIG(blob))
+	return err
+}
+
+// SSHSignature is a parsed SSHSIG signature.
+type SSHSignature struct {
+	PublicKey ssh.PublicKey
+	Namespace string
+
+	hashAlgorithm string
+	signature     *ssh.Signature
+}
+
+// ParseSSHSignature parses an (armored) SSHSIG signature.
+func ParseSSHSignature(b []byte) (*SSHSignature, error) {
+	s := strings.TrimSpace(string(b))
+	if !strings.HasPrefix(s, sshsigBegin) || !strings.HasSuffix(s, sshsigEnd) {
+		return nil, errors.Errorf("invalid ssh signature armor")
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, sshsigBegin), sshsigEnd)
+	raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature encoding")
+	}
+
+	var blob sshsigBlob
+	if err := ssh.Unmarshal(raw, &blob); err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature")
+	}
+	if string(blob.Magic[:]) != sshsigMagic {
+		return nil, errors.Errorf("invalid ssh signature magic")
+	}
+	if blob.Version != sshsigVersion {
+		return nil, errors.Errorf("unsupported ssh signature version %d", blob.Version)
+	}
+	pk, err := ssh.ParsePublicKey(blob.PublicKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature public key")
+	}
+	var sig ssh.Signature
+	if err := ssh.Unmarshal(blob.Signature, &sig); err != nil {
+		return nil, errors.Wrapf(err, "invalid ssh signature")
+	}
+	return &SSHSignature{
+		PublicKey:     pk,
+		Namespace:     blob.Namespace,
+		hashAlgorithm: blob.HashAlgorithm,
+		signature:     &sig,
+	}, nil
+}
+
+// Verify the signature for the message in the namespace.
+func (s *SSHSignature) Verify(namespace string, message io.Reader) error {
+	if s.Namespace != namespace {
+		return errors.Errorf("ssh signature namespace mismatch %q != %q", s.Namespace, namespace)
+	}
+	// ssh-rsa signatures use SHA-1, which ssh-keygen doesn't accept for SSHSIG.
+	if s.signature.Format == ssh.SigAlgoRSA {
+		return errors.Errorf("unsupported ssh signature algorithm %s", s.signature.Format)
+	}
+	var hf hash.Hash
+	switch s.hashAlgorithm {
+	case "sha512":
+		hf = sha512.New()
+	case "sha256":
+		hf = sha256.New()
+	default:
+		return errors.Errorf("unsupported ssh signature hash algorithm %s", s.hashAlgorithm)
+	}
+	h, err := sshsigHash(hf, message)
+	if err != nil {
+		return err
+	}
+	signed := ssh.Marshal(sshsigSignedData{
+		Magic:         sshsigMagicBytes(),
+		Namespace:     s.Namespace,
+		HashAlgorithm: s.hashAlgorithm,
+		Hash:          h,
+	})
+	if err := s.PublicKey.Verify(signed, s.signature); err != nil {
+		return errors.Errorf("verify failed")
+	}
+	return nil
+}
+
+// Key returns the signature public key (EdX25519, RSA or ECDSA).
+func (s *SSHSignature) Key() (keys.Key, error) {
+	return ParseSSHPublicKey(string(ssh.MarshalAuthorizedKey(s.PublicKey)))
+}
+
+func sshsigHash(h hash.Hash, r io.Reader) ([]byte, error) {
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func sshsigMagicBytes() [6]byte {
+	var b [6]byte
+	copy(b[:], sshsigMagic)
+	return b
+}
+
+func armorSSHSIG(b []byte) string {
+	enc := base64.StdEncoding.EncodeToString(b)
+	var buf bytes.Buffer
+	buf.WriteString(sshsigBegin + "\n")
+	for len(enc) > sshsigLineSize {
+		buf.WriteString(enc[:sshsigLineSize] + "\n")
+		enc = enc[sshsigLineSize:]
+	}
+	buf.WriteString(enc + "\n")
+	buf.WriteString(sshsigEnd + "\n")
+	return buf.String()
+}
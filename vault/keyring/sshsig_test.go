@@ -0,0 +1,106 @@
+package keyring_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault/keyring"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSSHSign(t *testing.T) {
+	sk := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	message := []byte("tree 1234\nauthor alice\n\nCommit message\n")
+
+	out, err := keyring.SSHSign(sk, "git", bytes.NewReader(message))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out, "-----BEGIN SSH SIGNATURE-----\n"))
+	require.True(t, strings.HasSuffix(out, "-----END SSH SIGNATURE-----\n"))
+
+	sig, err := keyring.ParseSSHSignature([]byte(out))
+	require.NoError(t, err)
+	require.Equal(t, "git", sig.Namespace)
+	require.Equal(t, "ssh-ed25519", sig.PublicKey.Type())
+	err = sig.Verify("git", bytes.NewReader(message))
+	require.NoError(t, err)
+	key, err := sig.Key()
+	require.NoError(t, err)
+	require.Equal(t, sk.ID(), key.ID())
+
+	err = sig.Verify("git", bytes.NewReader([]byte("Other message")))
+	require.EqualError(t, err, "verify failed")
+	err = sig.Verify("file", bytes.NewReader(message))
+	require.EqualError(t, err, `ssh signature namespace mismatch "git" != "file"`)
+
+	// Signature from ssh-keygen -Y sign -n git
+	known := `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgJzrJ6aXMIXsOHDnyG1XG9Xtx/p
+BL00lqZAprH4eVkDoAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQA+CKvb+jnCinF3ioPiG6wjocvybqIMLKIdOSi2/yFKzR4fBrEIC5P0LBPULjgdLha
+Kv65tLZIcsYDdeMFqzNwM=
+-----END SSH SIGNATURE-----`
+	sig, err = keyring.ParseSSHSignature([]byte(known))
+	require.NoError(t, err)
+	err = sig.Verify("git", bytes.NewReader(message))
+	require.NoError(t, err)
+	key, err = sig.Key()
+	require.NoError(t, err)
+	spk, err := keyring.ParseSSHPublicKey("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAICc6yemlzCF7Dhw58htVxvV7cf6QS9NJamQKax+HlZA6")
+	require.NoError(t, err)
+	require.Equal(t, spk.ID(), key.ID())
+
+	// RSA, ECDSA
+	for _, k := range []keys.Key{keys.GenerateRSAKey(), keyring.GenerateECDSAKey()} {
+		out, err := keyring.SSHSign(k, "file", bytes.NewReader(message))
+		require.NoError(t, err)
+		sig, err := keyring.ParseSSHSignature([]byte(out))
+		require.NoError(t, err)
+		err = sig.Verify("file", bytes.NewReader(message))
+		require.NoError(t, err)
+		key, err := sig.Key()
+		require.NoError(t, err)
+		require.Equal(t, k.ID(), key.ID())
+	}
+
+	_, err = keyring.SSHSign(sk, "", bytes.NewReader(message))
+	require.EqualError(t, err, "no namespace")
+}
+
+func TestSSHSignRSASHA1(t *testing.T) {
+	rk := keys.GenerateRSAKey()
+	signer, err := keyring.SSHSigner(rk)
+	require.NoError(t, err)
+	message := []byte("tree 1234\nauthor alice\n\nCommit message\n")
+
+	h := sha512.Sum512(message)
+	signed := ssh.Marshal(struct {
+		Magic         [6]byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          []byte
+	}{[6]byte{'S', 'S', 'H', 'S', 'I', 'G'}, "git", "", "sha512", h[:]})
+	sig, err := signer.(ssh.AlgorithmSigner).SignWithAlgorithm(rand.Reader, signed, ssh.SigAlgoRSA)
+	require.NoError(t, err)
+	blob := ssh.Marshal(struct {
+		Magic         [6]byte
+		Version       uint32
+		PublicKey     []byte
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Signature     []byte
+	}{[6]byte{'S', 'S', 'H', 'S', 'I', 'G'}, 1, signer.PublicKey().Marshal(), "git", "", "sha512", ssh.Marshal(sig)})
+	armored := "-----BEGIN SSH SIGNATURE-----\n" + base64.StdEncoding.EncodeToString(blob) + "\n-----END SSH SIGNATURE-----\n"
+
+	out, err := keyring.ParseSSHSignature([]byte(armored))
+	require.NoError(t, err)
+	err = out.Verify("git", bytes.NewReader(message))
+	require.EqualError(t, err, "unsupported ssh signature algorithm ssh-rsa")
+}
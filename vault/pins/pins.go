@@ -0,0 +1,208 @@
+// Package pins provides pinned (trusted) keys stored in the vault.
+package pins
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/pkg/errors"
+)
+
+// Method is how a pinned key was verified.
+type Method string
+
+const (
+	// TOFU (trust on first use) if the key was pinned when first used.
+	TOFU Method = "tofu"
+	// Proof if the key was verified from a user (service) proof.
+	Proof Method = "proof"
+	// Wormhole if the key was exchanged over a wormhole.
+	Wormhole Method = "wormhole"
+	// InPerson if the key was verified in person.
+	InPerson Method = "in-person"
+)
+
+// Level is the trust level for a pinned key.
+type Level string
+
+const (
+	// Never trust the key.
+	Never Level = "never"
+	// Marginal trust.
+	Marginal Level = "marginal"
+	// Full trust.
+	Full Level = "full"
+)
+
+// DefaultLevel is the trust level for a verification method, if not
+// specified.
+func DefaultLevel(method Method) Level {
+	switch method {
+	case Wormhole, InPerson:
+		return Full
+	default:
+		return Marginal
+	}
+}
+
+// Pin is a pinned key, and the user (name@service) it was for.
+type Pin struct {
+	KID    keys.ID `json:"kid"`
+	User   string  `json:"user,omitempty"`
+	Method Method  `json:"method"`
+	Level  Level   `json:"level"`
+
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
+
+// Pins vault.
+type Pins struct {
+	*vault.Vault
+}
+
+// New pins vault.
+func New(vlt *vault.Vault) *Pins {
+	return &Pins{vlt}
+}
+
+// pinItemType is type for pin.
+const pinItemType string = "pin"
+
+func itemID(kid keys.ID) string {
+	return "pin-" + kid.String()
+}
+
+// Validate returns error if pin is invalid.
+func (p *Pin) Validate() error {
+	if p.KID == "" {
+		return errors.Errorf("no kid")
+	}
+	switch p.Method {
+	case TOFU, Proof, Wormhole, InPerson:
+	default:
+		return errors.Errorf("invalid pin method %q", p.Method)
+	}
+	switch p.Level {
+	case Never, Marginal, Full:
+	default:
+		return errors.Errorf("invalid trust level %q", p.Level)
+	}
+	return nil
+}
+
+// Save a pin, replacing an existing pin for the same key.
+// If a different key was pinned for the user, that pin is removed.
+func (v *Pins) Save(pin *Pin) (*Pin, error) {
+	if pin.Level == "" {
+		pin.Level = DefaultLevel(pin.Method)
+	}
+	if err := pin.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := v.Now()
+	existing, err := v.Get(pin.KID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		pin.CreatedAt = existing.CreatedAt
+	} else {
+		pin.CreatedAt = now
+	}
+	pin.UpdatedAt = now
+
+	if pin.User != "" {
+		prev, err := v.FindUser(pin.User)
+		if err != nil {
+			return nil, err
+		}
+		if prev != nil && prev.KID != pin.KID {
+			if _, err := v.Delete(prev.KID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	b, err := json.Marshal(pin)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Set(vault.NewItem(itemID(pin.KID), b, pinItemType, now)); err != nil {
+		return nil, err
+	}
+	return pin, nil
+}
+
+// Get a pin by key, or nil if not found.
+func (v *Pins) Get(kid keys.ID) (*Pin, error) {
+	item, err := v.Vault.Get(itemID(kid))
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+	return asPin(item)
+}
+
+// FindUser returns the pin for a user (name@service), or nil if not found.
+func (v *Pins) FindUser(user string) (*Pin, error) {
+	pins, err := v.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, pin := range pins {
+		if pin.User == user {
+			return pin, nil
+		}
+	}
+	return nil, nil
+}
+
+// Delete a pin.
+// Returns false if not found.
+func (v *Pins) Delete(kid keys.ID) (bool, error) {
+	return v.Vault.Delete(itemID(kid))
+}
+
+// List pins, sorted by user then kid.
+func (v *Pins) List() ([]*Pin, error) {
+	items, err := v.Items()
+	if err != nil {
+		return nil, err
+	}
+	out := []*Pin{}
+	for _, item := range items {
+		if item.Type != pinItemType {
+			continue
+		}
+		pin, err := asPin(item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pin)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].User != out[j].User {
+			return out[i].User < out[j].User
+		}
+		return out[i].KID < out[j].KID
+	})
+	return out, nil
+}
+
+func asPin(item *vault.Item) (*Pin, error) {
+	var pin Pin
+	if err := json.Unmarshal(item.Data, &pin); err != nil {
+		return nil, err
+	}
+	if itemID(pin.KID) != item.ID {
+		return nil, errors.Errorf("pin id mismatch")
+	}
+	return &pin, nil
+}
@@ -0,0 +1,84 @@
+package pins_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/keys-pub/keys"
+	"github.com/keys-pub/keys-ext/vault"
+	"github.com/keys-pub/keys-ext/vault/pins"
+	"github.com/keys-pub/keys/encoding"
+	"github.com/keys-pub/keys/tsutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVault(t *testing.T) (*vault.Vault, func()) {
+	clock := tsutil.NewTestClock()
+	mem := vault.NewMem()
+	err := mem.Open()
+	require.NoError(t, err)
+	vlt := vault.New(mem, vault.WithClock(clock))
+	key := keys.Bytes32(bytes.Repeat([]byte{0xFF}, 32))
+	provision := &vault.Provision{
+		ID:        encoding.MustEncode(bytes.Repeat([]byte{0xFE}, 32), encoding.Base62),
+		Type:      vault.UnknownAuth,
+		CreatedAt: clock.Now(),
+	}
+	err = vlt.Setup(key, provision)
+	require.NoError(t, err)
+	_, err = vlt.Unlock(key)
+	require.NoError(t, err)
+	return vlt, func() { mem.Close() }
+}
+
+func TestPins(t *testing.T) {
+	vlt, closeFn := newTestVault(t)
+	defer closeFn()
+	pv := pins.New(vlt)
+
+	alice := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x01}, 32)))
+	alice2 := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x02}, 32)))
+	bob := keys.NewEdX25519KeyFromSeed(keys.Bytes32(bytes.Repeat([]byte{0x03}, 32)))
+
+	pin, err := pv.Save(&pins.Pin{KID: alice.ID(), User: "alice@github", Method: pins.TOFU})
+	require.NoError(t, err)
+	require.Equal(t, pins.Marginal, pin.Level)
+
+	_, err = pv.Save(&pins.Pin{KID: bob.ID(), Method: pins.InPerson})
+	require.NoError(t, err)
+
+	out, err := pv.Get(bob.ID())
+	require.NoError(t, err)
+	require.Equal(t, pins.Full, out.Level)
+	require.Equal(t, pins.InPerson, out.Method)
+
+	out, err = pv.FindUser("alice@github")
+	require.NoError(t, err)
+	require.Equal(t, alice.ID(), out.KID)
+
+	// Pin a new key for alice@github
+	_, err = pv.Save(&pins.Pin{KID: alice2.ID(), User: "alice@github", Method: pins.Wormhole, Level: pins.Marginal})
+	require.NoError(t, err)
+	out, err = pv.Get(alice.ID())
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	list, err := pv.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	require.Equal(t, bob.ID(), list[0].KID)
+	require.Equal(t, alice2.ID(), list[1].KID)
+	require.Equal(t, pins.Marginal, list[1].Level)
+
+	ok, err := pv.Delete(bob.ID())
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = pv.Delete(bob.ID())
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = pv.Save(&pins.Pin{KID: bob.ID(), Method: "email"})
+	require.EqualError(t, err, `invalid pin method "email"`)
+	_, err = pv.Save(&pins.Pin{KID: bob.ID(), Method: pins.Proof, Level: "ultimate"})
+	require.EqualError(t, err, `invalid trust level "ultimate"`)
+}
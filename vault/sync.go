@@ -71,6 +71,15 @@ func (v *Vault) SyncStatus() (*SyncStatus, error) {
 	}, nil
 }
 
+// Usage returns vault usage and quota from the remote, or nil if not synced.
+func (v *Vault) Usage(ctx context.Context) (*Usage, error) {
+	remote := v.Remote()
+	if remote == nil || v.client == nil {
+		return nil, nil
+	}
+	return v.client.VaultUsage(ctx, remote.Key)
+}
+
 // Unsync removes vault from the remote and resets the vault log.
 //
 // The steps for "unsyncing" are: